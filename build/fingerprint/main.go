@@ -0,0 +1,52 @@
+// Package main generates the fingerprints of embedded static assets at build
+// time. Given a directory, it writes the fingerprints of the files below it to
+// the directory's assets.FingerprintsFile, which is embedded with the files so
+// the server serves them under fixed fingerprinted names. It is run by
+// go generate.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/assets"
+)
+
+// filePerms defines the permission bits for writing the fingerprints, allowing
+// read access for all.
+const filePerms = 0o644
+
+// errUsage is returned when the command is not given exactly one directory.
+var errUsage = errors.New("usage: fingerprint <directory>")
+
+// main writes the fingerprints of the directory named by the only argument.
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run fingerprints the files of the directory named by args and writes them to
+// its assets.FingerprintsFile. Returns an error if the arguments are invalid or
+// the files cannot be read or the fingerprints written.
+func run(args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	var buf bytes.Buffer
+	if err := assets.WriteFingerprints(os.DirFS(args[0]), &buf); err != nil {
+		return fmt.Errorf("failed to fingerprint %s: %w", args[0], err)
+	}
+
+	output := filepath.Join(args[0], assets.FingerprintsFile)
+	if err := os.WriteFile(output, buf.Bytes(), filePerms); err != nil {
+		return fmt.Errorf("failed to write %s: %w", output, err)
+	}
+
+	return nil
+}
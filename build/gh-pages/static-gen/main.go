@@ -1,6 +1,7 @@
 // Package main generates static HTML for the EUI-64 calculator's GitHub Pages
-// site. It renders UI templates, adapts them for client-side WebAssembly usage,
-// formats the HTML for readability, and writes the result to a file for deployment.
+// site. It renders UI templates in every supported locale, adapts them for
+// client-side WebAssembly usage, formats the HTML for readability, and writes
+// a calculator and an address analyzer page per locale for deployment.
 package main
 
 import (
@@ -14,6 +15,8 @@ import (
 
 	"golang.org/x/net/html"
 
+	"github.com/nicholas-fedor/eui64-calculator/internal/classify"
+	"github.com/nicholas-fedor/eui64-calculator/internal/i18n"
 	"github.com/nicholas-fedor/eui64-calculator/internal/ui"
 )
 
@@ -25,9 +28,9 @@ const dirPerms = 0o755
 // for all to suit static hosting requirements.
 const filePerms = 0o644
 
-// main renders the EUI-64 calculator's home page template, adapts it for static use
-// by removing server-specific dependencies, adds WebAssembly scripts, formats the
-// HTML for readability, and writes the output to dist/static/index.html.
+// main renders the EUI-64 calculator's home and analyzer pages in every locale, adapts
+// them for static use by removing server-specific dependencies, adds WebAssembly
+// scripts, formats the HTML for readability, and writes the pages to dist/static.
 func main() {
 	if err := run(); err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
@@ -36,37 +39,184 @@ func main() {
 }
 
 // run performs the main logic of generating static HTML for the EUI-64 calculator.
-// It renders the home page template, adapts it for static use by removing server-specific
-// dependencies, adds WebAssembly scripts, formats the HTML for readability, and writes
-// the output to dist/static/index.html. Returns an error if any step fails.
+// It creates the output directory and writes the home page in every supported
+// locale, the default locale to dist/static/index.html and the others to
+// dist/static/<tag>.html, and the address analyzer page alongside it, see
+// analyzerPageName. Returns an error if any step fails.
 func run() error {
+	// Ensure output directory exists.
+	outputDir := filepath.Join("dist", "static")
+	if err := os.MkdirAll(outputDir, dirPerms); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", outputDir, err)
+	}
+
+	for _, locale := range i18n.Locales() {
+		if err := generatePage(outputDir, locale); err != nil {
+			return err
+		}
+
+		if err := generateAnalyzerPage(outputDir, locale); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generatePage renders the home page in the given locale, adapts it for static
+// use by removing server-specific dependencies, adds WebAssembly scripts and
+// the result, subnet plan, and ULA prefix templates, formats the HTML for readability, and writes
+// it to the locale's page in outputDir.
+func generatePage(outputDir string, locale *i18n.Locale) error {
+	ctx := i18n.WithLocale(context.Background(), locale)
+	ctx = ui.WithLocaleURL(ctx, pageURL)
+
 	// Create a buffer to hold the rendered HTML.
 	var buf bytes.Buffer
-	if err := ui.Home().Render(context.Background(), &buf); err != nil {
+	if err := ui.Home().Render(ctx, &buf); err != nil {
 		return fmt.Errorf("failed to render home template: %w", err)
 	}
 
+	// Render the result markup the client clones for calculations, in the same locale.
+	var result bytes.Buffer
+
+	err := ui.Result(ui.ResultData{
+		InterfaceID:    "",
+		FullIP:         "",
+		Prefix:         classify.Classification{},
+		DUIDLL:         "",
+		DUIDLLT:        "",
+		Error:          "",
+		ErrorField:     "",
+		ErrorHighlight: nil,
+	}).Render(ctx, &result)
+	if err != nil {
+		return fmt.Errorf("failed to render result template: %w", err)
+	}
+
+	// Render the MAC range markup the client clones for ranges, in the same locale.
+	var macRange bytes.Buffer
+
+	err = ui.RangeResult(ui.RangeData{
+		Addresses: nil,
+		Prefix:    classify.Classification{},
+	}).Render(ctx, &macRange)
+	if err != nil {
+		return fmt.Errorf("failed to render MAC range template: %w", err)
+	}
+
+	// Render the subnet plan markup the client clones for plans, in the same locale.
+	var plan bytes.Buffer
+
+	err = ui.PlanResult(ui.PlanData{
+		InterfaceID:    "",
+		Subnets:        nil,
+		Error:          "",
+		ErrorField:     "",
+		ErrorHighlight: nil,
+	}).Render(ctx, &plan)
+	if err != nil {
+		return fmt.Errorf("failed to render plan template: %w", err)
+	}
+
+	// Render the ULA prefix markup the client clones for generated prefixes, in the same locale.
+	var ula bytes.Buffer
+
+	err = ui.ULAResult(ui.ULAData{
+		Prefix:         "",
+		Subnet:         "",
+		Value:          "",
+		Error:          "",
+		ErrorField:     "",
+		ErrorHighlight: nil,
+	}).Render(ctx, &ula)
+	if err != nil {
+		return fmt.Errorf("failed to render ULA template: %w", err)
+	}
+
+	// Render the import markup the client clones for imported files, in the same locale.
+	var imported bytes.Buffer
+
+	err = ui.ImportResult(ui.ImportData{
+		Format:         "",
+		Rows:           nil,
+		Prefix:         classify.Classification{},
+		Error:          "",
+		ErrorField:     "",
+		ErrorHighlight: nil,
+	}).Render(ctx, &imported)
+	if err != nil {
+		return fmt.Errorf("failed to render import template: %w", err)
+	}
+
+	var simulation bytes.Buffer
+
+	err = ui.RAResult(ui.RAData{
+		InterfaceID:    "",
+		Rows:           nil,
+		Error:          "",
+		ErrorField:     "",
+		ErrorHighlight: nil,
+	}).Render(ctx, &simulation)
+	if err != nil {
+		return fmt.Errorf("failed to render simulation template: %w", err)
+	}
+
 	// Modify HTML for static site: remove HTMX, adjust paths, add WASM/JS scripts.
-	htmlContent := buf.String()
+	htmlContent := adaptPage(buf.String(), locale.Tag)
+	htmlContent = addTemplate(htmlContent, "offline-result", result.String())
+	htmlContent = addTemplate(htmlContent, "offline-range-result", macRange.String())
+	htmlContent = addTemplate(htmlContent, "offline-plan-result", plan.String())
+	htmlContent = addTemplate(htmlContent, "offline-ula-result", ula.String())
+	htmlContent = addTemplate(htmlContent, "offline-import-result", imported.String())
+	htmlContent = addTemplate(htmlContent, "offline-ra-result", simulation.String())
+
+	return writePage(filepath.Join(outputDir, pageName(locale.Tag)), htmlContent)
+}
+
+// generateAnalyzerPage renders the address analyzer page in the given locale,
+// adapts it for static use like the home page, and writes it to the locale's
+// analyzer page in outputDir. The static client analyzes the addresses itself,
+// including one given in the page's URL.
+func generateAnalyzerPage(outputDir string, locale *i18n.Locale) error {
+	ctx := i18n.WithLocale(context.Background(), locale)
+	ctx = ui.WithLocaleURL(ctx, analyzerPageURL)
+
+	var buf bytes.Buffer
+
+	err := ui.AnalyzerPage(ui.AnalysisData{
+		Address: "",
+		Facts:   nil,
+		Error:   "",
+		DUID:    ui.DUIDData{DUID: "", Facts: nil, Error: ""},
+	}).Render(ctx, &buf)
+	if err != nil {
+		return fmt.Errorf("failed to render analyzer template: %w", err)
+	}
+
+	return writePage(filepath.Join(outputDir, analyzerPageName(locale.Tag)), adaptPage(buf.String(), locale.Tag))
+}
+
+// adaptPage adapts a page rendered in the locale with the given tag for the
+// static site: it removes HTMX, adjusts paths and links to other pages, and
+// adds the WebAssembly scripts.
+func adaptPage(htmlContent, tag string) string {
 	htmlContent = removeHTMXScript(htmlContent)
 	htmlContent = replaceServerPaths(htmlContent)
+	htmlContent = replacePageLinks(htmlContent, tag)
 	htmlContent = replaceLayoutScript(htmlContent)
-	htmlContent = fixInputPatterns(htmlContent)
 
-	// Format HTML for readability with newlines and indentation.
+	return removeNoscript(htmlContent)
+}
+
+// writePage formats a page's HTML for readability, with newlines and
+// indentation, and writes it to outputFile.
+func writePage(outputFile, htmlContent string) error {
 	formattedHTML, err := formatHTML(htmlContent)
 	if err != nil {
 		return fmt.Errorf("failed to format HTML: %w", err)
 	}
 
-	// Ensure output directory exists.
-	outputDir := filepath.Join("dist", "static")
-	if err := os.MkdirAll(outputDir, dirPerms); err != nil {
-		return fmt.Errorf("failed to create directory %s: %w", outputDir, err)
-	}
-
-	// Write formatted HTML to file.
-	outputFile := filepath.Join(outputDir, "index.html")
 	if err := os.WriteFile(outputFile, []byte(formattedHTML), filePerms); err != nil {
 		return fmt.Errorf("failed to write %s: %w", outputFile, err)
 	}
@@ -82,98 +232,134 @@ func run() error {
 	return nil
 }
 
-// removeHTMXScript removes the HTMX script tag from the HTML, matching any version
-// or integrity attribute to handle CDN updates.
+// pageName returns the file name of the page for the locale with the given tag:
+// index.html for the default locale and <tag>.html for the others.
+func pageName(tag string) string {
+	if tag == i18n.Default().Tag {
+		return "index.html"
+	}
+
+	return tag + ".html"
+}
+
+// pageURL returns the relative URL of the page for the locale with the given
+// tag, linked to by the language selector.
+func pageURL(tag string) string {
+	return "./" + pageName(tag)
+}
+
+// analyzerPageName returns the file name of the address analyzer page for the
+// locale with the given tag: analyze.html for the default locale and
+// <tag>-analyze.html for the others.
+func analyzerPageName(tag string) string {
+	if tag == i18n.Default().Tag {
+		return "analyze.html"
+	}
+
+	return tag + "-analyze.html"
+}
+
+// analyzerPageURL returns the relative URL of the address analyzer page for the
+// locale with the given tag, linked to by its language selector.
+func analyzerPageURL(tag string) string {
+	return "./" + analyzerPageName(tag)
+}
+
+// replacePageLinks replaces the server's links to the home page and the address
+// analyzer, and the actions of the analyzer's forms, with the relative URLs of
+// the static pages in the locale with the given tag.
+func replacePageLinks(htmlContent, tag string) string {
+	htmlContent = strings.ReplaceAll(htmlContent, `href="/"`, `href="`+pageURL(tag)+`"`)
+	htmlContent = strings.ReplaceAll(htmlContent, `href="/analyze"`, `href="`+analyzerPageURL(tag)+`"`)
+
+	htmlContent = strings.ReplaceAll(htmlContent, `action="/analyze"`, `action="`+analyzerPageURL(tag)+`"`)
+
+	return strings.ReplaceAll(htmlContent, `action="/duid"`, `action="`+analyzerPageURL(tag)+`"`)
+}
+
+// removeNoscript removes <noscript> fallbacks, such as the language selector's
+// submit button, which rely on the server and do nothing on the static site.
+func removeNoscript(htmlContent string) string {
+	return regexp.MustCompile(`(?s)<noscript>.*?</noscript>`).ReplaceAllString(htmlContent, "")
+}
+
+// addTemplate adds markup as the template with the given id that the static
+// client clones to show a calculation or subnet plan, at the end of the body.
+func addTemplate(htmlContent, id, markup string) string {
+	return strings.Replace(
+		htmlContent,
+		"</body>",
+		`<template id="`+id+`">`+markup+`</template></body>`,
+		1,
+	)
+}
+
+// removeHTMXScript removes the HTMX script tag and its configuration meta tag from
+// the HTML, matching both the vendored copy served by the application and any CDN
+// version or integrity attribute.
 func removeHTMXScript(htmlContent string) string {
-	re := regexp.MustCompile(`<script\s+src="https://unpkg\.com/htmx\.org@[^"]+"[^>]*></script>`)
+	re := regexp.MustCompile(
+		`<script\s+src="(?:https://unpkg\.com/htmx\.org@[^"]+|/static/htmx\.min\.js)"[^>]*></script>`,
+	)
+	htmlContent = re.ReplaceAllString(htmlContent, "")
 
-	return re.ReplaceAllString(htmlContent, "")
+	return regexp.MustCompile(`<meta\s+name="htmx-config"[^>]*>`).ReplaceAllString(htmlContent, "")
 }
 
 // replaceServerPaths updates server-specific paths and attributes to relative paths
 // and static-compatible attributes for GitHub Pages deployment, unescaping inline
 // script content to ensure valid JavaScript syntax.
 func replaceServerPaths(htmlContent string) string {
-	const minMatchCount = 2 // Minimum number of regex matches (full match + one submatch).
-	// Remove all hx-* attributes from <form> tag.
-	re := regexp.MustCompile(`(<form[^>]*?)(?:\s+hx-[^=\s]+="[^"]*")*(\s*[^>]*>)`)
-	htmlContent = re.ReplaceAllString(htmlContent, "$1$2")
+	const minMatchCount = 3 // Minimum number of regex matches (full match + opening tag + content).
+	// Remove all hx-* attributes, such as the form's calculation request and the
+	// fields' live validation requests, which the static client handles itself.
+	htmlContent = regexp.MustCompile(`\s+hx-[^=\s]+="[^"]*"`).ReplaceAllString(htmlContent, "")
 
 	// Adjust static asset paths for GitHub Pages.
-	htmlContent = regexp.MustCompile(`/static/styles\.css`).ReplaceAllString(htmlContent, "./styles.css")
-	htmlContent = regexp.MustCompile(`/static/favicon\.ico`).ReplaceAllString(htmlContent, "./favicon.ico")
+	htmlContent = regexp.MustCompile(`/static/(styles\.css|favicon\.ico|theme\.js)`).
+		ReplaceAllString(htmlContent, "./$1")
 
 	// Add preload hint for styles.css to prevent FOUC.
 	htmlContent = regexp.MustCompile(`<head>`).
 		ReplaceAllString(htmlContent, `<head><link rel="preload" href="./styles.css" as="style">`)
 
 	// Unescape HTML entities in <script> tags to prevent issues with JavaScript execution.
-	reScript := regexp.MustCompile(`<script\b[^>]*>(.*?)</script>`)
+	reScript := regexp.MustCompile(`(<script\b[^>]*>)(.*?)</script>`)
 
 	return reScript.ReplaceAllStringFunc(htmlContent, func(script string) string {
-		// Extract the script content (between <script> and </script>).
-		reContent := regexp.MustCompile(`<script\b[^>]*>(.*?)</script>`)
-
-		matches := reContent.FindStringSubmatch(script)
+		// Extract the opening tag and the script content (between <script> and </script>).
+		matches := reScript.FindStringSubmatch(script)
 		if len(matches) < minMatchCount {
 			return script // Return unchanged if no content found.
 		}
 
-		content := matches[1]
+		openingTag, content := matches[1], matches[2]
 		// Unescape common HTML entities.
 		content = strings.ReplaceAll(content, "&quot;", "\"")
 		content = strings.ReplaceAll(content, "&amp;", "&")
 		content = strings.ReplaceAll(content, "&lt;", "<")
 		content = strings.ReplaceAll(content, "&gt;", ">")
 
-		return fmt.Sprintf("<script>%s</script>", content)
+		return openingTag + content + "</script>"
 	})
 }
 
-// replaceLayoutScript replaces the layout's inline JavaScript with includes for
-// WebAssembly runtime and application scripts, enabling client-side functionality.
+// replaceLayoutScript replaces the layout's application script, which relies on
+// HTMX, with includes for the WebAssembly runtime and application scripts,
+// enabling client-side functionality.
 func replaceLayoutScript(htmlContent string) string {
-	// Match the entire inline script, including copyToClipboard and htmx:afterSwap, with dotall mode.
-	formAttrRegex := regexp.MustCompile(
-		`(?s)<script>\s*function copyToClipboard\(elementId, buttonId\)\s*{.*?document\.body\.addEventListener\('htmx:afterSwap'.*?</script>`,
-	)
-	if !formAttrRegex.MatchString(htmlContent) {
+	appScriptRegex := regexp.MustCompile(`<script\s+src="/static/app\.js"[^>]*></script>`)
+	if !appScriptRegex.MatchString(htmlContent) {
 		fmt.Fprintf(
 			os.Stderr,
-			"Warning: Inline copyToClipboard script not found in HTML; WebAssembly scripts may not be included\n",
+			"Warning: Application script not found in HTML; WebAssembly scripts may not be included\n",
 		)
 	}
 
-	return formAttrRegex.ReplaceAllString(htmlContent, `<script src="./wasm_exec.js"></script>
+	return appScriptRegex.ReplaceAllString(htmlContent, `<script src="./wasm_exec.js"></script>
             <script src="./scripts.js"></script>`)
 }
 
-// fixInputPatterns fixes regex patterns in <input> elements to be compatible with
-// modern browsers using the 'v' flag for pattern validation, avoiding character
-// class ranges for the separator to prevent "invalid character in class" errors.
-func fixInputPatterns(htmlContent string) string {
-	// Match MAC address pattern: [0-9a-fA-F]{2}([-:][0-9a-fA-F]{2}){5}
-	macPatternRegex := regexp.MustCompile(
-		`pattern\s*=\s*"\[0\-9a\-fA\-F\]\{2\}\(\[\-:\]\[0\-9a\-fA\-F\]\{2\}\)\{5\}"`,
-	)
-	if macPatternRegex.MatchString(htmlContent) {
-		fmt.Fprintf(os.Stderr, "Info: MAC address pattern matched in HTML; applying regex fix\n")
-
-		return macPatternRegex.ReplaceAllStringFunc(htmlContent, func(match string) string {
-			fmt.Fprintf(os.Stderr, "Debug: Matched MAC address pattern: %s\n", match)
-
-			return `pattern="[0-9a-fA-F]{2}((-|:)[0-9a-fA-F]{2}){5}"`
-		})
-	}
-
-	fmt.Fprintf(
-		os.Stderr,
-		"Warning: MAC address pattern not found in HTML; regex fix not applied\n",
-	)
-
-	return htmlContent
-}
-
 // formatHTML parses the input HTML and returns a formatted version with newlines
 // and 2-space indentation for readability, using the html package to ensure
 // consistent tag separation.
@@ -7,6 +7,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/i18n"
 )
 
 // Test_main tests the main function's behavior by calling the run function directly.
@@ -59,22 +61,121 @@ func Test_main(t *testing.T) {
 				`<script src="./scripts.js">`,
 				"Should include application script",
 			)
+			assert.NotContains(
+				t,
+				htmlContent,
+				`pattern=`,
+				"Should leave validation to the validators",
+			)
+			assert.NotContains(
+				t,
+				htmlContent,
+				`hx-`,
+				"Should not contain HTMX attributes",
+			)
 			assert.Contains(
 				t,
 				htmlContent,
-				`pattern="[0-9a-fA-F]{2}((-|:)[0-9a-fA-F]{2}){5}"`,
-				"Should have fixed MAC address pattern",
+				`data-field-message="mac"`,
+				"Should contain the inline validation messages",
 			)
 			assert.NotContains(
 				t,
 				htmlContent,
-				`src="https://unpkg.com/htmx.org`,
-				"Should not contain HTMX CDN script",
+				`htmx.min.js`,
+				"Should not contain HTMX script",
+			)
+			assert.NotContains(
+				t,
+				htmlContent,
+				`/static/app.js`,
+				"Should not contain server application script",
+			)
+			assert.Contains(
+				t,
+				htmlContent,
+				`<script src="./theme.js">`,
+				"Should include theme script",
+			)
+			assert.Contains(
+				t,
+				htmlContent,
+				`data-theme-select`,
+				"Should include theme selector",
+			)
+
+			assert.Contains(
+				t,
+				htmlContent,
+				`<template id="offline-result">`,
+				"Should include the result template",
+			)
+			assert.Contains(
+				t,
+				htmlContent,
+				`<template id="offline-range-result">`,
+				"Should include the MAC range template",
+			)
+			assert.Contains(
+				t,
+				htmlContent,
+				`<template id="offline-plan-result">`,
+				"Should include the subnet plan template",
+			)
+			assert.Contains(
+				t,
+				htmlContent,
+				`<template id="offline-ula-result">`,
+				"Should include the ULA prefix template",
+			)
+			assert.Contains(
+				t,
+				htmlContent,
+				`<template id="offline-import-result">`,
+				"Should include the import template",
+			)
+			assert.Contains(
+				t,
+				htmlContent,
+				`<template id="offline-ra-result">`,
+				"Should include the simulation template",
 			)
+			assert.NotContains(t, htmlContent, "<noscript>", "Should not contain server fallbacks")
 
 			// Verify HTML is properly formatted (contains newlines and indentation)
 			assert.Contains(t, htmlContent, "\n", "HTML should be formatted with newlines")
 			assert.Contains(t, htmlContent, "  ", "HTML should be formatted with indentation")
+
+			// Verify a page is generated for every locale, linked from the language selector
+			for _, locale := range i18n.Locales() {
+				page, err := os.ReadFile(filepath.Join("dist", "static", pageName(locale.Tag)))
+				require.NoError(t, err, "Should generate a page for %s", locale.Tag)
+
+				assert.Contains(t, string(page), `<html lang="`+locale.Tag+`">`, "Incorrect page language")
+				assert.Contains(t, string(page), locale.T(i18n.KeyCalculate), "Page should be translated")
+				assert.Contains(
+					t,
+					htmlContent,
+					`data-href="`+pageURL(locale.Tag)+`"`,
+					"Language selector should link to the %s page",
+					locale.Tag,
+				)
+			}
+
+			// Verify an address analyzer page is generated for every locale,
+			// linking back to the calculator in the same locale
+			for _, locale := range i18n.Locales() {
+				page, err := os.ReadFile(filepath.Join("dist", "static", analyzerPageName(locale.Tag)))
+				require.NoError(t, err, "Should generate an analyzer page for %s", locale.Tag)
+
+				assert.Contains(t, string(page), locale.T(i18n.KeyAnalyzerTitle), "Page should be translated")
+				assert.Contains(t, string(page), `href="`+pageURL(locale.Tag)+`"`, "Should link to the calculator")
+				assert.Contains(t, string(page), `action="`+analyzerPageURL(locale.Tag)+`"`, "Should submit to itself")
+				assert.Contains(t, string(page), `data-href="`+analyzerPageURL(i18n.German.Tag)+`"`)
+				assert.NotContains(t, string(page), `hx-`, "Should not contain HTMX attributes")
+			}
+
+			assert.Contains(t, htmlContent, `href="./analyze.html"`, "Should link to the analyzer")
 		})
 	}
 }
@@ -109,6 +210,16 @@ func TestRemoveHTMXScript(t *testing.T) {
 			html: `<html><head><script src="https://unpkg.com/htmx.org@1.9.10"></script><script src="https://example.com/script.js"></script></head><body></body></html>`,
 			want: `<html><head><script src="https://example.com/script.js"></script></head><body></body></html>`,
 		},
+		{
+			name: "HTMX config meta tag",
+			html: `<html><head><meta name="htmx-config" content='{"allowEval":false}'/></head><body></body></html>`,
+			want: `<html><head></head><body></body></html>`,
+		},
+		{
+			name: "Vendored HTMX script with nonce",
+			html: `<html><head><script src="/static/htmx.min.js" nonce="abc"></script></head><body></body></html>`,
+			want: `<html><head></head><body></body></html>`,
+		},
 		{
 			name: "Empty HTML",
 			html: "",
@@ -141,16 +252,31 @@ func TestReplaceServerPaths(t *testing.T) {
 			html: `<html><head><link rel="stylesheet" href="/static/styles.css"><link rel="icon" href="/static/favicon.ico"></head><body></body></html>`,
 			want: `<html><head><link rel="preload" href="./styles.css" as="style"><link rel="stylesheet" href="./styles.css"><link rel="icon" href="./favicon.ico"></head><body></body></html>`,
 		},
+		{
+			name: "Replace theme script path",
+			html: `<html><head><script src="/static/theme.js"></script></head><body></body></html>`,
+			want: `<html><head><link rel="preload" href="./styles.css" as="style"><script src="./theme.js"></script></head><body></body></html>`,
+		},
 		{
 			name: "Remove hx-* attributes from form",
 			html: `<form hx-post="/calculate" hx-target="#result" hx-swap="innerHTML"><input type="text"></form>`,
 			want: `<form><input type="text"></form>`,
 		},
+		{
+			name: "Remove hx-* attributes from inputs",
+			html: `<input type="text" id="mac" hx-get="/validate/mac" hx-trigger="keyup changed delay:300ms" required>`,
+			want: `<input type="text" id="mac" required>`,
+		},
 		{
 			name: "Unescape HTML entities in script",
 			html: `<script>function test() { return ""hello" & <world>"; }</script>`,
 			want: `<script>function test() { return ""hello" & <world>"; }</script>`,
 		},
+		{
+			name: "Preserve script attributes",
+			html: `<script src="./scripts.js" defer></script>`,
+			want: `<script src="./scripts.js" defer></script>`,
+		},
 		{
 			name: "Complex HTML with multiple modifications",
 			html: `<html><head><link rel="stylesheet" href="/static/styles.css"><link rel="icon" href="/static/favicon.ico"></head><body><form hx-post="/calculate"><script>alert(""test"");</script></form></body></html>`,
@@ -179,7 +305,7 @@ func TestReplaceServerPaths(t *testing.T) {
 }
 
 // TestReplaceLayoutScript tests the replaceLayoutScript function with various HTML inputs.
-// It verifies that the inline JavaScript is correctly replaced with WebAssembly script includes.
+// It verifies that the application script is correctly replaced with WebAssembly script includes.
 func TestReplaceLayoutScript(t *testing.T) {
 	t.Parallel()
 
@@ -189,17 +315,16 @@ func TestReplaceLayoutScript(t *testing.T) {
 		want string
 	}{
 		{
-			name: "Replace inline script with WebAssembly includes",
-			html: `<script>
-function copyToClipboard(elementId, buttonId) {
-    // copy logic
-}
-document.body.addEventListener('htmx:afterSwap', function() {
-    // htmx logic
-});
-</script>`,
+			name: "Replace application script with WebAssembly includes",
+			html: `<script src="/static/app.js"></script>`,
 			want: `<script src="./wasm_exec.js"></script>
             <script src="./scripts.js"></script>`,
+		},
+		{
+			name: "Replace application script with nonce",
+			html: `<body><script src="/static/app.js" nonce="abc"></script></body>`,
+			want: `<body><script src="./wasm_exec.js"></script>
+            <script src="./scripts.js"></script></body>`,
 		},
 		{
 			name: "No matching script",
@@ -212,9 +337,9 @@ document.body.addEventListener('htmx:afterSwap', function() {
 			want: "",
 		},
 		{
-			name: "Script without htmx event listener",
-			html: `<script>function copyToClipboard() { /* logic */ }</script>`,
-			want: `<script>function copyToClipboard() { /* logic */ }</script>`,
+			name: "Other static script",
+			html: `<script src="/static/other.js"></script>`,
+			want: `<script src="/static/other.js"></script>`,
 		},
 	}
 
@@ -228,9 +353,9 @@ document.body.addEventListener('htmx:afterSwap', function() {
 	}
 }
 
-// TestFixInputPatterns tests the fixInputPatterns function with various HTML inputs.
-// It verifies that MAC address input patterns are correctly fixed for browser compatibility.
-func TestFixInputPatterns(t *testing.T) {
+// TestRemoveNoscript tests that removeNoscript strips <noscript> fallbacks,
+// including their content, and leaves other markup untouched.
+func TestRemoveNoscript(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
@@ -239,24 +364,14 @@ func TestFixInputPatterns(t *testing.T) {
 		want string
 	}{
 		{
-			name: "Fix MAC address pattern",
-			html: `<input type="text" pattern="[0-9a-fA-F]{2}([-:][0-9a-fA-F]{2}){5}">`,
-			want: `<input type="text" pattern="[0-9a-fA-F]{2}((-|:)[0-9a-fA-F]{2}){5}">`,
-		},
-		{
-			name: "No pattern to fix",
-			html: `<input type="text" pattern="[0-9]+">`,
-			want: `<input type="text" pattern="[0-9]+">`,
-		},
-		{
-			name: "Empty HTML",
-			html: "",
-			want: "",
+			name: "Remove noscript fallback",
+			html: "<form><select></select><noscript>\n<button type=\"submit\">Apply</button>\n</noscript></form>",
+			want: "<form><select></select></form>",
 		},
 		{
-			name: "Multiple inputs, only MAC pattern fixed",
-			html: `<input type="text" pattern="[0-9a-fA-F]{2}([-:][0-9a-fA-F]{2}){5}"><input type="text" pattern="[0-9]+">`,
-			want: `<input type="text" pattern="[0-9a-fA-F]{2}((-|:)[0-9a-fA-F]{2}){5}"><input type="text" pattern="[0-9]+">`,
+			name: "No noscript",
+			html: `<form><select></select></form>`,
+			want: `<form><select></select></form>`,
 		},
 	}
 
@@ -264,12 +379,60 @@ func TestFixInputPatterns(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			got := fixInputPatterns(tt.html)
-			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want, removeNoscript(tt.html))
 		})
 	}
 }
 
+// TestPageName tests that the default locale is written to index.html and the
+// other locales to pages named after their tag.
+func TestPageName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "index.html", pageName(i18n.Default().Tag))
+	assert.Equal(t, "de.html", pageName(i18n.German.Tag))
+	assert.Equal(t, "./fr.html", pageURL(i18n.French.Tag))
+}
+
+// TestAnalyzerPageName tests that the default locale's address analyzer is
+// written to analyze.html and the other locales' to pages prefixed with their tag.
+func TestAnalyzerPageName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "analyze.html", analyzerPageName(i18n.Default().Tag))
+	assert.Equal(t, "de-analyze.html", analyzerPageName(i18n.German.Tag))
+	assert.Equal(t, "./fr-analyze.html", analyzerPageURL(i18n.French.Tag))
+}
+
+// TestReplacePageLinks tests that links to the server's pages and the actions
+// of the analyzer's forms are replaced with the static pages of the given
+// locale.
+func TestReplacePageLinks(t *testing.T) {
+	t.Parallel()
+
+	got := replacePageLinks(`<a href="/">Back</a><a href="/analyze">Analyze</a><form action="/analyze"><form action="/duid">`, i18n.German.Tag)
+
+	assert.Equal(
+		t,
+		`<a href="./de.html">Back</a><a href="./de-analyze.html">Analyze</a><form action="./de-analyze.html"><form action="./de-analyze.html">`,
+		got,
+	)
+}
+
+// TestAddTemplate tests that addTemplate wraps the result markup in the
+// template cloned by the static client, at the end of the body.
+func TestAddTemplate(t *testing.T) {
+	t.Parallel()
+
+	got := addTemplate(`<html><body><form></form></body></html>`, "offline-result", `<div class="result"></div>`)
+
+	assert.Equal(
+		t,
+		`<html><body><form></form><template id="offline-result"><div class="result"></div></template></body></html>`,
+		got,
+	)
+}
+
 // TestFormatHTML tests the formatHTML function with various HTML inputs.
 // It verifies that HTML is correctly parsed and formatted with proper indentation and newlines.
 func TestFormatHTML(t *testing.T) {
@@ -1,6 +1,9 @@
 // Loads and initializes the WebAssembly module for EUI-64 calculations.
 const go = new Go();
-WebAssembly.instantiateStreaming(fetch("./main.wasm"), go.importObject)
+const wasmReady = WebAssembly.instantiateStreaming(
+  fetch("./main.wasm"),
+  go.importObject
+)
   .then((result) => {
     go.run(result.instance);
     console.log("WebAssembly module initialized");
@@ -16,7 +19,18 @@ WebAssembly.instantiateStreaming(fetch("./main.wasm"), go.importObject)
   })
   .catch((err) => console.error("Failed to load WebAssembly module:", err));
 
-// Copies the value of an input element to the clipboard and updates the button's tooltip to "Copied!" for 2 seconds.
+// Returns the messages the page provides, in its language, for text shown by this script.
+function messages() {
+  const form = document.querySelector("form[data-messages]");
+  try {
+    return form ? JSON.parse(form.dataset.messages) : {};
+  } catch (err) {
+    console.error("Invalid page messages:", err);
+    return {};
+  }
+}
+
+// Copies the value of an input element to the clipboard and updates the button's tooltip for 2 seconds.
 function copyToClipboard(elementId, buttonId) {
   const input = document.getElementById(elementId);
   const button = document.getElementById(buttonId);
@@ -33,21 +47,893 @@ function copyToClipboard(elementId, buttonId) {
     .then(() => {
       button.classList.add("copied");
       const tooltip = button.querySelector(".copy-tooltip");
-      tooltip.textContent = "Copied!";
+      const label = tooltip.textContent;
+      tooltip.textContent = messages().copied;
+      const announcer = document.getElementById("announcer");
+      if (announcer) {
+        announcer.textContent = messages().copied;
+      }
       setTimeout(() => {
         button.classList.remove("copied");
-        tooltip.textContent = "Copy";
+        tooltip.textContent = label;
+        if (announcer) {
+          announcer.textContent = "";
+        }
       }, 2000);
     })
     .catch((err) => console.error("Clipboard copy failed:", err));
 }
 
+// Escapes text for inclusion in HTML markup.
+function escapeHTML(text) {
+  const element = document.createElement("span");
+  element.textContent = text;
+  return element.innerHTML;
+}
+
+// Renders an error message with the same markup as the server, naming the form
+// field it refers to, if any. Given the input and the validation error returned
+// by a WebAssembly validator, it also renders the input with the part the error
+// refers to marked, when the error locates one. The message has the id of the
+// calculation error unless another is given, such as the subnet plan error's.
+function errorMarkup(message, field, input, error, id = "result-error") {
+  const fieldAttribute = field ? ` data-error-field="${field}"` : "";
+  let markup = `<p class="error-message" id="${id}" role="alert"${fieldAttribute}>${escapeHTML(message)}</p>`;
+  if (error && error.value) {
+    markup +=
+      `<p class="error-input"><code>${escapeHTML(input.slice(0, error.start))}` +
+      `<mark>${escapeHTML(input.slice(error.start, error.end))}</mark>` +
+      `${escapeHTML(input.slice(error.end))}</code></p>`;
+  }
+  return markup;
+}
+
+// Marks the form fields named by an error in the result, plan, ULA, analysis,
+// verification, import, or simulation container, or with an inline validation
+// message, as invalid and clears the state of any other field.
+function markInvalidField() {
+  const fields = new Set(
+    Array.from(
+      document.querySelectorAll(
+        ".result-container [data-error-field], .plan-result [data-error-field], .ula-result [data-error-field], .analysis-result [data-error-field], .duid-result [data-error-field], .verify-result [data-error-field], .import-result [data-error-field], .ra-result [data-error-field]"
+      ),
+      (error) => error.dataset.errorField
+    )
+  );
+
+  document.querySelectorAll("form [aria-errormessage]").forEach((input) => {
+    const message = document.querySelector(
+      `[data-field-message="${input.id}"]`
+    );
+    if (fields.has(input.id) || (message && message.textContent.trim())) {
+      input.setAttribute("aria-invalid", "true");
+    } else {
+      input.removeAttribute("aria-invalid");
+    }
+  });
+}
+
+// Returns the link type selected in a form, or an empty string, meaning
+// Ethernet, if the form has no link type selector.
+function linkType(form) {
+  const select = form && form.elements["link-type"];
+  return select ? select.value : "";
+}
+
+// Delay, in milliseconds, after the user stops typing before a field is validated.
+const VALIDATION_DELAY = 300;
+
+// Validates a form field with the named WebAssembly function and shows the
+// result in the field's inline validation message. Blank fields show no
+// message, as required fields are only enforced when the form is submitted.
+function showFieldMessage(input, validator) {
+  const message = document.querySelector(`[data-field-message="${input.id}"]`);
+  if (!message || typeof window[validator] !== "function") {
+    return;
+  }
+
+  const error = input.value.trim()
+    ? window[validator](input.value, linkType(input.form))
+    : "";
+  message.textContent = error ? error.message : "";
+  markInvalidField();
+}
+
+// Fills the prefix classification of a cloned result template from a
+// WebAssembly result, revealing the type and any warning about the prefix.
+function showPrefixType(fragment, result) {
+  const prefixType = fragment.querySelector(".prefix-type");
+  const warning = fragment.querySelector(".prefix-warning");
+  if (!prefixType || !warning) {
+    return;
+  }
+  prefixType.dataset.prefixType = result.prefixType;
+  prefixType.dataset.slaac = String(result.slaac);
+  prefixType.querySelector(".prefix-type-name").textContent =
+    result.prefixTypeName;
+  prefixType.hidden = !result.prefixType;
+  warning.textContent = result.prefixWarning;
+  warning.hidden = !result.prefixWarning;
+}
+
+// Fills the DUID list of a cloned result template from a WebAssembly result,
+// revealing it when the MAC address has DUIDs.
+function showDUIDs(fragment, result) {
+  const list = fragment.querySelector(".duid-list");
+  if (!list) {
+    return;
+  }
+  list.querySelector(".duid-ll").textContent = result.duidLL;
+  list.querySelector(".duid-llt").textContent = result.duidLLT;
+  list.hidden = !result.duidLL;
+}
+
+// The calculator's form fields, by the calculateRange argument they provide.
+const RANGE_FIELDS = {
+  start: "mac",
+  end: "mac-end",
+  count: "mac-count",
+  prefix: "ip-start",
+  linkType: "link-type",
+};
+
+// The calculator's DUID fields, by the calculateEUI64 argument they provide.
+const DUID_FIELDS = {
+  hardware: "duid-hardware",
+  time: "duid-time",
+};
+
+// Returns the values of the calculator form's DUID fields by the
+// calculateEUI64 argument they provide, empty for fields the form lacks.
+function duidValues(form) {
+  return Object.fromEntries(
+    Object.entries(DUID_FIELDS).map(([arg, id]) => {
+      const input = form.elements[id];
+      return [arg, input ? input.value : ""];
+    })
+  );
+}
+
+// Reports whether the calculator form's MAC range fields are filled in, making
+// its MAC address the start of a range.
+function isRange(form) {
+  return [RANGE_FIELDS.end, RANGE_FIELDS.count].some((id) => {
+    const input = form.elements[id];
+    return input && input.value.trim() !== "";
+  });
+}
+
+// Calculates the calculator form's MAC range with WebAssembly and shows its
+// addresses with the page's range template, in the page's language, or the
+// error explaining which field is invalid, in the result container.
+function showRange(form, container) {
+  const template = document.getElementById("offline-range-result");
+  if (typeof window.calculateRange !== "function" || !template) {
+    container.innerHTML = errorMarkup(messages().unavailable);
+    markInvalidField();
+    return;
+  }
+
+  const values = Object.fromEntries(
+    Object.entries(RANGE_FIELDS).map(([arg, id]) => [
+      arg,
+      form.elements[id].value,
+    ])
+  );
+  const result = window.calculateRange(
+    values.start,
+    values.end,
+    values.count,
+    values.prefix,
+    values.linkType
+  );
+  if (typeof result === "string") {
+    container.innerHTML = errorMarkup(`${messages().calculation}: ${result}`);
+  } else if (result.message) {
+    container.innerHTML = errorMarkup(
+      result.message,
+      RANGE_FIELDS[result.input],
+      values[result.input],
+      result
+    );
+  } else {
+    const fragment = template.content.cloneNode(true);
+    fragment.querySelector("caption").textContent = result.caption;
+    const body = fragment.querySelector("tbody");
+    result.addresses.forEach((address) => {
+      const row = document.createElement("tr");
+      [address.mac, address.interfaceID, address.fullIP].forEach((value) => {
+        const cell = document.createElement("td");
+        const code = document.createElement("code");
+        code.textContent = value;
+        cell.append(code);
+        row.append(cell);
+      });
+      body.append(row);
+    });
+    showPrefixType(fragment, result);
+    container.replaceChildren(fragment);
+  }
+  markInvalidField();
+}
+
+// The subnet planner's form fields, by the planSubnets argument they provide.
+const PLAN_FIELDS = {
+  mac: "plan-mac",
+  parent: "plan-parent",
+  ids: "plan-ids",
+};
+
+// Renders a subnet plan computed by WebAssembly with the page's plan template,
+// in the page's language, adding a table row per subnet.
+function planFragment(template, plan) {
+  const fragment = template.content.cloneNode(true);
+  fragment.querySelector(".plan-interface-id").textContent = plan.interfaceID;
+
+  const body = fragment.querySelector("tbody");
+  plan.subnets.forEach((subnet) => {
+    const row = document.createElement("tr");
+    const id = document.createElement("td");
+    id.textContent = subnet.id;
+    row.append(id);
+    [subnet.prefix, subnet.address].forEach((value) => {
+      const cell = document.createElement("td");
+      const code = document.createElement("code");
+      code.textContent = value;
+      cell.append(code);
+      row.append(cell);
+    });
+    body.append(row);
+  });
+
+  return fragment;
+}
+
+// Plans the subnet planner form's addresses with WebAssembly and shows the
+// plan, or the error explaining which field is invalid, in its container.
+function showPlan(form, container) {
+  const template = document.getElementById("offline-plan-result");
+  if (typeof window.planSubnets !== "function" || !template) {
+    container.innerHTML = errorMarkup(
+      messages().unavailable,
+      "",
+      "",
+      null,
+      "plan-error"
+    );
+    markInvalidField();
+    return;
+  }
+
+  const values = Object.fromEntries(
+    Object.entries(PLAN_FIELDS).map(([arg, id]) => [
+      arg,
+      form.elements[id].value,
+    ])
+  );
+  const plan = window.planSubnets(values.mac, values.parent, values.ids);
+  if (typeof plan === "string") {
+    container.innerHTML = errorMarkup(
+      `${messages().calculation}: ${plan}`,
+      "",
+      "",
+      null,
+      "plan-error"
+    );
+  } else if (plan.message) {
+    container.innerHTML = errorMarkup(
+      plan.message,
+      PLAN_FIELDS[plan.input],
+      values[plan.input],
+      plan,
+      "plan-error"
+    );
+  } else {
+    container.replaceChildren(planFragment(template, plan));
+  }
+  markInvalidField();
+}
+
+// Generates a ULA prefix with WebAssembly from the ULA generator form and the
+// MAC address entered in the calculator form, and shows it, or the error
+// explaining why it could not be generated, in its container.
+function showULA(form, container) {
+  const template = document.getElementById("offline-ula-result");
+  if (typeof window.generateULA !== "function" || !template) {
+    container.innerHTML = errorMarkup(
+      messages().unavailable,
+      "",
+      "",
+      null,
+      "ula-error"
+    );
+    markInvalidField();
+    return;
+  }
+
+  const values = {
+    mac: document.getElementById("mac").value,
+    subnet: form.elements["ula-subnet"].value,
+  };
+  const ula = window.generateULA(
+    values.mac,
+    form.elements["ula-method"].value,
+    values.subnet
+  );
+  if (typeof ula === "string") {
+    container.innerHTML = errorMarkup(
+      `${messages().calculation}: ${ula}`,
+      "",
+      "",
+      null,
+      "ula-error"
+    );
+  } else if (ula.message) {
+    // The MAC address field belongs to the calculator form, so only the subnet
+    // ID field is marked invalid, as the server does.
+    container.innerHTML = errorMarkup(
+      ula.message,
+      ula.input === "subnet" ? "ula-subnet" : "",
+      values[ula.input],
+      ula,
+      "ula-error"
+    );
+  } else {
+    const fragment = template.content.cloneNode(true);
+    fragment.querySelector(".ula-prefix").textContent = ula.prefix;
+    fragment.querySelector(".ula-subnet").textContent = ula.subnet;
+    fragment.querySelector("[data-use-prefix]").dataset.usePrefix = ula.value;
+    container.replaceChildren(fragment);
+  }
+  markInvalidField();
+}
+
+// Enters the subnet of a generated ULA prefix into the IPv6 prefix field and
+// validates it as if the user had typed it.
+function usePrefix(button) {
+  const input = document.getElementById("ip-start");
+  if (!input) {
+    return;
+  }
+
+  input.value = button.dataset.usePrefix;
+  input.dispatchEvent(new KeyboardEvent("keyup"));
+  input.focus();
+}
+
+document.addEventListener("click", (event) => {
+  const button = event.target.closest("[data-use-prefix]");
+  if (button) {
+    usePrefix(button);
+  }
+});
+
+// The name the address matrix's CSV file is downloaded as, as from the server.
+const MATRIX_FILENAME = "eui64-matrix.csv";
+
+// Builds the address matrix form's CSV with WebAssembly and downloads it, or
+// shows the error explaining why it could not be built in its container.
+function downloadMatrix(form, container) {
+  if (typeof window.matrixCSV !== "function") {
+    container.innerHTML = errorMarkup(
+      messages().unavailable,
+      "",
+      "",
+      null,
+      "matrix-error"
+    );
+    return;
+  }
+
+  const csv = window.matrixCSV(
+    form.elements["matrix-macs"].value,
+    form.elements["matrix-prefixes"].value
+  );
+  if (typeof csv !== "string") {
+    container.innerHTML = errorMarkup(csv.message, "", "", null, "matrix-error");
+    return;
+  }
+
+  container.innerHTML = "";
+  const url = URL.createObjectURL(new Blob([csv], { type: "text/csv" }));
+  const link = document.createElement("a");
+  link.href = url;
+  link.download = MATRIX_FILENAME;
+  link.click();
+  URL.revokeObjectURL(url);
+}
+
+// The address verifier's form fields, by the verifyAddress argument they
+// provide.
+const VERIFY_FIELDS = {
+  mac: "verify-mac",
+  address: "verify-address",
+  prefix: "verify-prefix",
+};
+
+// The importer's form fields, by the importMACs argument they provide.
+const IMPORT_FIELDS = {
+  file: "import-file",
+  format: "import-format",
+  prefix: "import-prefix",
+};
+
+// Imports the MAC addresses of the file chosen in the importer form with
+// WebAssembly and shows their addresses with the page's import template, in the
+// page's language, or the error explaining which field is invalid, in its
+// container.
+async function showImport(form, container) {
+  const template = document.getElementById("offline-import-result");
+  if (typeof window.importMACs !== "function" || !template) {
+    container.innerHTML = errorMarkup(
+      messages().unavailable,
+      "",
+      "",
+      null,
+      "import-error"
+    );
+    markInvalidField();
+    return;
+  }
+
+  const file = form.elements[IMPORT_FIELDS.file].files[0];
+  const values = {
+    file: file ? await file.text() : "",
+    format: form.elements[IMPORT_FIELDS.format].value,
+    prefix: form.elements[IMPORT_FIELDS.prefix].value,
+  };
+  const result = window.importMACs(values.file, values.format, values.prefix);
+  if (typeof result === "string") {
+    container.innerHTML = errorMarkup(
+      `${messages().calculation}: ${result}`,
+      "",
+      "",
+      null,
+      "import-error"
+    );
+  } else if (result.message) {
+    container.innerHTML = errorMarkup(
+      result.message,
+      IMPORT_FIELDS[result.input],
+      values[result.input],
+      result,
+      "import-error"
+    );
+  } else {
+    const fragment = template.content.cloneNode(true);
+    fragment.querySelector("caption").textContent = result.caption;
+    const body = fragment.querySelector("tbody");
+    result.rows.forEach((entry) => {
+      const row = document.createElement("tr");
+      const line = document.createElement("td");
+      line.textContent = entry.line;
+      const mac = document.createElement("td");
+      const code = document.createElement("code");
+      code.textContent = entry.mac;
+      mac.append(code);
+      const hostname = document.createElement("td");
+      hostname.textContent = entry.hostname;
+      row.append(line, mac, hostname);
+      if (entry.error) {
+        const error = document.createElement("td");
+        error.className = "import-row-error";
+        error.colSpan = 2;
+        error.textContent = entry.error;
+        row.append(error);
+      } else {
+        [entry.interfaceID, entry.fullIP].forEach((value) => {
+          const cell = document.createElement("td");
+          const address = document.createElement("code");
+          address.textContent = value;
+          cell.append(address);
+          row.append(cell);
+        });
+      }
+      body.append(row);
+    });
+    showPrefixType(fragment, result);
+    container.replaceChildren(fragment);
+  }
+  markInvalidField();
+}
+
+// The Router Advertisement simulator's form fields, by the simulateRA argument
+// they provide.
+const RA_FIELDS = {
+  mac: "ra-mac",
+  prefixes: "ra-prefixes",
+};
+
+// Simulates the Router Advertisement entered in the simulator form with
+// WebAssembly and shows the addresses the host forms with the page's
+// simulation template, in the page's language, or the error explaining which
+// field is invalid, in its container.
+function showSimulation(form, container) {
+  const template = document.getElementById("offline-ra-result");
+  if (typeof window.simulateRA !== "function" || !template) {
+    container.innerHTML = errorMarkup(
+      messages().unavailable,
+      "",
+      "",
+      null,
+      "ra-error"
+    );
+    markInvalidField();
+    return;
+  }
+
+  const values = Object.fromEntries(
+    Object.entries(RA_FIELDS).map(([arg, id]) => [arg, form.elements[id].value])
+  );
+  const result = window.simulateRA(values.mac, values.prefixes);
+  if (typeof result === "string") {
+    container.innerHTML = errorMarkup(
+      `${messages().calculation}: ${result}`,
+      "",
+      "",
+      null,
+      "ra-error"
+    );
+  } else if (result.message) {
+    container.innerHTML = errorMarkup(
+      result.message,
+      RA_FIELDS[result.input],
+      values[result.input],
+      result,
+      "ra-error"
+    );
+  } else {
+    const fragment = template.content.cloneNode(true);
+    fragment.querySelector(".ra-interface-id").textContent = result.interfaceID;
+    const body = fragment.querySelector("tbody");
+    result.rows.forEach((entry) => {
+      const row = document.createElement("tr");
+      const prefix = document.createElement("td");
+      const code = document.createElement("code");
+      code.textContent = entry.prefix;
+      prefix.append(code);
+      row.append(prefix);
+      [entry.flags, entry.valid, entry.preferred].forEach((value) => {
+        const cell = document.createElement("td");
+        cell.textContent = value;
+        row.append(cell);
+      });
+      const outcome = document.createElement("td");
+      if (entry.status) {
+        outcome.className = "ra-row-status";
+        outcome.textContent = entry.status;
+      } else {
+        const address = document.createElement("code");
+        address.textContent = entry.address;
+        outcome.append(address);
+        if (entry.deprecated) {
+          const deprecated = document.createElement("span");
+          deprecated.className = "ra-deprecated";
+          deprecated.textContent = entry.deprecated;
+          outcome.append(" ", deprecated);
+        }
+      }
+      row.append(outcome);
+      body.append(row);
+    });
+    container.replaceChildren(fragment);
+  }
+  markInvalidField();
+}
+
+// Verifies the pair entered in the address verifier form with WebAssembly and
+// shows the verdict and the facts supporting it, or the error explaining why it
+// could not be verified, in its container, with the same markup as the server's.
+function showVerification(form, container) {
+  if (typeof window.verifyAddress !== "function") {
+    container.innerHTML = errorMarkup(
+      messages().unavailable,
+      "",
+      "",
+      null,
+      "verify-error"
+    );
+    markInvalidField();
+    return;
+  }
+
+  const values = Object.fromEntries(
+    Object.entries(VERIFY_FIELDS).map(([arg, id]) => [
+      arg,
+      form.elements[id].value,
+    ])
+  );
+  const result = window.verifyAddress(values.mac, values.address, values.prefix);
+  if (typeof result === "string") {
+    container.innerHTML = errorMarkup(
+      `${messages().calculation}: ${result}`,
+      "",
+      "",
+      null,
+      "verify-error"
+    );
+  } else if (result.message) {
+    container.innerHTML = errorMarkup(
+      result.message,
+      VERIFY_FIELDS[result.input],
+      values[result.input],
+      result,
+      "verify-error"
+    );
+  } else {
+    const verdict = document.createElement("p");
+    verdict.className = "verify-verdict";
+    verdict.dataset.verifyStatus = result.status;
+    verdict.textContent = result.verdict;
+    const list = document.createElement("dl");
+    list.className = "verify-facts";
+    result.facts.forEach((fact) => {
+      const label = document.createElement("dt");
+      label.textContent = fact.label;
+      const value = document.createElement("dd");
+      const code = document.createElement("code");
+      code.textContent = fact.value;
+      value.append(code);
+      list.append(label, value);
+    });
+    container.replaceChildren(verdict, list);
+  }
+  markInvalidField();
+}
+
+// The name the verification CSV file is downloaded as, as from the server.
+const VERIFY_FILENAME = "eui64-verification.csv";
+
+// Verifies the pairs entered as CSV in the address verifier with WebAssembly
+// and downloads the outcomes as CSV, or shows the error explaining why they
+// could not be verified in its container.
+function downloadVerification(form, container) {
+  if (typeof window.verifyCSV !== "function") {
+    container.innerHTML = errorMarkup(
+      messages().unavailable,
+      "",
+      "",
+      null,
+      "verify-csv-error"
+    );
+    return;
+  }
+
+  const csv = window.verifyCSV(form.elements["verify-csv"].value);
+  if (typeof csv !== "string") {
+    container.innerHTML = errorMarkup(
+      csv.message,
+      "",
+      "",
+      null,
+      "verify-csv-error"
+    );
+    return;
+  }
+
+  container.innerHTML = "";
+  const url = URL.createObjectURL(new Blob([csv], { type: "text/csv" }));
+  const link = document.createElement("a");
+  link.href = url;
+  link.download = VERIFY_FILENAME;
+  link.click();
+  URL.revokeObjectURL(url);
+}
+
+// Returns the description list of facts describing an analyzed address or a
+// decoded DUID, with the same markup as the server's.
+function factsElement(facts) {
+  const list = document.createElement("dl");
+  list.className = "analysis-facts";
+  facts.forEach((fact) => {
+    const label = document.createElement("dt");
+    label.textContent = fact.label;
+    const value = document.createElement("dd");
+    const code = document.createElement("code");
+    code.textContent = fact.value;
+    value.append(code);
+    list.append(label, value);
+  });
+  return list;
+}
+
+// Analyzes the address entered in the address analyzer form with WebAssembly
+// and shows the facts describing it, or the error explaining why it could not
+// be analyzed, in its container, with the same markup as the server's.
+function showAnalysis(form, container) {
+  if (typeof window.analyzeAddress !== "function") {
+    container.innerHTML = errorMarkup(
+      messages().unavailable,
+      "",
+      "",
+      null,
+      "analyzer-error"
+    );
+    markInvalidField();
+    return;
+  }
+
+  const analysis = window.analyzeAddress(form.elements.address.value);
+  if (typeof analysis === "string") {
+    container.innerHTML = errorMarkup(
+      `${messages().calculation}: ${analysis}`,
+      "",
+      "",
+      null,
+      "analyzer-error"
+    );
+  } else if (analysis.message) {
+    container.innerHTML = errorMarkup(
+      analysis.message,
+      "address",
+      "",
+      null,
+      "analyzer-error"
+    );
+  } else {
+    container.replaceChildren(factsElement(analysis.facts));
+  }
+  markInvalidField();
+}
+
+// Decodes the DUID entered in the DUID decoder form with WebAssembly and shows
+// the facts describing it, or the error explaining why it could not be
+// decoded, in its container, with the same markup as the server's.
+function showDUID(form, container) {
+  if (typeof window.decodeDUID !== "function") {
+    container.innerHTML = errorMarkup(
+      messages().unavailable,
+      "",
+      "",
+      null,
+      "duid-error"
+    );
+    markInvalidField();
+    return;
+  }
+
+  const decoded = window.decodeDUID(form.elements.duid.value);
+  if (typeof decoded === "string") {
+    container.innerHTML = errorMarkup(
+      `${messages().calculation}: ${decoded}`,
+      "",
+      "",
+      null,
+      "duid-error"
+    );
+  } else if (decoded.message) {
+    container.innerHTML = errorMarkup(
+      decoded.message,
+      "duid",
+      "",
+      null,
+      "duid-error"
+    );
+  } else {
+    container.replaceChildren(factsElement(decoded.facts));
+  }
+  markInvalidField();
+}
+
+// Sets up the address analyzer form, analyzing the address given in the page's
+// URL, if any, once WebAssembly is ready, so analyses can be linked to.
+document.addEventListener("DOMContentLoaded", () => {
+  const form = document.querySelector("form[data-analyzer-form]");
+  const container = document.getElementById("analysis-result");
+  if (!form || !container) {
+    return;
+  }
+
+  form.addEventListener("submit", (e) => {
+    e.preventDefault();
+    showAnalysis(form, container);
+  });
+  form.addEventListener("input", (event) => {
+    event.target.removeAttribute("aria-invalid");
+  });
+
+  const address = new URLSearchParams(window.location.search).get("address");
+  if (address) {
+    form.elements.address.value = address;
+    wasmReady.then(() => showAnalysis(form, container));
+  }
+});
+
+// Sets up the DUID decoder form on the address analyzer page, decoding the DUID
+// given in the page's URL, if any, once WebAssembly is ready, so decoded DUIDs
+// can be linked to.
+document.addEventListener("DOMContentLoaded", () => {
+  const form = document.querySelector("form[data-duid-form]");
+  const container = document.getElementById("duid-result");
+  if (!form || !container) {
+    return;
+  }
+
+  form.addEventListener("submit", (e) => {
+    e.preventDefault();
+    showDUID(form, container);
+  });
+  form.addEventListener("input", (event) => {
+    event.target.removeAttribute("aria-invalid");
+  });
+
+  const duid = new URLSearchParams(window.location.search).get("duid");
+  if (duid) {
+    form.elements.duid.value = duid;
+    form.closest("details").open = true;
+    wasmReady.then(() => showDUID(form, container));
+  }
+});
+
+// Returns the pressed key combination in the aria-keyshortcuts syntax.
+function keyCombination(event) {
+  const keys = [
+    ["Control", event.ctrlKey],
+    ["Alt", event.altKey],
+    ["Shift", event.shiftKey],
+    ["Meta", event.metaKey],
+  ]
+    .filter(([, pressed]) => pressed)
+    .map(([name]) => name);
+  keys.push(event.code.startsWith("Key") ? event.code.slice(3) : event.key);
+  return keys.join("+");
+}
+
+// Handles the keyboard shortcuts declared by elements' aria-keyshortcuts
+// attributes: fields are focused and buttons are clicked. Escape only clears
+// the form while focus is inside it.
+document.addEventListener("keydown", (event) => {
+  if (event.repeat || event.isComposing) {
+    return;
+  }
+
+  const combination = keyCombination(event);
+  const target = Array.from(
+    document.querySelectorAll("[aria-keyshortcuts]")
+  ).find((element) =>
+    element.getAttribute("aria-keyshortcuts").split(" ").includes(combination)
+  );
+  if (!target) {
+    return;
+  }
+  if (
+    combination === "Escape" &&
+    !(target.form && target.form.contains(event.target))
+  ) {
+    return;
+  }
+
+  event.preventDefault();
+  if (target.matches("input, select, textarea")) {
+    target.focus();
+    target.select();
+  } else {
+    target.click();
+  }
+});
+
+// Switches to the page for the language chosen in the language selector.
+document.addEventListener("change", (event) => {
+  if (!event.target.matches("[data-language-select]")) {
+    return;
+  }
+
+  const option = event.target.selectedOptions[0];
+  if (option && option.dataset.href) {
+    window.location.href = option.dataset.href;
+  }
+});
+
 // Sets up form event listeners for submission and clearing, handling input validation and EUI-64 calculation via WebAssembly.
 document.addEventListener("DOMContentLoaded", () => {
+  // The address analyzer page has no calculator; it is set up above.
+  if (document.querySelector("form[data-analyzer-form]")) {
+    return;
+  }
+
   // Retrieve DOM elements for form interaction.
   const form = document.querySelector("form");
   const resultContainer = document.querySelector(".result-container");
-  const formResults = document.querySelector(".form-results");
   const macInput = document.getElementById("mac");
   const prefixInput = document.getElementById("ip-start");
   const copyMac = document.getElementById("copy-mac");
@@ -57,7 +943,6 @@ document.addEventListener("DOMContentLoaded", () => {
   if (
     !form ||
     !resultContainer ||
-    !formResults ||
     !macInput ||
     !prefixInput ||
     !copyMac ||
@@ -83,80 +968,123 @@ document.addEventListener("DOMContentLoaded", () => {
     copyToClipboard("ip-start", "copy-ip-start")
   );
 
+  // Validate each field as the user types, once typing pauses and the value
+  // has changed, like the server's live validation.
+  [
+    [macInput, "validateMAC"],
+    [prefixInput, "validateIPv6Prefix"],
+  ].forEach(([input, validator]) => {
+    let timer;
+    let lastValue = input.value;
+    input.addEventListener("keyup", () => {
+      if (input.value === lastValue) {
+        return;
+      }
+      lastValue = input.value;
+      clearTimeout(timer);
+      timer = setTimeout(
+        () => showFieldMessage(input, validator),
+        VALIDATION_DELAY
+      );
+    });
+  });
+
+  // Revalidate the MAC address field as an address of the newly selected link
+  // type.
+  const linkTypeSelect = form.elements["link-type"];
+  if (linkTypeSelect) {
+    linkTypeSelect.addEventListener("change", () =>
+      showFieldMessage(macInput, "validateMAC")
+    );
+  }
+
   // Handle form submission for EUI-64 calculation.
   form.addEventListener("submit", (e) => {
     e.preventDefault(); // Prevent default form submission behavior.
     console.log("Form submitted");
 
-    // Clear previous results and show result container.
+    // Clear previous results.
     resultContainer.innerHTML = "";
-    formResults.classList.remove("hidden");
 
     const mac = macInput.value;
     const prefix = prefixInput.value;
 
     // Ensure WebAssembly validation function is available.
     if (typeof window.validateMAC !== "function") {
-      resultContainer.innerHTML = `<p class="error-message">Error: WebAssembly module not loaded</p>`;
-      resultContainer.classList.remove("hidden");
+      resultContainer.innerHTML = errorMarkup(messages().unavailable);
+      markInvalidField();
+      return;
+    }
+
+    // An end address or count makes the MAC address the start of a range.
+    if (isRange(form)) {
+      showRange(form, resultContainer);
       return;
     }
 
-    // Validate MAC address.
-    let macErr = window.validateMAC(mac);
+    // Validate MAC address, showing the explanation of what is wrong with it and
+    // marking where.
+    let macErr = window.validateMAC(mac, linkType(form));
     if (macErr) {
-      resultContainer.innerHTML = `<p class="error-message">Invalid MAC address (e.g., 00-14-22-01-23-45): ${macErr}</p>`;
-      resultContainer.classList.remove("hidden");
+      resultContainer.innerHTML = errorMarkup(macErr.message, "mac", mac, macErr);
+      markInvalidField();
       return;
     }
 
     // Validate IPv6 prefix.
     let prefixErr = window.validateIPv6Prefix(prefix);
     if (prefixErr) {
-      resultContainer.innerHTML = `<p class="error-message">Invalid IPv6 prefix (e.g., 2001:db8::): ${prefixErr}</p>`;
-      resultContainer.classList.remove("hidden");
+      resultContainer.innerHTML = errorMarkup(
+        prefixErr.message,
+        "ip-start",
+        prefix,
+        prefixErr
+      );
+      markInvalidField();
       return;
     }
 
     // Calculate EUI-64 address.
-    let result = window.calculateEUI64(mac, prefix);
+    const duid = duidValues(form);
+    let result = window.calculateEUI64(
+      mac,
+      prefix,
+      linkType(form),
+      duid.hardware,
+      duid.time
+    );
     if (typeof result === "string") {
-      resultContainer.innerHTML = `<p class="error-message">EUI-64 calculation failed: ${result}</p>`;
-      resultContainer.classList.remove("hidden");
+      resultContainer.innerHTML = errorMarkup(
+        `${messages().calculation}: ${result}`
+      );
+      markInvalidField();
+      return;
+    }
+    if (result.message) {
+      resultContainer.innerHTML = errorMarkup(
+        result.message,
+        DUID_FIELDS[result.input],
+        duid[result.input],
+        result
+      );
+      markInvalidField();
       return;
     }
 
-    // Render result HTML with interface ID and full IPv6 address.
-    resultContainer.innerHTML = `
-      <div class="form-field-container">
-        <label class="form-label" for="interface-id">End of IPv6 Address</label>
-        <div class="input-copy-container">
-          <input type="text" class="form-field" id="interface-id" readonly value="${result.interfaceID}" aria-describedby="interface-id-copy"/>
-          <button class="copy-button" id="copy-interface" aria-label="Copy Interface ID">
-            <svg class="copy-icon" width="16" height="16" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round">
-              <rect x="9" y="9" width="13" height="13" rx="2" ry="2"></rect>
-              <path d="M5 15H4a2 2 0 0 1-2-2V4a2 2 0 0 1 2-2h9a2 2 0 0 1 2 2v1"></path>
-            </svg>
-            <span class="copy-tooltip">Copy</span>
-          </button>
-        </div>
-      </div>
-      <br/>
-      <div class="form-field-container">
-        <label class="form-label" for="ip-full">IPv6 Address</label>
-        <div class="input-copy-container">
-          <input type="text" class="form-field" id="ip-full" readonly value="${result.fullIP}" aria-describedby="ip-full-copy"/>
-          <button class="copy-button" id="copy-ip-full" aria-label="Copy IPv6 Address">
-            <svg class="copy-icon" width="16" height="16" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round">
-              <rect x="9" y="9" width="13" height="13" rx="2" ry="2"></rect>
-              <path d="M5 15H4a2 2 0 0 1-2-2V4a2 2 0 0 1 2-2h9a2 2 0 0 1 2 2v1"></path>
-            </svg>
-            <span class="copy-tooltip">Copy</span>
-          </button>
-        </div>
-      </div>
-    `;
-    resultContainer.classList.remove("hidden");
+    // Render the result with the page's result template, in the page's language.
+    const template = document.getElementById("offline-result");
+    if (!template) {
+      resultContainer.innerHTML = errorMarkup(messages().unavailable);
+      markInvalidField();
+      return;
+    }
+    const fragment = template.content.cloneNode(true);
+    fragment.querySelector("#interface-id").value = result.interfaceID;
+    fragment.querySelector("#ip-full").value = result.fullIP;
+    showPrefixType(fragment, result);
+    showDUIDs(fragment, result);
+    resultContainer.replaceChildren(fragment);
+    markInvalidField();
 
     // Attach event listeners to result copy buttons, ensuring no duplicates.
     const copyInterface = document.getElementById("copy-interface");
@@ -179,10 +1107,136 @@ document.addEventListener("DOMContentLoaded", () => {
     }
   });
 
-  // Clear form results and hide containers on clear button click.
-  document.querySelector(".form-clear").addEventListener("click", () => {
+  // Clear form results, inline validation messages and the invalid state they
+  // set when the form is reset.
+  form.addEventListener("reset", () => {
     resultContainer.innerHTML = "";
-    formResults.classList.add("hidden");
-    resultContainer.classList.add("hidden");
+    document
+      .querySelectorAll("[data-field-message]")
+      .forEach((message) => message.replaceChildren());
+    markInvalidField();
+  });
+
+  // Clear a field's invalid state as soon as it is edited.
+  form.addEventListener("input", (event) => {
+    event.target.removeAttribute("aria-invalid");
   });
+
+  // Plan subnets with the subnet planner form, clearing the plan on reset.
+  const planForm = document.querySelector("form[data-plan-form]");
+  const planContainer = document.getElementById("plan-result");
+  if (planForm && planContainer) {
+    planForm.addEventListener("submit", (e) => {
+      e.preventDefault();
+      showPlan(planForm, planContainer);
+    });
+    planForm.addEventListener("reset", () => {
+      planContainer.innerHTML = "";
+      markInvalidField();
+    });
+    planForm.addEventListener("input", (event) => {
+      event.target.removeAttribute("aria-invalid");
+    });
+  }
+
+  // Generate ULA prefixes with the ULA generator form.
+  const ulaForm = document.querySelector("form[data-ula-form]");
+  const ulaContainer = document.getElementById("ula-result");
+  if (ulaForm && ulaContainer) {
+    ulaForm.addEventListener("submit", (e) => {
+      e.preventDefault();
+      showULA(ulaForm, ulaContainer);
+    });
+    ulaForm.addEventListener("input", (event) => {
+      event.target.removeAttribute("aria-invalid");
+    });
+  }
+
+  // Download the address matrix as CSV, clearing any error on reset.
+  const matrixForm = document.querySelector("form[data-matrix-form]");
+  const matrixContainer = document.getElementById("matrix-result");
+  if (matrixForm && matrixContainer) {
+    matrixForm.addEventListener("submit", (e) => {
+      e.preventDefault();
+      downloadMatrix(matrixForm, matrixContainer);
+    });
+    matrixForm.addEventListener("reset", () => {
+      matrixContainer.innerHTML = "";
+    });
+  }
+
+  // Verify observed addresses with the address verifier, one pair at a time or
+  // from CSV, clearing the outcome on reset.
+  const verifyForm = document.querySelector("form[data-verify-form]");
+  const verifyContainer = document.getElementById("verify-result");
+  if (verifyForm && verifyContainer) {
+    verifyForm.addEventListener("submit", (e) => {
+      e.preventDefault();
+      showVerification(verifyForm, verifyContainer);
+    });
+    verifyForm.addEventListener("reset", () => {
+      verifyContainer.innerHTML = "";
+      markInvalidField();
+    });
+    verifyForm.addEventListener("input", (event) => {
+      event.target.removeAttribute("aria-invalid");
+    });
+  }
+  const verifyCSVForm = document.querySelector("form[data-verify-csv-form]");
+  const verifyCSVContainer = document.getElementById("verify-csv-result");
+  if (verifyCSVForm && verifyCSVContainer) {
+    verifyCSVForm.addEventListener("submit", (e) => {
+      e.preventDefault();
+      downloadVerification(verifyCSVForm, verifyCSVContainer);
+    });
+    verifyCSVForm.addEventListener("reset", () => {
+      verifyCSVContainer.innerHTML = "";
+    });
+  }
+
+  // Import MAC addresses from a lease file or neighbor table with the importer,
+  // clearing the table on reset.
+  const importForm = document.querySelector("form[data-import-form]");
+  const importContainer = document.getElementById("import-result");
+  if (importForm && importContainer) {
+    importForm.addEventListener("submit", (e) => {
+      e.preventDefault();
+      showImport(importForm, importContainer).catch((err) => {
+        console.error("Import failed:", err);
+        importContainer.innerHTML = errorMarkup(
+          messages().unavailable,
+          "",
+          "",
+          null,
+          "import-error"
+        );
+        markInvalidField();
+      });
+    });
+    importForm.addEventListener("reset", () => {
+      importContainer.innerHTML = "";
+      markInvalidField();
+    });
+    importForm.addEventListener("input", (event) => {
+      event.target.removeAttribute("aria-invalid");
+    });
+  }
+
+  // Simulate the addresses a host forms from a Router Advertisement with the
+  // simulator, clearing the table on reset.
+  const raForm = document.querySelector("form[data-ra-form]");
+  const raContainer = document.getElementById("ra-result");
+  if (raForm && raContainer) {
+    raForm.addEventListener("submit", (e) => {
+      e.preventDefault();
+      showSimulation(raForm, raContainer);
+    });
+    raForm.addEventListener("reset", () => {
+      raContainer.innerHTML = "";
+      markInvalidField();
+    });
+    raForm.addEventListener("input", (event) => {
+      event.target.removeAttribute("aria-invalid");
+    });
+  }
 });
@@ -2,70 +2,614 @@
 // +build js,wasm
 
 // Package main provides a WebAssembly module for client-side EUI-64 calculations.
-// It exposes functions to validate MAC addresses, IPv6 prefixes, and compute EUI-64
-// identifiers, integrating with the browser's JavaScript environment.
+// It exposes functions to validate MAC addresses, IEEE 802.15.4 and Bluetooth
+// LE addresses, interface ID tokens, and IPv6 prefixes, compute EUI-64
+// identifiers of single MAC addresses or ranges of them and the identifiers of
+// the other link types' addresses, plan them across subnets, build address
+// matrices as CSV, generate ULA prefixes, analyze addresses, verify observed
+// addresses against MAC addresses, import MAC addresses from DHCP lease files
+// and neighbor tables, simulate the addresses hosts form from Router
+// Advertisements, and build and decode DHCPv6 DUIDs, integrating with the
+// browser's JavaScript environment.
+// Error messages are translated into the language of the page.
 package main
 
 import (
+	"crypto/rand"
+	"errors"
+	"net/netip"
+	"strconv"
+	"strings"
 	"syscall/js"
+	"time"
+	"unicode/utf16"
 
+	"github.com/nicholas-fedor/eui64-calculator/internal/analyzer"
+	"github.com/nicholas-fedor/eui64-calculator/internal/classify"
+	"github.com/nicholas-fedor/eui64-calculator/internal/duid"
 	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+	"github.com/nicholas-fedor/eui64-calculator/internal/i18n"
+	"github.com/nicholas-fedor/eui64-calculator/internal/importer"
+	"github.com/nicholas-fedor/eui64-calculator/internal/macrange"
+	"github.com/nicholas-fedor/eui64-calculator/internal/matrix"
+	"github.com/nicholas-fedor/eui64-calculator/internal/subnet"
+	"github.com/nicholas-fedor/eui64-calculator/internal/ula"
 	"github.com/nicholas-fedor/eui64-calculator/internal/validators"
+	"github.com/nicholas-fedor/eui64-calculator/internal/verify"
 )
 
+// subnetIDBase is the base subnet IDs are returned in, as in addresses.
+const subnetIDBase = 16
+
+// linkLocalPrefix is the prefix of the link-local address a host forms
+// regardless of Router Advertisements, as returned.
+const linkLocalPrefix = "fe80::/64"
+
 // main initializes the WebAssembly module, registering JavaScript functions and
 // keeping the module alive in the browser event loop.
 func main() {
 	js.Global().Set("validateMAC", js.FuncOf(validateMACFunc))
 	js.Global().Set("validateIPv6Prefix", js.FuncOf(validateIPv6PrefixFunc))
 	js.Global().Set("calculateEUI64", js.FuncOf(calculateEUI64Func))
+	js.Global().Set("calculateRange", js.FuncOf(calculateRangeFunc))
+	js.Global().Set("planSubnets", js.FuncOf(planSubnetsFunc))
+	js.Global().Set("matrixCSV", js.FuncOf(matrixCSVFunc))
+	js.Global().Set("generateULA", js.FuncOf(generateULAFunc))
+	js.Global().Set("analyzeAddress", js.FuncOf(analyzeAddressFunc))
+	js.Global().Set("verifyAddress", js.FuncOf(verifyAddressFunc))
+	js.Global().Set("verifyCSV", js.FuncOf(verifyCSVFunc))
+	js.Global().Set("importMACs", js.FuncOf(importMACsFunc))
+	js.Global().Set("simulateRA", js.FuncOf(simulateRAFunc))
+	js.Global().Set("decodeDUID", js.FuncOf(decodeDUIDFunc))
 	<-make(chan bool) // Block indefinitely to keep WASM module active.
 }
 
-// validateMACFunc validates a MAC address string provided via JavaScript.
-// It expects a single string argument and returns an empty string on success or
-// an error message on failure.
+// validateMACFunc validates a MAC address string provided via JavaScript, or
+// the link-layer address of another link type. It expects the address and,
+// optionally, its link type, see eui64.LinkTypes, Ethernet by default, and
+// returns an empty string on success or an object describing the error on
+// failure, see validationResult.
 func validateMACFunc(this js.Value, args []js.Value) any {
-	if len(args) != 1 {
+	if len(args) != 1 && len(args) != 2 {
 		return "Invalid number of arguments"
 	}
 	mac := args[0].String()
-	if err := validators.ValidateMAC(mac); err != nil {
-		return err.Error()
+	link, err := linkTypeArg(args, 1)
+	if err == nil {
+		err = validators.ValidateLinkAddress(link, mac)
+	}
+	if err != nil {
+		return validationResult(err)
 	}
 	return ""
 }
 
+// linkTypeArg returns the link type given by the argument at index i, Ethernet
+// if it is missing, undefined, or empty.
+func linkTypeArg(args []js.Value, i int) (eui64.LinkType, error) {
+	if i >= len(args) || args[i].IsUndefined() || args[i].IsNull() {
+		return eui64.LinkEthernet, nil
+	}
+	return eui64.ParseLinkType(args[i].String())
+}
+
+// stringArg returns the string argument at index i, or an empty string if it
+// is missing, undefined, or null.
+func stringArg(args []js.Value, i int) string {
+	if i >= len(args) || args[i].IsUndefined() || args[i].IsNull() {
+		return ""
+	}
+	return args[i].String()
+}
+
 // validateIPv6PrefixFunc validates an IPv6 prefix string provided via JavaScript.
 // It expects a single string argument and returns an empty string on success or
-// an error message on failure.
+// an object describing the error on failure, see validationResult.
 func validateIPv6PrefixFunc(this js.Value, args []js.Value) any {
 	if len(args) != 1 {
 		return "Invalid number of arguments"
 	}
 	prefix := args[0].String()
 	if err := validators.ValidateIPv6Prefix(prefix); err != nil {
-		return err.Error()
+		return validationResult(err)
 	}
 	return ""
 }
 
 // calculateEUI64Func computes the EUI-64 interface ID and full IPv6 address from
 // a MAC address and IPv6 prefix provided via JavaScript. It expects two string
-// arguments (MAC and prefix) and returns a JavaScript object with "interfaceID"
-// and "fullIP" fields on success, or an error message on failure.
+// arguments (MAC and prefix) and, optionally, the link type of the address, see
+// eui64.LinkTypes, Ethernet by default, and the hardware type and time of the
+// DUIDs of Ethernet MAC addresses, see duid.ParseHardware and duid.ParseTime,
+// Ethernet and now by default. It returns a JavaScript object with
+// "interfaceID" and "fullIP" fields, the "duidLL" and "duidLLT" fields holding
+// the DUIDs of Ethernet MAC addresses, and the classification of the prefix,
+// its "prefixType", "prefixTypeName", "slaac", and translated "prefixWarning"
+// fields, on success, or a translated error message on failure. Invalid DUID
+// arguments return the object describing the error instead, see
+// validationResult, with an "input" field naming the argument at fault:
+// "hardware" or "time".
 func calculateEUI64Func(this js.Value, args []js.Value) any {
-	if len(args) != 2 {
+	if len(args) < 2 || len(args) > 5 {
 		return "Invalid number of arguments"
 	}
 	mac := args[0].String()
 	prefix := args[1].String()
-	interfaceID, fullIP, err := eui64.CalculateEUI64(mac, prefix)
+	link, err := linkTypeArg(args, 2)
 	if err != nil {
-		return err.Error()
+		return pageLocale().Error(err)
 	}
-	return js.ValueOf(map[string]any{
+	interfaceID, fullIP, err := eui64.CalculateLinkAddress(link, mac, prefix)
+	if err != nil {
+		return pageLocale().Error(err)
+	}
+	result := map[string]any{
 		"interfaceID": interfaceID,
 		"fullIP":      fullIP,
+		"duidLL":      "",
+		"duidLLT":     "",
+	}
+	if link != eui64.LinkEthernet {
+		return js.ValueOf(withClassification(result, prefix))
+	}
+	hardware, err := duid.ParseHardware(stringArg(args, 3))
+	if err != nil {
+		return planError("hardware", err)
+	}
+	generated, err := duid.ParseTime(stringArg(args, 4), time.Now())
+	if err != nil {
+		return planError("time", err)
+	}
+	if ll, err := duid.LL(mac, hardware); err == nil {
+		result["duidLL"] = duid.Format(ll)
+	}
+	llt, err := duid.LLT(mac, hardware, generated)
+	if err != nil {
+		return planError("time", err)
+	}
+	result["duidLLT"] = duid.Format(llt)
+	return js.ValueOf(withClassification(result, prefix))
+}
+
+// calculateRangeFunc computes the EUI-64 addresses of a range of MAC addresses
+// in an IPv6 prefix provided via JavaScript, as the server's calculator does
+// when an end address or a count is given. It expects four string arguments,
+// the start MAC address, the end MAC address or block length, the count, and
+// the prefix, and, optionally, the link type, which must be Ethernet. It
+// returns a JavaScript object with a translated "caption", an
+// "addresses" field, each address having "mac", "interfaceID", and "fullIP"
+// fields, and the classification of the prefix, see calculateEUI64Func, on
+// success. On failure it returns the object describing the error, see
+// validationResult, with an "input" field naming the argument at fault:
+// "start", "end", "count", "prefix", or "linkType".
+func calculateRangeFunc(this js.Value, args []js.Value) any {
+	if len(args) != 4 && len(args) != 5 {
+		return "Invalid number of arguments"
+	}
+	link, err := linkTypeArg(args, 4)
+	if err != nil {
+		return planError("linkType", err)
+	}
+	if link != eui64.LinkEthernet {
+		return planError("linkType", macrange.ErrNotEthernet)
+	}
+	prefix := args[3].String()
+	locale := pageLocale()
+	macs, err := macrange.Parse(args[0].String(), args[1].String(), args[2].String(), macrange.DefaultMaxCount)
+	var inputErr *macrange.InputError
+	if errors.As(err, &inputErr) {
+		return planError(string(inputErr.Input), err)
+	}
+	if err != nil {
+		return locale.Error(err)
+	}
+	if err := validators.ValidateIPv6Prefix(prefix); err != nil {
+		return planError("prefix", err)
+	}
+	addresses, err := macrange.Calculate(&eui64.DefaultCalculator{}, macs, prefix)
+	if err != nil {
+		return locale.Error(err)
+	}
+	rows := make([]any, 0, len(addresses))
+	for _, address := range addresses {
+		rows = append(rows, map[string]any{
+			"mac":         address.MAC,
+			"interfaceID": address.InterfaceID,
+			"fullIP":      address.FullIP,
+		})
+	}
+	return js.ValueOf(withClassification(map[string]any{
+		"caption":   locale.T(i18n.KeyRangeCaption, len(addresses)),
+		"addresses": rows,
+	}, prefix))
+}
+
+// importMACsFunc imports the MAC addresses of a DHCP lease file or neighbor
+// table provided via JavaScript and calculates their EUI-64 addresses in a
+// prefix, as the server's importer does. It expects three string arguments,
+// the content of the file, its format, see importer.Formats, and the prefix,
+// and returns a JavaScript object with a translated "caption", the "format" the
+// file was read in, a "rows" field, each row having "line", "mac", "hostname",
+// "interfaceID", "fullIP", and translated "error" fields, and the
+// classification of the prefix, see calculateEUI64Func, on success. On failure
+// it returns the object describing the error, see validationResult, with an
+// "input" field naming the argument at fault: "file", "format", or "prefix".
+func importMACsFunc(this js.Value, args []js.Value) any {
+	if len(args) != 3 {
+		return "Invalid number of arguments"
+	}
+	data, err := importer.Read(strings.NewReader(args[0].String()))
+	if err != nil {
+		return planError("file", err)
+	}
+	format, err := importer.ParseFormat(args[1].String())
+	if err != nil {
+		return planError("format", err)
+	}
+	entries, format, err := importer.Parse(data, format)
+	if errors.Is(err, importer.ErrInvalidFile) {
+		return planError("format", err)
+	}
+	if err != nil {
+		return planError("file", err)
+	}
+	prefix := strings.TrimSpace(args[2].String())
+	if err := validators.ValidateIPv6Prefix(prefix); err != nil {
+		return planError("prefix", err)
+	}
+	locale := pageLocale()
+	results := importer.Calculate(&eui64.DefaultCalculator{}, entries, prefix)
+	rows := make([]any, 0, len(results))
+	for _, result := range results {
+		message := ""
+		if result.Err != nil {
+			message = locale.Error(result.Err)
+		}
+		rows = append(rows, map[string]any{
+			"line":        result.Line,
+			"mac":         result.MAC,
+			"hostname":    result.Hostname,
+			"interfaceID": result.InterfaceID,
+			"fullIP":      result.FullIP,
+			"error":       message,
+		})
+	}
+	return js.ValueOf(withClassification(map[string]any{
+		"caption": locale.T(i18n.KeyImportCaption, len(rows), format.Name()),
+		"format":  string(format),
+		"rows":    rows,
+	}, prefix))
+}
+
+// simulateRAFunc simulates the addresses a host forms from a Router
+// Advertisement from a MAC address and its Prefix Information options, one per
+// line, provided via JavaScript. It expects two string arguments and returns a
+// JavaScript object with "interfaceID" and "rows" fields on success, the rows
+// starting with the link-local address, each having "prefix", "flags",
+// "valid", "preferred", "address", and translated "deprecated" and "status"
+// fields as the server's table shows them, "deprecated" being empty unless the
+// address is. On failure it returns the object
+// describing the error, see validationResult, with an "input" field naming the
+// argument at fault: "mac" or "prefixes".
+func simulateRAFunc(this js.Value, args []js.Value) any {
+	if len(args) != 2 {
+		return "Invalid number of arguments"
+	}
+	mac := args[0].String()
+	if err := validators.ValidateMAC(mac); err != nil {
+		return planError("mac", err)
+	}
+	prefixes, err := eui64.ParsePrefixInformation(args[1].String())
+	if err != nil {
+		return planError("prefixes", err)
+	}
+	locale := pageLocale()
+	simulation, err := eui64.Simulate(&eui64.DefaultCalculator{}, mac, prefixes)
+	if err != nil {
+		return locale.Error(err)
+	}
+	rows := make([]any, 0, len(simulation.Addresses)+1)
+	rows = append(rows, map[string]any{
+		"prefix":     linkLocalPrefix,
+		"flags":      locale.T(i18n.KeyRALinkLocal),
+		"valid":      locale.Lifetime(eui64.InfiniteLifetime),
+		"preferred":  locale.Lifetime(eui64.InfiniteLifetime),
+		"address":    simulation.LinkLocal,
+		"deprecated": "",
+		"status":     "",
+	})
+	for _, simulated := range simulation.Addresses {
+		deprecated := ""
+		if simulated.Deprecated {
+			deprecated = locale.T(i18n.KeyRADeprecated)
+		}
+		rows = append(rows, map[string]any{
+			"prefix":     simulated.Prefix.String(),
+			"flags":      simulated.Flags(),
+			"valid":      locale.Lifetime(simulated.ValidLifetime),
+			"preferred":  locale.Lifetime(simulated.PreferredLifetime),
+			"address":    simulated.Address,
+			"deprecated": deprecated,
+			"status":     locale.SLAACStatus(simulated.Status),
+		})
+	}
+	return js.ValueOf(map[string]any{
+		"interfaceID": simulation.InterfaceID,
+		"rows":        rows,
+	})
+}
+
+// withClassification adds the classification of the prefix to a calculation's
+// result: its "prefixType", "prefixTypeName", "slaac", and translated
+// "prefixWarning" fields. The prefix is left unclassified if it fails.
+func withClassification(result map[string]any, prefix string) map[string]any {
+	classification, _ := classify.Prefix(prefix)
+	locale := pageLocale()
+	result["prefixType"] = string(classification.Type)
+	result["prefixTypeName"] = locale.PrefixType(classification.Type)
+	result["slaac"] = classification.SLAAC
+	result["prefixWarning"] = locale.PrefixWarning(classification.Warning)
+	return result
+}
+
+// planSubnetsFunc computes the EUI-64 addresses of a host across the subnets of
+// a parent prefix from a MAC address, a parent prefix, and a list of subnet IDs
+// provided via JavaScript. It expects three string arguments and returns a
+// JavaScript object with "interfaceID" and "subnets" fields, each subnet having
+// "id", "prefix", and "address" fields, on success. On failure it returns the
+// object describing the error, see validationResult, with an "input" field
+// naming the argument at fault: "mac", "parent", or "ids".
+func planSubnetsFunc(this js.Value, args []js.Value) any {
+	if len(args) != 3 {
+		return "Invalid number of arguments"
+	}
+	mac := args[0].String()
+	if err := validators.ValidateMAC(mac); err != nil {
+		return planError("mac", err)
+	}
+	parent, err := subnet.ParseParent(args[1].String())
+	if err != nil {
+		return planError("parent", err)
+	}
+	ids, err := subnet.ParseIDs(args[2].String(), parent)
+	if err != nil {
+		return planError("ids", err)
+	}
+	plan, err := subnet.Compute(mac, parent, ids)
+	if err != nil {
+		return pageLocale().Error(err)
+	}
+	subnets := make([]any, 0, len(plan.Subnets))
+	for _, planned := range plan.Subnets {
+		subnets = append(subnets, map[string]any{
+			"id":      strconv.FormatUint(planned.ID, subnetIDBase),
+			"prefix":  planned.Prefix.String(),
+			"address": planned.Address,
+		})
+	}
+	return js.ValueOf(map[string]any{
+		"interfaceID": plan.InterfaceID,
+		"subnets":     subnets,
+	})
+}
+
+// matrixCSVFunc builds the CSV of the EUI-64 address of every MAC address in
+// every prefix from lists of MAC addresses and prefixes provided via JavaScript,
+// as the server's matrix mode streams it. It expects two string arguments and
+// returns the CSV as a string on success, or an object with a translated
+// "message" field if a list is empty or the matrix is too large.
+func matrixCSVFunc(this js.Value, args []js.Value) any {
+	if len(args) != 2 {
+		return "Invalid number of arguments"
+	}
+	macs := matrix.ParseList(args[0].String())
+	prefixes := matrix.ParseList(args[1].String())
+	locale := pageLocale()
+	if err := matrix.Check(macs, prefixes); err != nil {
+		return js.ValueOf(map[string]any{"message": locale.Error(err)})
+	}
+	var csv strings.Builder
+	if err := matrix.WriteCSV(&csv, matrix.Cells(&eui64.DefaultCalculator{}, macs, prefixes), locale.Error); err != nil {
+		return js.ValueOf(map[string]any{"message": locale.Error(err)})
+	}
+	return csv.String()
+}
+
+// generateULAFunc generates an RFC 4193 ULA prefix from a MAC address, a method,
+// and a subnet ID provided via JavaScript, as the server's ULA generator does:
+// the Global ID is derived from the current time and the MAC address unless the
+// method is "random". It expects three string arguments and returns a
+// JavaScript object with "prefix", "subnet", and "value" fields on success, the
+// value being the subnet as entered in the IPv6 prefix field. On failure it
+// returns the object describing the error, see validationResult, with an
+// "input" field naming the argument at fault: "mac" or "subnet".
+func generateULAFunc(this js.Value, args []js.Value) any {
+	if len(args) != 3 {
+		return "Invalid number of arguments"
+	}
+	id, err := ula.ParseSubnetID(args[2].String())
+	if err != nil {
+		return planError("subnet", err)
+	}
+	var prefix netip.Prefix
+	if args[1].String() == "random" {
+		prefix, err = ula.Random(rand.Reader)
+	} else {
+		mac := args[0].String()
+		if err := validators.ValidateMAC(mac); err != nil {
+			return planError("mac", err)
+		}
+		prefix, err = ula.Derive(mac, time.Now())
+	}
+	if err != nil {
+		return pageLocale().Error(err)
+	}
+	chosen, err := ula.Subnet(prefix, id)
+	if err != nil {
+		return pageLocale().Error(err)
+	}
+	return js.ValueOf(map[string]any{
+		"prefix": prefix.String(),
+		"subnet": chosen.String(),
+		"value":  subnet.Hextets(chosen),
+	})
+}
+
+// analyzeAddressFunc analyzes an IPv6 address provided via JavaScript as the
+// server's address analyzer does. It expects a single string argument and
+// returns a JavaScript object with a "facts" field listing the facts describing
+// the address, each having translated "label" and "value" fields, on success,
+// or an object with a translated "message" field if the address is invalid.
+func analyzeAddressFunc(this js.Value, args []js.Value) any {
+	if len(args) != 1 {
+		return "Invalid number of arguments"
+	}
+	locale := pageLocale()
+	analysis, err := analyzer.Analyze(args[0].String())
+	if err != nil {
+		return js.ValueOf(map[string]any{"message": locale.Error(err)})
+	}
+	facts := make([]any, 0)
+	for _, fact := range locale.Facts(analysis) {
+		facts = append(facts, map[string]any{
+			"label": fact.Label,
+			"value": fact.Value,
+		})
+	}
+	return js.ValueOf(map[string]any{"facts": facts})
+}
+
+// decodeDUIDFunc decodes a DHCPv6 DUID provided via JavaScript as hexadecimal
+// bytes, optionally separated by colons, hyphens, or spaces. It expects a
+// single string argument and returns a JavaScript object with a "facts" field
+// listing the components of the DUID, each having translated "label" and
+// "value" fields, and a "mac" field holding the MAC address of DUIDs based on
+// an Ethernet address, empty for others, on success, or an object with a
+// translated "message" field if the DUID is invalid.
+func decodeDUIDFunc(this js.Value, args []js.Value) any {
+	if len(args) != 1 {
+		return "Invalid number of arguments"
+	}
+	locale := pageLocale()
+	decoded, err := duid.Parse(args[0].String())
+	if err != nil {
+		return js.ValueOf(map[string]any{"message": locale.Error(err)})
+	}
+	facts := make([]any, 0)
+	for _, fact := range locale.DUIDFacts(decoded) {
+		facts = append(facts, map[string]any{
+			"label": fact.Label,
+			"value": fact.Value,
+		})
+	}
+	return js.ValueOf(map[string]any{
+		"facts": facts,
+		"mac":   decoded.MAC(),
+	})
+}
+
+// verifyAddressFunc verifies an address observed for a MAC address, provided via
+// JavaScript with the prefix it is expected in, as the server's address verifier
+// does. It expects three string arguments, the prefix being empty to accept the
+// address in any prefix, and returns a JavaScript object with "status",
+// translated "verdict", and "facts" fields, each fact having translated "label"
+// and "value" fields, on success. On failure it returns the object describing
+// the error, see validationResult, with an "input" field naming the argument at
+// fault: "mac", "address", or "prefix".
+func verifyAddressFunc(this js.Value, args []js.Value) any {
+	if len(args) != 3 {
+		return "Invalid number of arguments"
+	}
+	result := verify.Verify(&eui64.DefaultCalculator{}, verify.Pair{
+		MAC:     args[0].String(),
+		Address: args[1].String(),
+		Prefix:  args[2].String(),
+	})
+	locale := pageLocale()
+	var inputErr *verify.InputError
+	if errors.As(result.Err, &inputErr) {
+		return planError(string(inputErr.Input), result.Err)
+	}
+	if result.Err != nil {
+		return locale.Error(result.Err)
+	}
+	facts := make([]any, 0)
+	for _, fact := range locale.VerificationFacts(result) {
+		facts = append(facts, map[string]any{
+			"label": fact.Label,
+			"value": fact.Value,
+		})
+	}
+	return js.ValueOf(map[string]any{
+		"status":  string(result.Status),
+		"verdict": locale.Verdict(result),
+		"facts":   facts,
 	})
 }
+
+// verifyCSVFunc verifies pairs of MAC addresses and observed addresses provided
+// via JavaScript as CSV, as the server's address verifier streams them. It
+// expects a single string argument and returns the CSV of the outcomes as a
+// string on success, or an object with a translated "message" field if the CSV
+// is malformed, empty, or has too many pairs.
+func verifyCSVFunc(this js.Value, args []js.Value) any {
+	if len(args) != 1 {
+		return "Invalid number of arguments"
+	}
+	locale := pageLocale()
+	pairs, err := verify.ParseCSV(strings.NewReader(args[0].String()))
+	if err != nil {
+		return js.ValueOf(map[string]any{"message": locale.Error(err)})
+	}
+	var csv strings.Builder
+	if err := verify.WriteCSV(&csv, verify.Results(&eui64.DefaultCalculator{}, pairs), locale.Error); err != nil {
+		return js.ValueOf(map[string]any{"message": locale.Error(err)})
+	}
+	return csv.String()
+}
+
+// planError returns the object describing a MAC range, subnet plan, ULA
+// generator, verification, import, or simulation error, see validationResult,
+// naming the argument at fault in its "input" field.
+func planError(input string, err error) any {
+	result := validationResult(err)
+	result.Set("input", input)
+	return result
+}
+
+// validationResult returns a JavaScript object describing a validation error:
+// its translated "message", the "field" and "code" of the rule it breaks and,
+// when the error locates the offending part of the input, its "value" and the
+// UTF-16 "start" and "end" indices of that part, as used by JavaScript strings.
+func validationResult(err error) js.Value {
+	result := map[string]any{
+		"message": pageLocale().Error(err),
+		"field":   "",
+		"code":    "",
+		"value":   "",
+		"start":   0,
+		"end":     0,
+	}
+
+	var validationErr *validators.ValidationError
+	if errors.As(err, &validationErr) {
+		result["field"] = string(validationErr.Field)
+		result["code"] = string(validationErr.Code)
+		result["value"] = validationErr.Value
+		result["start"] = utf16Length(validationErr.Input[:validationErr.Offset])
+		result["end"] = utf16Length(validationErr.Input[:validationErr.Offset+len(validationErr.Value)])
+	}
+
+	return js.ValueOf(result)
+}
+
+// utf16Length returns the length of s in UTF-16 code units.
+func utf16Length(s string) int {
+	return len(utf16.Encode([]rune(s)))
+}
+
+// pageLocale returns the locale matching the lang attribute of the page's
+// root element, or the default locale if it has none.
+func pageLocale() *i18n.Locale {
+	return i18n.Match(js.Global().Get("document").Get("documentElement").Get("lang").String())
+}
@@ -0,0 +1,362 @@
+// Package main provides the eui64-interfaces command, which predicts the SLAAC
+// addresses of the host's own network interfaces. For every interface with a
+// MAC address, skipping loopback interfaces, it calculates the EUI-64
+// link-local address and, given a prefix, the global address, and compares
+// them with the addresses configured on the interface to report whether it
+// uses EUI-64 addressing, EUI-64 addressing with another MAC address, static
+// addressing, privacy addressing, or a mix.
+//
+// Usage:
+//
+//	eui64-interfaces [-prefix 2001:db8:0:0] [-json]
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/analyzer"
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+	"github.com/nicholas-fedor/eui64-calculator/internal/validators"
+	"github.com/nicholas-fedor/eui64-calculator/internal/verify"
+)
+
+// Addressing describes how the addresses configured on an interface were
+// formed.
+type Addressing string
+
+// Addressing schemes reported for interfaces. Interfaces configuring addresses
+// of several schemes report them joined with "+", such as "eui64+static".
+const (
+	AddressingEUI64    Addressing = "eui64"     // AddressingEUI64 configures only EUI-64 addresses of the interface's MAC address.
+	AddressingOtherMAC Addressing = "other_mac" // AddressingOtherMAC configures only EUI-64 addresses of another MAC address, as bridges and bonds may.
+	AddressingStatic   Addressing = "static"    // AddressingStatic configures only low-byte addresses, such as ::1, as manual and DHCPv6 addressing do.
+	AddressingPrivacy  Addressing = "privacy"   // AddressingPrivacy configures only addresses formed otherwise, such as privacy addresses.
+	AddressingNone     Addressing = "none"      // AddressingNone configures no IPv6 addresses.
+)
+
+// addressingSeparator joins the schemes of interfaces configuring several.
+const addressingSeparator = "+"
+
+// addressingSchemes lists the addressing schemes in the order they are joined.
+var addressingSchemes = []Addressing{AddressingEUI64, AddressingOtherMAC, AddressingStatic, AddressingPrivacy}
+
+// Constants defining the command's layout.
+const (
+	// linkLocalPrefix is the prefix of link-local addresses, as the calculator takes it.
+	linkLocalPrefix = "fe80::"
+	// macBytes is the size of the MAC addresses EUI-64 identifiers are derived from.
+	macBytes = 6
+	// Exit statuses of the command.
+	exitFailure = 1
+	exitUsage   = 2
+	// Column layout of the text report.
+	tabMinWidth = 0
+	tabWidth    = 8
+	tabPadding  = 2
+)
+
+// Static error variables.
+var (
+	ErrListInterfaces = errors.New("listing network interfaces")
+	ErrNoInterfaces   = errors.New("no network interfaces with a MAC address")
+)
+
+// hostInterface is a network interface of the host and its IPv6 addresses.
+type hostInterface struct {
+	Name      string
+	MAC       string       // MAC is the interface's MAC address, as six pairs of digits separated by hyphens.
+	Addresses []netip.Addr // Addresses are the IPv6 addresses configured on the interface.
+}
+
+// Report describes the predicted and configured addresses of an interface.
+type Report struct {
+	Name                string          `json:"name"`
+	MAC                 string          `json:"mac"`
+	LinkLocal           string          `json:"eui64_link_local"`
+	LinkLocalConfigured bool            `json:"eui64_link_local_configured"`
+	Global              string          `json:"eui64_global,omitempty"`
+	GlobalConfigured    bool            `json:"eui64_global_configured"`
+	Addressing          Addressing      `json:"addressing"`
+	Addresses           []AddressReport `json:"addresses"`
+}
+
+// AddressReport describes an address configured on an interface, verified
+// against the interface's MAC address.
+type AddressReport struct {
+	Address string           `json:"address"`
+	Status  verify.Status    `json:"status"`
+	IIDType analyzer.IIDType `json:"iid_type"`
+}
+
+// main runs the command on the host's network interfaces and exits with its
+// status.
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr, localInterfaces))
+}
+
+// run parses the command line, reports on the interfaces returned by list, and
+// returns the exit status: 0 on success, 1 if the interfaces cannot be
+// reported on, and 2 for invalid usage.
+func run(args []string, stdout, stderr io.Writer, list func() ([]hostInterface, error)) int {
+	flags := flag.NewFlagSet("eui64-interfaces", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	prefix := flags.String("prefix", "", "IPv6 prefix, up to four hextets, to predict global addresses in (e.g., 2001:db8:0:0)")
+	asJSON := flags.Bool("json", false, "report as JSON")
+
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if *prefix != "" {
+		if err := validators.ValidateIPv6Prefix(*prefix); err != nil {
+			fmt.Fprintf(stderr, "invalid -prefix: %v\n", err)
+
+			return exitUsage
+		}
+	}
+
+	interfaces, err := list()
+	if err == nil && len(interfaces) == 0 {
+		err = ErrNoInterfaces
+	}
+
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+
+		return exitFailure
+	}
+
+	reports := make([]Report, 0, len(interfaces))
+
+	for _, iface := range interfaces {
+		report, err := newReport(&eui64.DefaultCalculator{}, iface, *prefix)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", iface.Name, err)
+
+			return exitFailure
+		}
+
+		reports = append(reports, report)
+	}
+
+	if *asJSON {
+		err = writeJSON(stdout, reports)
+	} else {
+		err = writeText(stdout, reports)
+	}
+
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+
+		return exitFailure
+	}
+
+	return 0
+}
+
+// localInterfaces returns the host's network interfaces with a MAC address,
+// skipping loopback interfaces and those whose hardware addresses are not
+// 48-bit MAC addresses, such as InfiniBand interfaces.
+func localInterfaces() ([]hostInterface, error) {
+	interfaces, err := net.Interfaces()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrListInterfaces, err)
+	}
+
+	var hosts []hostInterface
+
+	for _, iface := range interfaces {
+		if iface.Flags&net.FlagLoopback != 0 || len(iface.HardwareAddr) != macBytes {
+			continue
+		}
+
+		addrs, err := iface.Addrs()
+		if err != nil {
+			return nil, fmt.Errorf("%w: addresses of %s: %w", ErrListInterfaces, iface.Name, err)
+		}
+
+		host := hostInterface{
+			Name:      iface.Name,
+			MAC:       strings.ReplaceAll(iface.HardwareAddr.String(), ":", "-"),
+			Addresses: nil,
+		}
+
+		for _, addr := range addrs {
+			ipNet, ok := addr.(*net.IPNet)
+			if !ok {
+				continue
+			}
+
+			ip, ok := netip.AddrFromSlice(ipNet.IP)
+			if ok && ip.Is6() && !ip.Is4In6() {
+				host.Addresses = append(host.Addresses, ip)
+			}
+		}
+
+		hosts = append(hosts, host)
+	}
+
+	return hosts, nil
+}
+
+// newReport predicts the EUI-64 addresses of an interface with calc, in the
+// link-local prefix and, unless it is empty, the given prefix, and verifies
+// the addresses configured on the interface against its MAC address.
+func newReport(calc eui64.Calculator, iface hostInterface, prefix string) (Report, error) {
+	report := Report{
+		Name:                iface.Name,
+		MAC:                 iface.MAC,
+		LinkLocal:           "",
+		LinkLocalConfigured: false,
+		Global:              "",
+		GlobalConfigured:    false,
+		Addressing:          AddressingNone,
+		Addresses:           make([]AddressReport, 0, len(iface.Addresses)),
+	}
+
+	var err error
+
+	report.LinkLocal, report.LinkLocalConfigured, err = predict(calc, iface, linkLocalPrefix)
+	if err != nil {
+		return Report{}, err
+	}
+
+	if prefix != "" {
+		report.Global, report.GlobalConfigured, err = predict(calc, iface, prefix)
+		if err != nil {
+			return Report{}, err
+		}
+	}
+
+	configured := make(map[Addressing]bool)
+
+	for _, addr := range iface.Addresses {
+		result := verify.Verify(calc, verify.Pair{MAC: iface.MAC, Address: addr.String(), Prefix: ""})
+		if result.Err != nil {
+			// Addresses without interface identifiers, such as multicast
+			// addresses, say nothing about how addresses are formed.
+			continue
+		}
+
+		report.Addresses = append(report.Addresses, AddressReport{
+			Address: addr.String(),
+			Status:  result.Status,
+			IIDType: result.IIDType,
+		})
+
+		switch {
+		case result.Status == verify.StatusMatch:
+			configured[AddressingEUI64] = true
+		case result.IIDType == analyzer.IIDEUI64:
+			configured[AddressingOtherMAC] = true
+		case result.IIDType == analyzer.IIDLowByte:
+			configured[AddressingStatic] = true
+		default:
+			configured[AddressingPrivacy] = true
+		}
+	}
+
+	report.Addressing = addressing(configured)
+
+	return report, nil
+}
+
+// addressing returns the addressing of an interface configuring addresses of
+// the given schemes, joining them in the order of addressingSchemes if there
+// are several.
+func addressing(configured map[Addressing]bool) Addressing {
+	var schemes []string
+
+	for _, scheme := range addressingSchemes {
+		if configured[scheme] {
+			schemes = append(schemes, string(scheme))
+		}
+	}
+
+	if len(schemes) == 0 {
+		return AddressingNone
+	}
+
+	return Addressing(strings.Join(schemes, addressingSeparator))
+}
+
+// predict calculates the EUI-64 address of an interface in a prefix with calc,
+// reporting whether it is configured on the interface.
+func predict(calc eui64.Calculator, iface hostInterface, prefix string) (string, bool, error) {
+	_, fullIP, err := calc.CalculateEUI64(iface.MAC, prefix)
+	if err != nil {
+		return "", false, fmt.Errorf("calculating EUI-64 address in %s: %w", prefix, err)
+	}
+
+	predicted, err := netip.ParseAddr(fullIP)
+	if err != nil {
+		return "", false, fmt.Errorf("parsing calculated address %q: %w", fullIP, err)
+	}
+
+	configured := slices.ContainsFunc(iface.Addresses, func(addr netip.Addr) bool {
+		return addr.WithZone("") == predicted
+	})
+
+	return fullIP, configured, nil
+}
+
+// writeJSON writes the reports as an indented JSON array.
+func writeJSON(w io.Writer, reports []Report) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(reports); err != nil {
+		return fmt.Errorf("writing JSON report: %w", err)
+	}
+
+	return nil
+}
+
+// writeText writes the reports as aligned text: per interface, its addressing,
+// its predicted addresses and whether they are configured, and the status of
+// each configured address.
+func writeText(w io.Writer, reports []Report) error {
+	out := tabwriter.NewWriter(w, tabMinWidth, tabWidth, tabPadding, ' ', 0)
+
+	for i, report := range reports {
+		if i > 0 {
+			fmt.Fprintln(out)
+		}
+
+		fmt.Fprintf(out, "%s (%s): %s addressing\n", report.Name, report.MAC, report.Addressing)
+		fmt.Fprintf(out, "  EUI-64 link-local\t%s\t%s\n", report.LinkLocal, configuredText(report.LinkLocalConfigured))
+
+		if report.Global != "" {
+			fmt.Fprintf(out, "  EUI-64 global\t%s\t%s\n", report.Global, configuredText(report.GlobalConfigured))
+		}
+
+		for _, addr := range report.Addresses {
+			fmt.Fprintf(out, "  configured\t%s\t%s (%s)\n", addr.Address, addr.Status, addr.IIDType)
+		}
+	}
+
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("writing report: %w", err)
+	}
+
+	return nil
+}
+
+// configuredText describes whether a predicted address is configured.
+func configuredText(configured bool) string {
+	if configured {
+		return "configured"
+	}
+
+	return "not configured"
+}
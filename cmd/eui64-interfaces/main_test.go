@@ -0,0 +1,286 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/analyzer"
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+	"github.com/nicholas-fedor/eui64-calculator/internal/verify"
+)
+
+// Addresses of the interface with MAC address 00-14-22-01-23-45.
+const (
+	testMAC       = "00-14-22-01-23-45"
+	testLinkLocal = "fe80::214:22ff:fe01:2345"
+	testGlobal    = "2001:db8::214:22ff:fe01:2345"
+	testPrivacy   = "2001:db8::8d3f:61c2:a94e:17b0"
+)
+
+// errList is returned by the failing interface lister in tests.
+var errList = errors.New("list failed")
+
+// testInterface returns an interface with MAC address testMAC and the given
+// addresses.
+func testInterface(t *testing.T, addresses ...string) hostInterface {
+	t.Helper()
+
+	iface := hostInterface{Name: "eth0", MAC: testMAC, Addresses: nil}
+
+	for _, address := range addresses {
+		iface.Addresses = append(iface.Addresses, netip.MustParseAddr(address))
+	}
+
+	return iface
+}
+
+// TestNewReport tests that newReport predicts the EUI-64 addresses of an
+// interface, reports whether they are configured, and tells EUI-64 from static
+// and privacy addressing.
+func TestNewReport(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		addresses           []string
+		prefix              string
+		wantGlobal          string
+		wantLinkLocal       bool
+		wantGlobalFound     bool
+		wantAddressing      Addressing
+		wantAddressStatuses []verify.Status
+	}{
+		{
+			name:                "EUI-64 addressing",
+			addresses:           []string{testLinkLocal + "%eth0", testGlobal},
+			prefix:              "2001:db8::",
+			wantGlobal:          testGlobal,
+			wantLinkLocal:       true,
+			wantGlobalFound:     true,
+			wantAddressing:      AddressingEUI64,
+			wantAddressStatuses: []verify.Status{verify.StatusMatch, verify.StatusMatch},
+		},
+		{
+			name:                "Privacy addressing",
+			addresses:           []string{"fe80::8d3f:61c2:a94e:17b0", testPrivacy},
+			prefix:              "2001:db8::",
+			wantGlobal:          testGlobal,
+			wantLinkLocal:       false,
+			wantGlobalFound:     false,
+			wantAddressing:      AddressingPrivacy,
+			wantAddressStatuses: []verify.Status{verify.StatusNotEUI64, verify.StatusNotEUI64},
+		},
+		{
+			name:                "EUI-64 and temporary addresses",
+			addresses:           []string{testLinkLocal, testGlobal, testPrivacy},
+			prefix:              "",
+			wantGlobal:          "",
+			wantLinkLocal:       true,
+			wantGlobalFound:     false,
+			wantAddressing:      Addressing("eui64+privacy"),
+			wantAddressStatuses: []verify.Status{verify.StatusMatch, verify.StatusMatch, verify.StatusNotEUI64},
+		},
+		{
+			name:                "Static addressing",
+			addresses:           []string{"2001:db8::1"},
+			prefix:              "",
+			wantGlobal:          "",
+			wantLinkLocal:       false,
+			wantGlobalFound:     false,
+			wantAddressing:      AddressingStatic,
+			wantAddressStatuses: []verify.Status{verify.StatusNotEUI64},
+		},
+		{
+			name:                "EUI-64 and static addresses",
+			addresses:           []string{testLinkLocal, "2001:db8::53"},
+			prefix:              "",
+			wantGlobal:          "",
+			wantLinkLocal:       true,
+			wantGlobalFound:     false,
+			wantAddressing:      Addressing("eui64+static"),
+			wantAddressStatuses: []verify.Status{verify.StatusMatch, verify.StatusNotEUI64},
+		},
+		{
+			name:                "EUI-64 address of another MAC address",
+			addresses:           []string{"fe80::214:22ff:fe01:2346"},
+			prefix:              "",
+			wantGlobal:          "",
+			wantLinkLocal:       false,
+			wantGlobalFound:     false,
+			wantAddressing:      AddressingOtherMAC,
+			wantAddressStatuses: []verify.Status{verify.StatusIIDMismatch},
+		},
+		{
+			name:                "EUI-64 addresses of the interface's and another MAC address",
+			addresses:           []string{testLinkLocal, "2001:db8::214:22ff:fe01:2346", testPrivacy},
+			prefix:              "",
+			wantGlobal:          "",
+			wantLinkLocal:       true,
+			wantGlobalFound:     false,
+			wantAddressing:      Addressing("eui64+other_mac+privacy"),
+			wantAddressStatuses: []verify.Status{verify.StatusMatch, verify.StatusIIDMismatch, verify.StatusNotEUI64},
+		},
+		{
+			name:                "No addresses",
+			addresses:           nil,
+			prefix:              "2001:db8:0:1",
+			wantGlobal:          "2001:db8:0:1:214:22ff:fe01:2345",
+			wantLinkLocal:       false,
+			wantGlobalFound:     false,
+			wantAddressing:      AddressingNone,
+			wantAddressStatuses: []verify.Status{},
+		},
+		{
+			name:                "Multicast addresses ignored",
+			addresses:           []string{"ff02::1"},
+			prefix:              "",
+			wantGlobal:          "",
+			wantLinkLocal:       false,
+			wantGlobalFound:     false,
+			wantAddressing:      AddressingNone,
+			wantAddressStatuses: []verify.Status{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := newReport(&eui64.DefaultCalculator{}, testInterface(t, tt.addresses...), tt.prefix)
+			require.NoError(t, err)
+			assert.Equal(t, testLinkLocal, got.LinkLocal, "LinkLocal")
+			assert.Equal(t, tt.wantLinkLocal, got.LinkLocalConfigured, "LinkLocalConfigured")
+			assert.Equal(t, tt.wantGlobal, got.Global, "Global")
+			assert.Equal(t, tt.wantGlobalFound, got.GlobalConfigured, "GlobalConfigured")
+			assert.Equal(t, tt.wantAddressing, got.Addressing, "Addressing")
+
+			statuses := make([]verify.Status, 0, len(got.Addresses))
+			for _, addr := range got.Addresses {
+				statuses = append(statuses, addr.Status)
+			}
+
+			assert.Equal(t, tt.wantAddressStatuses, statuses, "Address statuses")
+		})
+	}
+}
+
+// TestRun tests the command's output and exit statuses.
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	listed := func() ([]hostInterface, error) {
+		return []hostInterface{testInterface(t, testLinkLocal, testPrivacy)}, nil
+	}
+	empty := func() ([]hostInterface, error) { return nil, nil }
+	failing := func() ([]hostInterface, error) { return nil, errList }
+
+	tests := []struct {
+		name       string
+		args       []string
+		list       func() ([]hostInterface, error)
+		wantStatus int
+		wantStdout []string
+		wantStderr string
+	}{
+		{
+			name:       "Text report",
+			args:       []string{"-prefix", "2001:db8:0:0"},
+			list:       listed,
+			wantStatus: 0,
+			wantStdout: []string{
+				"eth0 (00-14-22-01-23-45): eui64+privacy addressing",
+				testLinkLocal, testGlobal, "not configured", "not_eui64 (random)",
+			},
+			wantStderr: "",
+		},
+		{
+			name:       "Invalid prefix",
+			args:       []string{"-prefix", "2001:db8:zz"},
+			list:       listed,
+			wantStatus: exitUsage,
+			wantStdout: nil,
+			wantStderr: "invalid -prefix",
+		},
+		{
+			name:       "Unknown flag",
+			args:       []string{"-mac", testMAC},
+			list:       listed,
+			wantStatus: exitUsage,
+			wantStdout: nil,
+			wantStderr: "flag provided but not defined",
+		},
+		{
+			name:       "No interfaces",
+			args:       nil,
+			list:       empty,
+			wantStatus: exitFailure,
+			wantStdout: nil,
+			wantStderr: ErrNoInterfaces.Error(),
+		},
+		{
+			name:       "Listing fails",
+			args:       nil,
+			list:       failing,
+			wantStatus: exitFailure,
+			wantStdout: nil,
+			wantStderr: errList.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var stdout, stderr bytes.Buffer
+
+			status := run(tt.args, &stdout, &stderr, tt.list)
+			assert.Equal(t, tt.wantStatus, status, "Exit status")
+			assert.Contains(t, stderr.String(), tt.wantStderr)
+
+			for _, want := range tt.wantStdout {
+				assert.Contains(t, stdout.String(), want)
+			}
+
+			if tt.wantStdout == nil {
+				assert.Empty(t, stdout.String())
+			}
+		})
+	}
+}
+
+// TestRunJSON tests that the command reports as JSON with -json.
+func TestRunJSON(t *testing.T) {
+	t.Parallel()
+
+	list := func() ([]hostInterface, error) {
+		return []hostInterface{testInterface(t, testLinkLocal)}, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	require.Equal(t, 0, run([]string{"-json"}, &stdout, &stderr, list))
+	assert.Empty(t, stderr.String())
+
+	var reports []Report
+
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &reports))
+	assert.Equal(t, []Report{{
+		Name:                "eth0",
+		MAC:                 testMAC,
+		LinkLocal:           testLinkLocal,
+		LinkLocalConfigured: true,
+		Global:              "",
+		GlobalConfigured:    false,
+		Addressing:          AddressingEUI64,
+		Addresses: []AddressReport{
+			{Address: testLinkLocal, Status: verify.StatusMatch, IIDType: analyzer.IIDEUI64},
+		},
+	}}, reports)
+	assert.NotContains(t, stdout.String(), "eui64_global\"", "Global address omitted without a prefix")
+}
@@ -0,0 +1,335 @@
+// Package main provides the eui64-pcap command, which analyzes a packet capture
+// offline. It reads a pcap or pcapng file, such as one written by tcpdump or
+// Wireshark in the field, maps the MAC addresses of the hosts on the link to
+// the IPv6 addresses seen in their NDP and DHCPv6 messages, verifying each
+// against the host's EUI-64 addresses, and reports the prefixes routers
+// advertise and which hosts configured EUI-64 addresses in them.
+//
+// Usage:
+//
+//	eui64-pcap [-json] capture.pcapng
+//
+// The capture is read from standard input if its name is "-".
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/analyzer"
+	"github.com/nicholas-fedor/eui64-calculator/internal/capture"
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+	"github.com/nicholas-fedor/eui64-calculator/internal/verify"
+)
+
+// Constants defining the command's layout.
+const (
+	// stdinName names standard input in place of a capture file.
+	stdinName = "-"
+	// Exit statuses of the command.
+	exitFailure = 1
+	exitUsage   = 2
+	// Column layout of the text report.
+	tabMinWidth = 0
+	tabWidth    = 8
+	tabPadding  = 2
+)
+
+// ErrCaptureRequired is reported when no capture file is named.
+var ErrCaptureRequired = errors.New("a single capture file is required")
+
+// Report is the JSON report of a capture.
+type Report struct {
+	Packets   int       `json:"packets"`
+	Messages  int       `json:"messages"`
+	Truncated bool      `json:"truncated"`
+	Prefixes  []Prefix  `json:"prefixes"`
+	Hosts     []Host    `json:"hosts"`
+	Unmapped  []Address `json:"unmapped_addresses"`
+}
+
+// Prefix is the JSON report of an advertised prefix.
+type Prefix struct {
+	Prefix            string   `json:"prefix"`
+	Routers           []string `json:"routers"`
+	OnLink            bool     `json:"on_link"`
+	Autonomous        bool     `json:"autonomous"`
+	ValidLifetime     uint32   `json:"valid_lifetime"`
+	PreferredLifetime uint32   `json:"preferred_lifetime"`
+	SLAAC             bool     `json:"slaac"`
+	EUI64Hosts        []string `json:"eui64_hosts"`
+}
+
+// Host is the JSON report of a host.
+type Host struct {
+	MAC       string    `json:"mac"`
+	Addresses []Address `json:"addresses"`
+}
+
+// Address is the JSON report of an address.
+type Address struct {
+	Address string           `json:"address"`
+	Sources []capture.Source `json:"sources"`
+	Derived bool             `json:"derived,omitempty"`
+	Status  verify.Status    `json:"status,omitempty"`
+	IIDType analyzer.IIDType `json:"iid_type"`
+}
+
+// main analyzes the capture named on the command line and exits with the
+// command's status.
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run parses the command line, analyzes the capture it names, reading "-" from
+// stdin, and returns the exit status: 0 on success, 1 if the capture cannot be
+// analyzed, and 2 for invalid usage.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("eui64-pcap", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	asJSON := flags.Bool("json", false, "report as JSON")
+
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if flags.NArg() != 1 {
+		fmt.Fprintln(stderr, ErrCaptureRequired)
+		flags.Usage()
+
+		return exitUsage
+	}
+
+	report, err := analyze(flags.Arg(0), stdin)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+
+		return exitFailure
+	}
+
+	if *asJSON {
+		err = writeJSON(stdout, report)
+	} else {
+		err = writeText(stdout, report)
+	}
+
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+
+		return exitFailure
+	}
+
+	return 0
+}
+
+// analyze analyzes the named capture file, or stdin if the name is "-".
+func analyze(name string, stdin io.Reader) (capture.Report, error) {
+	if name == stdinName {
+		return analyzeReader(name, stdin)
+	}
+
+	file, err := os.Open(name)
+	if err != nil {
+		return capture.Report{}, fmt.Errorf("opening capture: %w", err)
+	}
+
+	defer func() { _ = file.Close() }() // Only read, so closing cannot lose data.
+
+	return analyzeReader(name, file)
+}
+
+// analyzeReader analyzes a capture, naming it in errors.
+func analyzeReader(name string, r io.Reader) (capture.Report, error) {
+	report, err := capture.Analyze(&eui64.DefaultCalculator{}, r)
+	if err != nil {
+		return capture.Report{}, fmt.Errorf("%s: %w", name, err)
+	}
+
+	return report, nil
+}
+
+// writeJSON writes the report as indented JSON.
+func writeJSON(w io.Writer, report capture.Report) error {
+	out := Report{
+		Packets:   report.Packets,
+		Messages:  report.Messages,
+		Truncated: report.Truncated,
+		Prefixes:  make([]Prefix, 0, len(report.Prefixes)),
+		Hosts:     make([]Host, 0, len(report.Hosts)),
+		Unmapped:  addresses(report.Unmapped),
+	}
+
+	for _, prefix := range report.Prefixes {
+		out.Prefixes = append(out.Prefixes, Prefix{
+			Prefix:            prefix.Prefix.String(),
+			Routers:           addrStrings(prefix.Routers),
+			OnLink:            prefix.OnLink,
+			Autonomous:        prefix.Autonomous,
+			ValidLifetime:     prefix.ValidLifetime,
+			PreferredLifetime: prefix.PreferredLifetime,
+			SLAAC:             prefix.SLAAC,
+			EUI64Hosts:        append([]string{}, prefix.EUI64Hosts...),
+		})
+	}
+
+	for _, host := range report.Hosts {
+		out.Hosts = append(out.Hosts, Host{MAC: host.MAC, Addresses: addresses(host.Addresses)})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(out); err != nil {
+		return fmt.Errorf("writing JSON report: %w", err)
+	}
+
+	return nil
+}
+
+// addresses returns the JSON reports of addresses.
+func addresses(addrs []capture.Address) []Address {
+	reports := make([]Address, 0, len(addrs))
+
+	for _, addr := range addrs {
+		reports = append(reports, Address{
+			Address: addr.Address.String(),
+			Sources: addr.Sources,
+			Derived: addr.Derived,
+			Status:  addr.Status,
+			IIDType: addr.IIDType,
+		})
+	}
+
+	return reports
+}
+
+// writeText writes the report as aligned text: the advertised prefixes with
+// their flags, lifetimes and EUI-64 hosts, then the addresses of each host and
+// the addresses that could not be mapped to one.
+func writeText(w io.Writer, report capture.Report) error {
+	out := tabwriter.NewWriter(w, tabMinWidth, tabWidth, tabPadding, ' ', 0)
+
+	fmt.Fprintf(out, "%d packets, %d NDP and DHCPv6 messages", report.Packets, report.Messages)
+
+	if report.Truncated {
+		fmt.Fprint(out, " (capture truncated)")
+	}
+
+	fmt.Fprintln(out)
+
+	if len(report.Prefixes) > 0 {
+		fmt.Fprintln(out, "\nAdvertised prefixes")
+	}
+
+	for _, prefix := range report.Prefixes {
+		fmt.Fprintf(out, "  %s\t%s\tvalid %s, preferred %s\tfrom %s\n", prefix.Prefix, prefixFlags(prefix),
+			lifetime(prefix.ValidLifetime), lifetime(prefix.PreferredLifetime), strings.Join(addrStrings(prefix.Routers), " "))
+
+		if prefix.SLAAC {
+			fmt.Fprintf(out, "    EUI-64 hosts:\t%s\n", orNone(strings.Join(prefix.EUI64Hosts, ", ")))
+		}
+	}
+
+	if len(report.Hosts) > 0 {
+		fmt.Fprintln(out, "\nHosts")
+	}
+
+	for _, host := range report.Hosts {
+		fmt.Fprintf(out, "  %s\n", host.MAC)
+
+		for _, addr := range host.Addresses {
+			writeAddress(out, addr)
+		}
+	}
+
+	if len(report.Unmapped) > 0 {
+		fmt.Fprintln(out, "\nAddresses without a MAC address")
+	}
+
+	for _, addr := range report.Unmapped {
+		writeAddress(out, addr)
+	}
+
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("writing report: %w", err)
+	}
+
+	return nil
+}
+
+// writeAddress writes a line describing an address: where it was seen and how
+// it was verified.
+func writeAddress(w io.Writer, addr capture.Address) {
+	sources := make([]string, 0, len(addr.Sources))
+	for _, source := range addr.Sources {
+		sources = append(sources, string(source))
+	}
+
+	verdict := string(addr.IIDType)
+	if addr.Status != "" {
+		verdict = fmt.Sprintf("%s (%s)", addr.Status, addr.IIDType)
+	}
+
+	if addr.Derived {
+		verdict += ", MAC address from interface ID"
+	}
+
+	fmt.Fprintf(w, "    %s\t%s\t%s\n", addr.Address, strings.Join(sources, ","), verdict)
+}
+
+// prefixFlags describes the flags of an advertised prefix and whether hosts
+// can form EUI-64 addresses in it.
+func prefixFlags(prefix capture.Prefix) string {
+	var flags []string
+
+	if prefix.OnLink {
+		flags = append(flags, "L")
+	}
+
+	if prefix.Autonomous {
+		flags = append(flags, "A")
+	}
+
+	if prefix.SLAAC {
+		flags = append(flags, "SLAAC")
+	} else {
+		flags = append(flags, "no SLAAC")
+	}
+
+	return strings.Join(flags, " ")
+}
+
+// lifetime formats a lifetime in seconds.
+func lifetime(seconds uint32) string {
+	if seconds == capture.InfiniteLifetime {
+		return "infinite"
+	}
+
+	return fmt.Sprintf("%ds", seconds)
+}
+
+// addrStrings formats addresses.
+func addrStrings(addrs []netip.Addr) []string {
+	texts := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		texts = append(texts, addr.String())
+	}
+
+	return texts
+}
+
+// orNone returns text, or "none" if it is empty.
+func orNone(text string) string {
+	if text == "" {
+		return "none"
+	}
+
+	return text
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/analyzer"
+	"github.com/nicholas-fedor/eui64-calculator/internal/capture"
+	"github.com/nicholas-fedor/eui64-calculator/internal/verify"
+)
+
+// sampleCapture is a pcap file of a Router Advertisement of 2001:db8:0:1::/64
+// by 00-00-5e-00-53-01 and a Neighbor Advertisement of the EUI-64 address of
+// 00-14-22-01-23-45 in it.
+const sampleCapture = "d4c3b2a1020004000000000000000000000004000100000000000000000000006e0000006e0000003333000000010000" +
+	"5e00530186dd6000000000383afffe8000000000000002005efffe005301ff0200000000000000000000000000018600" +
+	"0000000000000000000000000000010100005e005301030440c000278d0000093a800000000020010db8000000010000" +
+	"0000000000000000000000000000560000005600000033330000000100142201234586dd6000000000203afffe800000" +
+	"00000000021422fffe012345fe8000000000000002005efffe005301880000000000000020010db800000001021422ff" +
+	"fe0123450201001422012345"
+
+// sample returns the sample capture.
+func sample(t *testing.T) []byte {
+	t.Helper()
+
+	data, err := hex.DecodeString(sampleCapture)
+	require.NoError(t, err)
+
+	return data
+}
+
+// TestRun tests the command's output and exit statuses.
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "capture.pcap")
+	require.NoError(t, os.WriteFile(path, sample(t), 0o600))
+
+	tests := []struct {
+		name       string
+		args       []string
+		stdin      []byte
+		wantStatus int
+		wantStdout []string
+		wantStderr string
+	}{
+		{
+			name:       "Text report",
+			args:       []string{path},
+			stdin:      nil,
+			wantStatus: 0,
+			wantStdout: []string{
+				"2 packets, 2 NDP and DHCPv6 messages",
+				"2001:db8:0:1::/64", "L A SLAAC", "valid 2592000s, preferred 604800s", "from fe80::200:5eff:fe00:5301",
+				"EUI-64 hosts:", "00-14-22-01-23-45",
+				"2001:db8:0:1:214:22ff:fe01:2345", "ndp", "match (eui64)",
+			},
+			wantStderr: "",
+		},
+		{
+			name:       "Standard input",
+			args:       []string{"-"},
+			stdin:      sample(t),
+			wantStatus: 0,
+			wantStdout: []string{"2 packets"},
+			wantStderr: "",
+		},
+		{
+			name:       "No capture",
+			args:       nil,
+			stdin:      nil,
+			wantStatus: exitUsage,
+			wantStdout: nil,
+			wantStderr: ErrCaptureRequired.Error(),
+		},
+		{
+			name:       "Missing capture",
+			args:       []string{filepath.Join(t.TempDir(), "missing.pcap")},
+			stdin:      nil,
+			wantStatus: exitFailure,
+			wantStdout: nil,
+			wantStderr: "opening capture",
+		},
+		{
+			name:       "Not a capture",
+			args:       []string{"-"},
+			stdin:      []byte("hello world"),
+			wantStatus: exitFailure,
+			wantStdout: nil,
+			wantStderr: capture.ErrUnknownFormat.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var stdout, stderr bytes.Buffer
+
+			status := run(tt.args, bytes.NewReader(tt.stdin), &stdout, &stderr)
+			assert.Equal(t, tt.wantStatus, status, "Exit status")
+			assert.Contains(t, stderr.String(), tt.wantStderr)
+
+			for _, want := range tt.wantStdout {
+				assert.Contains(t, stdout.String(), want)
+			}
+
+			if tt.wantStdout == nil {
+				assert.Empty(t, stdout.String())
+			}
+		})
+	}
+}
+
+// TestRunJSON tests that the command reports as JSON with -json.
+func TestRunJSON(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+
+	require.Equal(t, 0, run([]string{"-json", "-"}, bytes.NewReader(sample(t)), &stdout, &stderr))
+	assert.Empty(t, stderr.String())
+
+	var report Report
+
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &report))
+	assert.Equal(t, Report{
+		Packets:   2,
+		Messages:  2,
+		Truncated: false,
+		Prefixes: []Prefix{{
+			Prefix:            "2001:db8:0:1::/64",
+			Routers:           []string{"fe80::200:5eff:fe00:5301"},
+			OnLink:            true,
+			Autonomous:        true,
+			ValidLifetime:     2592000,
+			PreferredLifetime: 604800,
+			SLAAC:             true,
+			EUI64Hosts:        []string{"00-14-22-01-23-45"},
+		}},
+		Hosts: []Host{
+			{MAC: "00-00-5e-00-53-01", Addresses: []Address{
+				{"fe80::200:5eff:fe00:5301", []capture.Source{capture.SourceNDP}, false, verify.StatusMatch, analyzer.IIDEUI64},
+			}},
+			{MAC: "00-14-22-01-23-45", Addresses: []Address{
+				{"fe80::214:22ff:fe01:2345", []capture.Source{capture.SourceNDP}, false, verify.StatusMatch, analyzer.IIDEUI64},
+				{"2001:db8:0:1:214:22ff:fe01:2345", []capture.Source{capture.SourceNDP}, false, verify.StatusMatch, analyzer.IIDEUI64},
+			}},
+		},
+		Unmapped: []Address{},
+	}, report)
+	assert.True(t, strings.HasPrefix(stdout.String(), "{\n  \"packets\": 2"), "Indented JSON")
+}
@@ -6,20 +6,29 @@ package main
 
 import (
 	"context"
+	"crypto/subtle"
 	"embed"
 	"errors"
 	"io/fs"
 	"log/slog"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/extractors"
+	"github.com/gofiber/fiber/v3/middleware/csrf"
 	"github.com/gofiber/fiber/v3/middleware/logger"
 	"github.com/gofiber/fiber/v3/middleware/recover"
-	"github.com/gofiber/fiber/v3/middleware/static"
 
+	"github.com/nicholas-fedor/eui64-calculator/internal/assets"
 	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
 	"github.com/nicholas-fedor/eui64-calculator/internal/handlers"
+	"github.com/nicholas-fedor/eui64-calculator/internal/locale"
+	"github.com/nicholas-fedor/eui64-calculator/internal/macrange"
+	"github.com/nicholas-fedor/eui64-calculator/internal/ratelimit"
+	"github.com/nicholas-fedor/eui64-calculator/internal/security"
+	"github.com/nicholas-fedor/eui64-calculator/internal/ui"
 )
 
 // Config holds server configuration parameters.
@@ -28,6 +37,31 @@ type Config struct {
 	Port string
 	// TrustedProxies lists IP addresses of trusted reverse proxies.
 	TrustedProxies []string
+	// RateLimit is the sustained number of calculations per second allowed per
+	// client IP. Zero disables per-client rate limiting.
+	RateLimit float64
+	// RateLimitBurst is the number of calculations a client may make in quick
+	// succession before RateLimit applies.
+	RateLimitBurst int
+	// MaxConcurrent caps the number of calculations processed at once across
+	// all clients. Zero disables the cap.
+	MaxConcurrent int
+	// MaxMACRange is the maximum number of MAC addresses in a range calculated
+	// at once. Zero disables MAC ranges.
+	MaxMACRange int
+	// Security configures the security headers, including the Content Security Policy.
+	Security security.Config
+	// APIToken exempts requests carrying it in APITokenHeader from CSRF checks,
+	// so scripted clients can call the API. Empty disables the exemption.
+	APIToken string
+	// APITokenHeader is the request header checked for APIToken.
+	APITokenHeader string
+	// SecureCookies marks cookies as Secure; enable when served over HTTPS.
+	SecureCookies bool
+	// EnablePWA serves the offline-capable client, including the service worker,
+	// web manifest, and WebAssembly calculator. It only takes effect in binaries
+	// built with the pwa tag, which embed those files.
+	EnablePWA bool
 }
 
 // Constants defining default configuration values and environment variable names.
@@ -36,10 +70,52 @@ const (
 	defaultPort = "8080"
 	// trustedProxiesEnv is the environment variable for trusted proxy IPs.
 	trustedProxiesEnv = "TRUSTED_PROXIES"
+	// defaultRateLimit is the default per-client calculations per second.
+	defaultRateLimit = 5
+	// rateLimitEnv is the environment variable for the per-client rate limit.
+	rateLimitEnv = "RATE_LIMIT"
+	// defaultRateLimitBurst is the default per-client burst size.
+	defaultRateLimitBurst = 20
+	// rateLimitBurstEnv is the environment variable for the per-client burst size.
+	rateLimitBurstEnv = "RATE_LIMIT_BURST"
+	// defaultMaxConcurrent is the default global concurrency cap.
+	defaultMaxConcurrent = 100
+	// maxConcurrentEnv is the environment variable for the global concurrency cap.
+	maxConcurrentEnv = "MAX_CONCURRENT_REQUESTS"
+	// maxMACRangeEnv is the environment variable for the maximum size of a MAC range.
+	maxMACRangeEnv = "MAX_MAC_RANGE"
+	// cspEnv is the environment variable overriding the Content Security Policy.
+	cspEnv = "CONTENT_SECURITY_POLICY"
+	// hstsMaxAgeEnv is the environment variable for the HSTS max-age in seconds.
+	hstsMaxAgeEnv = "HSTS_MAX_AGE"
+	// hstsIncludeSubdomainsEnv is the environment variable enabling includeSubDomains for HSTS.
+	hstsIncludeSubdomainsEnv = "HSTS_INCLUDE_SUBDOMAINS"
+	// referrerPolicyEnv is the environment variable overriding the Referrer-Policy.
+	referrerPolicyEnv = "REFERRER_POLICY"
+	// permissionsPolicyEnv is the environment variable overriding the Permissions-Policy.
+	permissionsPolicyEnv = "PERMISSIONS_POLICY"
+	// apiTokenEnv is the environment variable for the token exempting API clients from CSRF checks.
+	apiTokenEnv = "API_TOKEN"
+	// defaultAPITokenHeader is the default request header carrying the API token.
+	defaultAPITokenHeader = "X-Api-Token"
+	// apiTokenHeaderEnv is the environment variable overriding the API token header.
+	apiTokenHeaderEnv = "API_TOKEN_HEADER"
+	// secureCookiesEnv is the environment variable enabling Secure cookies.
+	secureCookiesEnv = "SECURE_COOKIES"
+	// staticPrefix is the URL prefix embedded static assets are served under.
+	staticPrefix = "/static/"
+	// enablePWAEnv is the environment variable toggling the offline-capable client.
+	enablePWAEnv = "ENABLE_PWA"
+	// csrfCookieName is the name of the cookie holding the CSRF token.
+	csrfCookieName = "csrf_"
 )
 
+// Fingerprint the static assets into static/fingerprints.json before compiling.
+//
+//go:generate go run ../../build/fingerprint static
+
 //go:embed static/*
-var staticFS embed.FS // Embeds all files in cmd/server/static/
+var staticFS embed.FS // Embeds all files in cmd/server/static/, with their fingerprints.
 
 // Build information injected by GoReleaser.
 var (
@@ -57,11 +133,21 @@ var (
 // LoadConfig loads server configuration from environment variables.
 // It defaults to port ":8080" if PORT is unset and processes TRUSTED_PROXIES
 // as a comma-separated list, trimming whitespace, logging warnings for empty
-// entries, and filtering them out.
+// entries, and filtering them out. Rate limiting and security header settings
+// fall back to their defaults when unset or invalid.
 func LoadConfig() Config {
 	config := Config{
 		Port:           ":" + defaultPort,
 		TrustedProxies: nil,
+		RateLimit:      defaultRateLimit,
+		RateLimitBurst: defaultRateLimitBurst,
+		MaxConcurrent:  defaultMaxConcurrent,
+		MaxMACRange:    macrange.DefaultMaxCount,
+		Security:       security.DefaultConfig(),
+		APIToken:       "",
+		APITokenHeader: defaultAPITokenHeader,
+		SecureCookies:  false,
+		EnablePWA:      true,
 	}
 	if port := os.Getenv("PORT"); port != "" {
 		config.Port = ":" + port
@@ -87,14 +173,40 @@ func LoadConfig() Config {
 		config.TrustedProxies = validProxies
 	}
 
+	config.RateLimit = envFloat(rateLimitEnv, config.RateLimit)
+	config.RateLimitBurst = envInt(rateLimitBurstEnv, config.RateLimitBurst)
+	config.MaxConcurrent = envInt(maxConcurrentEnv, config.MaxConcurrent)
+	config.MaxMACRange = envInt(maxMACRangeEnv, config.MaxMACRange)
+
+	config.Security.ContentSecurityPolicy = envString(cspEnv, config.Security.ContentSecurityPolicy)
+	config.Security.HSTSMaxAge = envInt(hstsMaxAgeEnv, config.Security.HSTSMaxAge)
+	config.Security.HSTSIncludeSubdomains = envBool(hstsIncludeSubdomainsEnv, config.Security.HSTSIncludeSubdomains)
+	config.Security.ReferrerPolicy = envString(referrerPolicyEnv, config.Security.ReferrerPolicy)
+	config.Security.PermissionsPolicy = envString(permissionsPolicyEnv, config.Security.PermissionsPolicy)
+
+	config.APIToken = os.Getenv(apiTokenEnv)
+	config.APITokenHeader = envString(apiTokenHeaderEnv, config.APITokenHeader)
+	config.SecureCookies = envBool(secureCookiesEnv, config.SecureCookies)
+	config.EnablePWA = envBool(enablePWAEnv, config.EnablePWA)
+
 	return config
 }
 
 // SetupRouter configures and returns a new Fiber app with middleware and routes.
-// It sets up logging and recovery middleware, configures trusted proxies,
-// and defines routes for the home page, EUI-64 calculation, and embedded file serving.
+// It sets up logging, recovery, security header, locale negotiation, and CSRF middleware,
+// configures trusted proxies, and defines routes for the home page, EUI-64 calculation,
+// live field validation, and embedded file serving.
+// Embedded files are served under fingerprinted names with immutable caching and
+// precompressed gzip and brotli variants, and templates link to those names.
+// When enabled and embedded, the offline-capable client is served as well.
 // Returns the app and any error.
 func SetupRouter(config Config) (*fiber.App, error) {
+	return newApp(config, embeddedPWA())
+}
+
+// newApp builds the app for SetupRouter, serving the offline-capable client from
+// pwa when config.EnablePWA is set and pwa is non-nil.
+func newApp(config Config, pwa fs.FS) (*fiber.App, error) {
 	fiberCfg := fiber.Config{}
 
 	if len(config.TrustedProxies) > 0 {
@@ -112,7 +224,9 @@ func SetupRouter(config Config) (*fiber.App, error) {
 
 	app := fiber.New(fiberCfg)
 
-	app.Use(recover.New(), logger.New())
+	app.Use(recover.New(), logger.New(), security.New(config.Security))
+
+	handler := handlers.NewHandler(&eui64.DefaultCalculator{}, handlers.WithMaxRange(config.MaxMACRange))
 
 	// Create a sub-FS to serve files from the "static" subdirectory as if it were the root.
 	subStatic, err := fs.Sub(staticFS, "static")
@@ -120,27 +234,91 @@ func SetupRouter(config Config) (*fiber.App, error) {
 		return nil, errors.Join(ErrSetupRouter, err)
 	}
 
-	app.Use("/static", static.New("", static.Config{
-		FS:              subStatic,
-		Next:            nil,
-		ModifyResponse:  nil,
-		NotFoundHandler: nil,
-		IndexNames:      []string{"index.html"},
-		CacheDuration:   0,
-		MaxAge:          0,
-		Compress:        false,
-		ByteRange:       false,
-		Browse:          false,
-		Download:        false,
+	manifest, err := assets.New(subStatic, staticPrefix)
+	if err != nil {
+		return nil, errors.Join(ErrSetupRouter, err)
+	}
+
+	pwaEnabled := config.EnablePWA && pwa != nil
+	if pwaEnabled {
+		if err := setupPWA(app, manifest, pwa); err != nil {
+			return nil, errors.Join(ErrSetupRouter, err)
+		}
+	}
+
+	app.Get(staticPrefix+"*", manifest.Handler())
+
+	localeConfig := locale.DefaultConfig()
+	localeConfig.CookieSecure = config.SecureCookies
+
+	app.Use(locale.New(localeConfig))
+
+	app.Use(func(c fiber.Ctx) error {
+		ctx := ui.WithAssetResolver(c.Context(), manifest)
+		c.SetContext(ui.WithPWA(ctx, pwaEnabled))
+
+		return c.Next()
+	})
+
+	app.Use(csrf.New(csrf.Config{
+		Storage:               nil,
+		Next:                  hasAPIToken(config),
+		Session:               nil,
+		KeyGenerator:          nil,
+		ErrorHandler:          handler.InvalidCSRFToken,
+		CookieName:            csrfCookieName,
+		CookieDomain:          "",
+		CookiePath:            "/",
+		CookieSameSite:        fiber.CookieSameSiteLaxMode,
+		TrustedOrigins:        nil,
+		Extractor:             extractors.Chain(extractors.FromHeader(ui.CSRFHeader), extractors.FromForm(ui.CSRFField)),
+		IdleTimeout:           0,
+		DisableValueRedaction: false,
+		CookieSecure:          config.SecureCookies,
+		CookieHTTPOnly:        true,
+		CookieSessionOnly:     true,
+		SingleUseToken:        false,
 	}))
 
-	handler := handlers.NewHandler(&eui64.DefaultCalculator{})
+	limiter := ratelimit.New(ratelimit.Config{
+		Rate:          config.RateLimit,
+		Burst:         config.RateLimitBurst,
+		MaxConcurrent: config.MaxConcurrent,
+		Store:         nil,
+		KeyGenerator:  nil,
+		LimitReached:  handler.TooManyRequests,
+	})
+
 	app.Get("/", handler.Home)
-	app.Post("/calculate", handler.Calculate)
+	app.Get("/analyze", handler.Analyze)
+	app.Get("/duid", handler.DecodeDUID)
+	app.Post("/calculate", limiter, handler.Calculate)
+	app.Post("/plan", limiter, handler.Plan)
+	app.Post("/matrix", limiter, handler.Matrix)
+	app.Post("/ula", limiter, handler.ULA)
+	app.Post("/verify", limiter, handler.Verify)
+	app.Post("/verify/csv", limiter, handler.VerifyCSV)
+	app.Post("/import", limiter, handler.Import)
+	app.Post("/simulate", limiter, handler.Simulate)
+	app.Get("/validate/mac", handler.ValidateMAC)
+	app.Get("/validate/ip-start", handler.ValidateIPv6Prefix)
 
 	return app, nil
 }
 
+// hasAPIToken returns a predicate reporting whether a request carries the
+// configured API token, using a constant-time comparison. The predicate always
+// reports false when no token is configured.
+func hasAPIToken(config Config) func(c fiber.Ctx) bool {
+	return func(c fiber.Ctx) bool {
+		if config.APIToken == "" {
+			return false
+		}
+
+		return subtle.ConstantTimeCompare([]byte(c.Get(config.APITokenHeader)), []byte(config.APIToken)) == 1
+	}
+}
+
 // main initializes and runs the EUI-64 calculator web server.
 // It loads configuration, sets up the app, and starts the server,
 // logging errors and exiting with status 1 on failure.
@@ -175,3 +353,91 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// envBool returns the boolean value of the named environment variable, or
+// fallback if it is unset or invalid.
+func envBool(name string, fallback bool) bool {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return fallback
+	}
+
+	value, err := strconv.ParseBool(strings.TrimSpace(raw))
+	if err != nil {
+		slog.WarnContext(
+			context.Background(),
+			"Invalid value for environment variable, using default",
+			"name", name,
+			"value", raw,
+			"default", fallback,
+		)
+
+		return fallback
+	}
+
+	return value
+}
+
+// envFloat returns the non-negative float value of the named environment
+// variable, or fallback if it is unset or invalid.
+func envFloat(name string, fallback float64) float64 {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return fallback
+	}
+
+	value, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+	if err != nil || value < 0 {
+		slog.WarnContext(
+			context.Background(),
+			"Invalid value for environment variable, using default",
+			"name", name,
+			"value", raw,
+			"default", fallback,
+		)
+
+		return fallback
+	}
+
+	return value
+}
+
+// envInt returns the non-negative integer value of the named environment
+// variable, or fallback if it is unset or invalid.
+func envInt(name string, fallback int) int {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return fallback
+	}
+
+	value, err := strconv.Atoi(strings.TrimSpace(raw))
+	if err != nil || value < 0 {
+		slog.WarnContext(
+			context.Background(),
+			"Invalid value for environment variable, using default",
+			"name", name,
+			"value", raw,
+			"default", fallback,
+		)
+
+		return fallback
+	}
+
+	return value
+}
+
+// envString returns the trimmed value of the named environment variable, or
+// fallback if it is unset. A value of "none" yields an empty string, which
+// disables the corresponding header.
+func envString(name, fallback string) string {
+	raw := strings.TrimSpace(os.Getenv(name))
+
+	switch raw {
+	case "":
+		return fallback
+	case "none":
+		return ""
+	default:
+		return raw
+	}
+}
@@ -5,12 +5,19 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/gofiber/fiber/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/i18n"
+	"github.com/nicholas-fedor/eui64-calculator/internal/locale"
+	"github.com/nicholas-fedor/eui64-calculator/internal/macrange"
+	"github.com/nicholas-fedor/eui64-calculator/internal/security"
+	"github.com/nicholas-fedor/eui64-calculator/internal/ui"
 )
 
 // setupRouter creates a Fiber app for testing with the application's configuration.
@@ -60,6 +67,18 @@ func TestRouterSetup(t *testing.T) {
 			wantStatus: http.StatusOK,
 			wantBody:   "0214:22ff:fe01:2345",
 		},
+		{
+			name:   "POST /calculate - MAC range",
+			method: "POST",
+			path:   "/calculate",
+			formData: url.Values{
+				"mac":       {"00-14-22-01-23-ff"},
+				"mac-count": {"2"},
+				"ip-start":  {"2001:db8::"},
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "2001:db8::214:22ff:fe01:2400",
+		},
 		{
 			name:   "POST /calculate - Invalid MAC",
 			method: "POST",
@@ -71,6 +90,111 @@ func TestRouterSetup(t *testing.T) {
 			wantStatus: http.StatusOK,
 			wantBody:   "error-message",
 		},
+		{
+			name:   "POST /plan - Valid subnet plan",
+			method: "POST",
+			path:   "/plan",
+			formData: url.Values{
+				"plan-mac":    {"00-14-22-01-23-45"},
+				"plan-parent": {"2001:db8:1::/48"},
+				"plan-ids":    {"a-b"},
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "2001:db8:1:b:214:22ff:fe01:2345",
+		},
+		{
+			name:   "POST /matrix - Address matrix",
+			method: "POST",
+			path:   "/matrix",
+			formData: url.Values{
+				"matrix-macs":     {"00-14-22-01-23-45, 00-14-22-01-23-46"},
+				"matrix-prefixes": {"fd00:1:2:3, 2001:db8::"},
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "00-14-22-01-23-46,2001:db8::,0214:22ff:fe01:2346,2001:db8::214:22ff:fe01:2346,",
+		},
+		{
+			name:   "POST /ula - Derived ULA prefix",
+			method: "POST",
+			path:   "/ula",
+			formData: url.Values{
+				"mac":        {"00-14-22-01-23-45"},
+				"ula-method": {"derived"},
+				"ula-subnet": {"1"},
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `class="ula-prefix">fd`,
+		},
+		{
+			name:   "POST /verify - Matching address",
+			method: "POST",
+			path:   "/verify",
+			formData: url.Values{
+				"verify-mac":     {"00-14-22-01-23-45"},
+				"verify-address": {"fe80::214:22ff:fe01:2345"},
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `data-verify-status="match"`,
+		},
+		{
+			name:   "POST /verify/csv - Verification CSV",
+			method: "POST",
+			path:   "/verify/csv",
+			formData: url.Values{
+				"verify-csv": {"00-14-22-01-23-45,fe80::214:22ff:fe01:2346"},
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "00-14-22-01-23-45,fe80::214:22ff:fe01:2346,,iid_mismatch,",
+		},
+		{
+			name:   "POST /import - No file",
+			method: "POST",
+			path:   "/import",
+			formData: url.Values{
+				"import-prefix": {"2001:db8::"},
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `data-error-field="import-file"`,
+		},
+		{
+			name:   "POST /simulate - Router Advertisement simulation",
+			method: "POST",
+			path:   "/simulate",
+			formData: url.Values{
+				"ra-mac":      {"00-14-22-01-23-45"},
+				"ra-prefixes": {"2001:db8:1::/64 LA 2592000 604800"},
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "2001:db8:1:0:214:22ff:fe01:2345",
+		},
+		{
+			name:       "GET /analyze - EUI-64 address",
+			method:     "GET",
+			path:       "/analyze?address=fe80::214:22ff:fe01:2345",
+			wantStatus: http.StatusOK,
+			wantBody:   "00-14-22-01-23-45",
+		},
+		{
+			name:       "GET /duid - DUID-LL",
+			method:     "GET",
+			path:       "/duid?duid=00:03:00:01:00:14:22:01:23:45",
+			wantStatus: http.StatusOK,
+			wantBody:   "00-14-22-01-23-45",
+		},
+		{
+			name:       "GET /validate/mac - Invalid MAC",
+			method:     "GET",
+			path:       "/validate/mac?mac=00-14-22",
+			wantStatus: http.StatusOK,
+			wantBody:   "The MAC address must be six pairs of hexadecimal digits",
+		},
+		{
+			name:       "GET /validate/ip-start - Prefix with trailing ::",
+			method:     "GET",
+			path:       "/validate/ip-start?ip-start=2001:db8:85a3:0::",
+			wantStatus: http.StatusOK,
+			wantBody:   "",
+		},
 		{
 			name:       "GET /static/styles.css - Static file",
 			method:     "GET",
@@ -78,6 +202,27 @@ func TestRouterSetup(t *testing.T) {
 			wantStatus: http.StatusOK,
 			wantBody:   "body {", // Partial match for CSS content; adjust if needed
 		},
+		{
+			name:       "GET /static/htmx.min.js - Vendored HTMX",
+			method:     "GET",
+			path:       "/static/htmx.min.js",
+			wantStatus: http.StatusOK,
+			wantBody:   "htmx",
+		},
+		{
+			name:       "GET /static/app.js - Application script",
+			method:     "GET",
+			path:       "/static/app.js",
+			wantStatus: http.StatusOK,
+			wantBody:   "function copyToClipboard(elementId, buttonId)",
+		},
+		{
+			name:       "GET /static/theme.js - Theme script",
+			method:     "GET",
+			path:       "/static/theme.js",
+			wantStatus: http.StatusOK,
+			wantBody:   "function applyTheme(theme)",
+		},
 		{
 			name:       "GET /unknown - 404 Not Found",
 			method:     "GET",
@@ -87,6 +232,7 @@ func TestRouterSetup(t *testing.T) {
 	}
 
 	app := setupRouter(t)
+	cookie, token := fetchCSRFToken(t, app)
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -94,13 +240,19 @@ func TestRouterSetup(t *testing.T) {
 
 			var req *http.Request
 			if tt.method == "POST" {
+				formData := url.Values{ui.CSRFField: {token}}
+				for key, values := range tt.formData {
+					formData[key] = values
+				}
+
 				req, _ = http.NewRequestWithContext(
 					context.Background(),
 					tt.method,
 					"http://localhost"+tt.path,
-					strings.NewReader(tt.formData.Encode()),
+					strings.NewReader(formData.Encode()),
 				)
 				req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+				req.AddCookie(cookie)
 			} else {
 				req, _ = http.NewRequestWithContext(context.Background(), tt.method, "http://localhost"+tt.path, http.NoBody)
 			}
@@ -122,6 +274,36 @@ func TestRouterSetup(t *testing.T) {
 	}
 }
 
+// fetchCSRFToken loads the home page and returns the CSRF cookie it sets along
+// with the token embedded in the calculation form.
+func fetchCSRFToken(t *testing.T, app *fiber.App) (*http.Cookie, string) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://localhost/", http.NoBody)
+	require.NoError(t, err)
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	match := regexp.MustCompile(`name="` + ui.CSRFField + `" value="([^"]+)"`).FindStringSubmatch(string(body))
+	require.Len(t, match, 2, "CSRF token not found in form")
+
+	for _, cookie := range resp.Cookies() {
+		if cookie.Name == csrfCookieName {
+			return cookie, match[1]
+		}
+	}
+
+	t.Fatal("CSRF cookie not set")
+
+	return nil, ""
+}
+
 // setupProxyRouter creates a Fiber app configured for testing proxy IP behavior.
 // It trusts "0.0.0.0" because app.Test() uses that as the hardcoded RemoteAddr.
 func setupProxyRouter(t *testing.T) *fiber.App {
@@ -202,35 +384,445 @@ func TestTrustedProxies(t *testing.T) {
 	}
 }
 
+// TestCSRF verifies that POST /calculate requires a CSRF token matching the
+// cookie issued with the home page, accepting it from the form or the HTMX
+// header, and that requests carrying the configured API token are exempt.
+func TestCSRF(t *testing.T) {
+	t.Parallel()
+
+	config := LoadConfig()
+	config.APIToken = "test-token"
+
+	app, err := SetupRouter(config)
+	require.NoError(t, err)
+
+	cookie, token := fetchCSRFToken(t, app)
+
+	tests := []struct {
+		name       string
+		formToken  string
+		header     map[string]string
+		withCookie bool
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "Token in form",
+			formToken:  token,
+			withCookie: true,
+			wantStatus: http.StatusOK,
+			wantBody:   "0214:22ff:fe01:2345",
+		},
+		{
+			name:       "Token in HTMX header",
+			header:     map[string]string{ui.CSRFHeader: token, "HX-Request": "true"},
+			withCookie: true,
+			wantStatus: http.StatusOK,
+			wantBody:   "0214:22ff:fe01:2345",
+		},
+		{
+			name:       "Missing token renders error for HTMX",
+			header:     map[string]string{"HX-Request": "true"},
+			withCookie: true,
+			wantStatus: http.StatusForbidden,
+			wantBody:   `<p class="error-message" id="result-error" role="alert">`,
+		},
+		{
+			name:       "Mismatched token returns JSON error",
+			formToken:  "forged",
+			withCookie: true,
+			wantStatus: http.StatusForbidden,
+			wantBody:   `"error":`,
+		},
+		{
+			name:       "Token without cookie",
+			formToken:  token,
+			withCookie: false,
+			wantStatus: http.StatusForbidden,
+			wantBody:   `"error":`,
+		},
+		{
+			name:       "API token exempts request",
+			header:     map[string]string{defaultAPITokenHeader: "test-token"},
+			withCookie: false,
+			wantStatus: http.StatusOK,
+			wantBody:   "0214:22ff:fe01:2345",
+		},
+		{
+			name:       "Wrong API token is not exempt",
+			header:     map[string]string{defaultAPITokenHeader: "guess"},
+			withCookie: false,
+			wantStatus: http.StatusForbidden,
+			wantBody:   `"error":`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			form := url.Values{"mac": {"00-14-22-01-23-45"}, "ip-start": {"2001:db8::"}}
+			if tt.formToken != "" {
+				form.Set(ui.CSRFField, tt.formToken)
+			}
+
+			req, err := http.NewRequestWithContext(
+				t.Context(),
+				http.MethodPost,
+				"http://localhost/calculate",
+				strings.NewReader(form.Encode()),
+			)
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			for key, value := range tt.header {
+				req.Header.Set(key, value)
+			}
+
+			if tt.withCookie {
+				req.AddCookie(cookie)
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantStatus, resp.StatusCode, "Status code")
+			assert.Contains(t, string(body), tt.wantBody, "Body content")
+		})
+	}
+}
+
+// TestSecurityHeaders verifies that pages are served with the security headers
+// and that every script on the page carries the nonce allowed by the policy.
+func TestSecurityHeaders(t *testing.T) {
+	t.Parallel()
+
+	app := setupRouter(t)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://localhost/", http.NoBody)
+	require.NoError(t, err)
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	policy := resp.Header.Get(fiber.HeaderContentSecurityPolicy)
+	match := regexp.MustCompile(`'nonce-([^']+)'`).FindStringSubmatch(policy)
+	require.Len(t, match, 2, "Nonce not found in Content-Security-Policy")
+
+	assert.Equal(t, strings.Count(string(body), "<script"), strings.Count(string(body), `nonce="`+match[1]+`"`),
+		"Scripts should carry the policy nonce")
+	assert.NotContains(t, string(body), "<script>", "Inline scripts should not be present")
+	assert.NotEmpty(t, resp.Header.Get(fiber.HeaderStrictTransportSecurity), "Strict-Transport-Security")
+	assert.Equal(t, "nosniff", resp.Header.Get(fiber.HeaderXContentTypeOptions), "X-Content-Type-Options")
+	assert.NotEmpty(t, resp.Header.Get(fiber.HeaderReferrerPolicy), "Referrer-Policy")
+	assert.NotEmpty(t, resp.Header.Get(fiber.HeaderPermissionsPolicy), "Permissions-Policy")
+}
+
+// TestLocale verifies that pages are rendered in the locale selected with the
+// lang query parameter, remembered in the lang cookie, or negotiated from
+// Accept-Language, in that order, and that responses declare their language.
+func TestLocale(t *testing.T) {
+	t.Parallel()
+
+	app, err := SetupRouter(LoadConfig())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		query          string
+		cookie         string
+		acceptLanguage string
+		wantLocale     *i18n.Locale
+		wantCookie     bool
+	}{
+		{
+			name:           "No preference",
+			query:          "",
+			cookie:         "",
+			acceptLanguage: "",
+			wantLocale:     i18n.English,
+			wantCookie:     false,
+		},
+		{
+			name:           "Accept-Language with region",
+			query:          "",
+			cookie:         "",
+			acceptLanguage: "de-AT,en;q=0.5",
+			wantLocale:     i18n.German,
+			wantCookie:     false,
+		},
+		{
+			name:           "Cookie takes precedence over Accept-Language",
+			query:          "",
+			cookie:         "es",
+			acceptLanguage: "de",
+			wantLocale:     i18n.Spanish,
+			wantCookie:     false,
+		},
+		{
+			name:           "Query parameter is remembered",
+			query:          "?lang=fr",
+			cookie:         "es",
+			acceptLanguage: "de",
+			wantLocale:     i18n.French,
+			wantCookie:     true,
+		},
+		{
+			name:           "Unsupported query parameter is ignored",
+			query:          "?lang=xx",
+			cookie:         "",
+			acceptLanguage: "es",
+			wantLocale:     i18n.Spanish,
+			wantCookie:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req, _ := http.NewRequestWithContext(
+				t.Context(),
+				http.MethodGet,
+				"http://localhost/"+tt.query,
+				http.NoBody,
+			)
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: locale.DefaultCookieName, Value: tt.cookie})
+			}
+
+			if tt.acceptLanguage != "" {
+				req.Header.Set(fiber.HeaderAcceptLanguage, tt.acceptLanguage)
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantLocale.Tag, resp.Header.Get(fiber.HeaderContentLanguage))
+			assert.Contains(t, resp.Header.Get(fiber.HeaderVary), fiber.HeaderAcceptLanguage)
+			assert.Contains(t, string(body), `<html lang="`+tt.wantLocale.Tag+`">`)
+			assert.Contains(t, string(body), "<title>"+tt.wantLocale.T(i18n.KeyAppTitle)+"</title>")
+
+			var remembered string
+
+			for _, cookie := range resp.Cookies() {
+				if cookie.Name == locale.DefaultCookieName {
+					remembered = cookie.Value
+				}
+			}
+
+			if tt.wantCookie {
+				assert.Equal(t, tt.wantLocale.Tag, remembered, "Selected locale should be remembered")
+			} else {
+				assert.Empty(t, remembered, "Locale should only be remembered when selected")
+			}
+		})
+	}
+}
+
+// TestStaticAssets verifies that the home page links fingerprinted assets and
+// that those are served compressed with long-lived immutable cache headers.
+func TestStaticAssets(t *testing.T) {
+	t.Parallel()
+
+	app := setupRouter(t)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://localhost/", http.NoBody)
+	require.NoError(t, err)
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	match := regexp.MustCompile(`href="(/static/styles\.[0-9a-f]+\.css)"`).FindStringSubmatch(string(body))
+	require.Len(t, match, 2, "Fingerprinted stylesheet not linked")
+	assert.Regexp(t, `src="/static/app\.[0-9a-f]+\.js"`, string(body), "Fingerprinted script not linked")
+
+	req, err = http.NewRequestWithContext(t.Context(), http.MethodGet, "http://localhost"+match[1], http.NoBody)
+	require.NoError(t, err)
+	req.Header.Set(fiber.HeaderAcceptEncoding, "gzip, br")
+
+	assetResp, err := app.Test(req)
+	require.NoError(t, err)
+
+	defer assetResp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, assetResp.StatusCode, "Status code")
+	assert.Contains(t, assetResp.Header.Get(fiber.HeaderCacheControl), "immutable", "Cache-Control")
+	assert.Equal(t, "br", assetResp.Header.Get(fiber.HeaderContentEncoding), "Content-Encoding")
+	assert.NotEmpty(t, assetResp.Header.Get(fiber.HeaderETag), "ETag")
+}
+
 // TestLoadConfig to cover Lines 37 and 56.
 func TestLoadConfig(t *testing.T) {
 	tests := []struct {
-		name           string
-		portEnv        string
-		trustedProxies string
-		wantPort       string
-		wantProxies    []string
+		name              string
+		portEnv           string
+		trustedProxies    string
+		rateLimit         string
+		rateLimitBurst    string
+		maxConcurrent     string
+		maxMACRange       string
+		csp               string
+		hstsMaxAge        string
+		apiToken          string
+		enablePWA         string
+		wantPort          string
+		wantProxies       []string
+		wantRateLimit     float64
+		wantRateBurst     int
+		wantMaxConcurrent int
+		wantMaxMACRange   int
+		wantCSP           string
+		wantHSTSMaxAge    int
+		wantAPIToken      string
+		wantEnablePWA     bool
 	}{
 		{
-			name:           "Default config",
-			portEnv:        "",
-			trustedProxies: "",
-			wantPort:       ":" + defaultPort,
-			wantProxies:    nil,
+			name:              "Default config",
+			portEnv:           "",
+			trustedProxies:    "",
+			wantPort:          ":" + defaultPort,
+			wantProxies:       nil,
+			wantRateLimit:     defaultRateLimit,
+			wantRateBurst:     defaultRateLimitBurst,
+			wantMaxConcurrent: defaultMaxConcurrent,
+			wantMaxMACRange:   macrange.DefaultMaxCount,
+			wantCSP:           security.DefaultContentSecurityPolicy,
+			wantHSTSMaxAge:    security.DefaultHSTSMaxAge,
+			wantEnablePWA:     true,
+		},
+		{
+			name:              "Custom port (Line 37)",
+			portEnv:           "9090",
+			trustedProxies:    "",
+			wantPort:          ":9090",
+			wantProxies:       nil,
+			wantRateLimit:     defaultRateLimit,
+			wantRateBurst:     defaultRateLimitBurst,
+			wantMaxConcurrent: defaultMaxConcurrent,
+			wantMaxMACRange:   macrange.DefaultMaxCount,
+			wantCSP:           security.DefaultContentSecurityPolicy,
+			wantHSTSMaxAge:    security.DefaultHSTSMaxAge,
+			wantEnablePWA:     true,
+		},
+		{
+			name:              "Trusted proxies with empty entry",
+			portEnv:           "",
+			trustedProxies:    "192.168.1.1, ,192.168.1.2",
+			wantPort:          ":" + defaultPort,
+			wantProxies:       []string{"192.168.1.1", "192.168.1.2"},
+			wantRateLimit:     defaultRateLimit,
+			wantRateBurst:     defaultRateLimitBurst,
+			wantMaxConcurrent: defaultMaxConcurrent,
+			wantMaxMACRange:   macrange.DefaultMaxCount,
+			wantCSP:           security.DefaultContentSecurityPolicy,
+			wantHSTSMaxAge:    security.DefaultHSTSMaxAge,
+			wantEnablePWA:     true,
+		},
+		{
+			name:              "Custom rate limiting",
+			rateLimit:         "0.5",
+			rateLimitBurst:    "3",
+			maxConcurrent:     "0",
+			maxMACRange:       "1024",
+			wantPort:          ":" + defaultPort,
+			wantProxies:       nil,
+			wantRateLimit:     0.5,
+			wantRateBurst:     3,
+			wantMaxConcurrent: 0,
+			wantMaxMACRange:   1024,
+			wantCSP:           security.DefaultContentSecurityPolicy,
+			wantHSTSMaxAge:    security.DefaultHSTSMaxAge,
+			wantEnablePWA:     true,
+		},
+		{
+			name:              "Custom security headers",
+			csp:               "default-src 'none'",
+			hstsMaxAge:        "0",
+			wantPort:          ":" + defaultPort,
+			wantProxies:       nil,
+			wantRateLimit:     defaultRateLimit,
+			wantRateBurst:     defaultRateLimitBurst,
+			wantMaxConcurrent: defaultMaxConcurrent,
+			wantMaxMACRange:   macrange.DefaultMaxCount,
+			wantCSP:           "default-src 'none'",
+			wantHSTSMaxAge:    0,
+			wantEnablePWA:     true,
+		},
+		{
+			name:              "Disabled Content Security Policy",
+			csp:               "none",
+			wantPort:          ":" + defaultPort,
+			wantProxies:       nil,
+			wantRateLimit:     defaultRateLimit,
+			wantRateBurst:     defaultRateLimitBurst,
+			wantMaxConcurrent: defaultMaxConcurrent,
+			wantMaxMACRange:   macrange.DefaultMaxCount,
+			wantCSP:           "",
+			wantHSTSMaxAge:    security.DefaultHSTSMaxAge,
+			wantEnablePWA:     true,
+		},
+		{
+			name:              "API token",
+			apiToken:          "secret",
+			wantPort:          ":" + defaultPort,
+			wantProxies:       nil,
+			wantRateLimit:     defaultRateLimit,
+			wantRateBurst:     defaultRateLimitBurst,
+			wantMaxConcurrent: defaultMaxConcurrent,
+			wantMaxMACRange:   macrange.DefaultMaxCount,
+			wantCSP:           security.DefaultContentSecurityPolicy,
+			wantHSTSMaxAge:    security.DefaultHSTSMaxAge,
+			wantEnablePWA:     true,
+			wantAPIToken:      "secret",
 		},
 		{
-			name:           "Custom port (Line 37)",
-			portEnv:        "9090",
-			trustedProxies: "",
-			wantPort:       ":9090",
-			wantProxies:    nil,
+			name:              "Offline client disabled",
+			enablePWA:         "false",
+			wantPort:          ":" + defaultPort,
+			wantProxies:       nil,
+			wantRateLimit:     defaultRateLimit,
+			wantRateBurst:     defaultRateLimitBurst,
+			wantMaxConcurrent: defaultMaxConcurrent,
+			wantMaxMACRange:   macrange.DefaultMaxCount,
+			wantCSP:           security.DefaultContentSecurityPolicy,
+			wantHSTSMaxAge:    security.DefaultHSTSMaxAge,
+			wantEnablePWA:     false,
 		},
 		{
-			name:           "Trusted proxies with empty entry",
-			portEnv:        "",
-			trustedProxies: "192.168.1.1, ,192.168.1.2",
-			wantPort:       ":" + defaultPort,
-			wantProxies:    []string{"192.168.1.1", "192.168.1.2"},
+			name:              "Invalid rate limiting falls back to defaults",
+			rateLimit:         "fast",
+			rateLimitBurst:    "-1",
+			maxConcurrent:     "many",
+			wantPort:          ":" + defaultPort,
+			wantProxies:       nil,
+			wantRateLimit:     defaultRateLimit,
+			wantRateBurst:     defaultRateLimitBurst,
+			wantMaxConcurrent: defaultMaxConcurrent,
+			wantMaxMACRange:   macrange.DefaultMaxCount,
+			wantCSP:           security.DefaultContentSecurityPolicy,
+			wantHSTSMaxAge:    security.DefaultHSTSMaxAge,
+			wantEnablePWA:     true,
 		},
 	}
 
@@ -239,11 +831,93 @@ func TestLoadConfig(t *testing.T) {
 			// Clear and set environment variables
 			t.Setenv("PORT", tt.portEnv)
 			t.Setenv(trustedProxiesEnv, tt.trustedProxies)
+			t.Setenv(rateLimitEnv, tt.rateLimit)
+			t.Setenv(rateLimitBurstEnv, tt.rateLimitBurst)
+			t.Setenv(maxConcurrentEnv, tt.maxConcurrent)
+			t.Setenv(maxMACRangeEnv, tt.maxMACRange)
+			t.Setenv(cspEnv, tt.csp)
+			t.Setenv(hstsMaxAgeEnv, tt.hstsMaxAge)
+			t.Setenv(apiTokenEnv, tt.apiToken)
+			t.Setenv(enablePWAEnv, tt.enablePWA)
 
 			config := LoadConfig()
 
 			assert.Equal(t, tt.wantPort, config.Port, "Port")
 			assert.Equal(t, tt.wantProxies, config.TrustedProxies, "TrustedProxies")
+			assert.InDelta(t, tt.wantRateLimit, config.RateLimit, 0, "RateLimit")
+			assert.Equal(t, tt.wantRateBurst, config.RateLimitBurst, "RateLimitBurst")
+			assert.Equal(t, tt.wantMaxConcurrent, config.MaxConcurrent, "MaxConcurrent")
+			assert.Equal(t, tt.wantMaxMACRange, config.MaxMACRange, "MaxMACRange")
+			assert.Equal(t, tt.wantCSP, config.Security.ContentSecurityPolicy, "ContentSecurityPolicy")
+			assert.Equal(t, tt.wantHSTSMaxAge, config.Security.HSTSMaxAge, "HSTSMaxAge")
+			assert.Equal(t, tt.wantAPIToken, config.APIToken, "APIToken")
+			assert.Equal(t, defaultAPITokenHeader, config.APITokenHeader, "APITokenHeader")
+			assert.Equal(t, tt.wantEnablePWA, config.EnablePWA, "EnablePWA")
 		})
 	}
 }
+
+// TestRateLimit verifies that SetupRouter applies the per-client rate limit to
+// POST /calculate, keyed on the client IP resolved through the trusted proxy
+// configuration, and renders rejections as HTML for HTMX and JSON otherwise.
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	app, err := SetupRouter(Config{
+		Port:           ":" + defaultPort,
+		TrustedProxies: []string{"0.0.0.0"},
+		RateLimit:      1,
+		RateLimitBurst: 1,
+		MaxConcurrent:  0,
+		Security:       security.DefaultConfig(),
+		APIToken:       "test-token",
+		APITokenHeader: defaultAPITokenHeader,
+		SecureCookies:  false,
+	})
+	require.NoError(t, err)
+
+	calculate := func(clientIP string, htmx bool) (*http.Response, string) {
+		t.Helper()
+
+		form := url.Values{"mac": {"00-14-22-01-23-45"}, "ip-start": {"2001:db8::"}}
+
+		req, err := http.NewRequestWithContext(
+			t.Context(),
+			http.MethodPost,
+			"http://localhost/calculate",
+			strings.NewReader(form.Encode()),
+		)
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set(fiber.HeaderXForwardedFor, clientIP)
+		req.Header.Set(defaultAPITokenHeader, "test-token")
+
+		if htmx {
+			req.Header.Set("HX-Request", "true")
+		}
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		return resp, string(body)
+	}
+
+	resp, _ := calculate("203.0.113.1", true)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "First request")
+
+	resp, body := calculate("203.0.113.1", true)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode, "Second HTMX request")
+	assert.Contains(t, body, "error-message", "HTMX rejection body")
+
+	resp, body = calculate("203.0.113.1", false)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode, "Third API request")
+	assert.Contains(t, body, `"error":`, "API rejection body")
+
+	resp, _ = calculate("203.0.113.2", true)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "Request from another client")
+}
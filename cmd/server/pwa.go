@@ -0,0 +1,66 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/assets"
+)
+
+// Constants defining how the offline-capable client is served.
+const (
+	// pwaDir is the directory below the static prefix holding the client files.
+	pwaDir = "pwa"
+	// serviceWorkerFile is the service worker script within the client files.
+	serviceWorkerFile = "sw.js"
+	// serviceWorkerPath is the URL of the service worker. It is served from the
+	// root so the worker's scope covers the whole site.
+	serviceWorkerPath = "/" + serviceWorkerFile
+)
+
+// pwaRequiredFiles lists the client files that must be present for the
+// service worker and offline calculations to work.
+var pwaRequiredFiles = []string{
+	serviceWorkerFile,
+	"manifest.webmanifest",
+	"main.wasm",
+	"wasm_exec.js",
+}
+
+// ErrPWAIncomplete is returned when the embedded client lacks a required file,
+// usually because go generate was not run before building with the pwa tag.
+var ErrPWAIncomplete = errors.New(`offline client is incomplete; run "go generate -tags pwa ./cmd/server"`)
+
+// setupPWA adds the offline-capable client files to the asset manifest and
+// serves the service worker from the site root. The worker is sent with
+// Cache-Control: no-cache so browsers pick up new releases promptly.
+//
+//nolint:wrapcheck // Returning Fiber response directly
+func setupPWA(app *fiber.App, manifest *assets.Manifest, files fs.FS) error {
+	for _, name := range pwaRequiredFiles {
+		if _, err := fs.Stat(files, name); err != nil {
+			return fmt.Errorf("%w: %w", ErrPWAIncomplete, err)
+		}
+	}
+
+	serviceWorker, err := fs.ReadFile(files, serviceWorkerFile)
+	if err != nil {
+		return fmt.Errorf("reading service worker: %w", err)
+	}
+
+	if err := manifest.Add(files, pwaDir); err != nil {
+		return fmt.Errorf("adding offline client assets: %w", err)
+	}
+
+	app.Get(serviceWorkerPath, func(c fiber.Ctx) error {
+		c.Set(fiber.HeaderCacheControl, "no-cache")
+		c.Set(fiber.HeaderContentType, "text/javascript; charset=utf-8")
+
+		return c.Send(serviceWorker)
+	})
+
+	return nil
+}
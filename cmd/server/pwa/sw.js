@@ -0,0 +1,120 @@
+// Service worker that keeps the self-hosted calculator available offline.
+// The home page is fetched network-first so it stays current, while static assets,
+// whose URLs are fingerprinted, are served cache-first. Calculations that cannot
+// reach the server fall back to the WebAssembly client in app.js.
+
+const CACHE_NAME = "eui64-calculator-v1";
+const HOME_URL = "/";
+const STATIC_PREFIX = "/static/";
+
+// Extracts the static asset URLs referenced by a page so they can be cached with it.
+function staticURLs(html) {
+  const urls = new Set();
+  for (const match of html.matchAll(/(?:href|src|content)="(\/static\/[^"]+)"/g)) {
+    urls.add(match[1]);
+  }
+  return [...urls];
+}
+
+// Caches a response for the home page along with every asset it references,
+// including the WebAssembly client used for offline calculations, and evicts
+// the assets of previous releases it no longer references.
+async function cacheHome(cache, response) {
+  const html = await response.clone().text();
+  const urls = staticURLs(html);
+  await cache.put(HOME_URL, response);
+  await cache.addAll(urls);
+
+  const current = new Set(urls);
+  for (const request of await cache.keys()) {
+    const { pathname } = new URL(request.url);
+    if (pathname.startsWith(STATIC_PREFIX) && !current.has(pathname)) {
+      await cache.delete(request);
+    }
+  }
+}
+
+// Caches the home page and its assets when the worker is installed.
+async function precache() {
+  const cache = await caches.open(CACHE_NAME);
+  const response = await fetch(HOME_URL, { cache: "no-store" });
+  if (!response.ok) {
+    throw new Error(`Failed to fetch ${HOME_URL}: ${response.status}`);
+  }
+
+  await cacheHome(cache, response);
+}
+
+// Answers navigations from the network, refreshing the cached home page and its
+// assets in the background, as a new release changes their fingerprints, and
+// falls back to the cached copy when the server is unreachable.
+async function networkFirst(event) {
+  const { request } = event;
+  const cache = await caches.open(CACHE_NAME);
+  try {
+    const response = await fetch(request);
+    if (response.ok && new URL(request.url).pathname === HOME_URL) {
+      event.waitUntil(
+        cacheHome(cache, response.clone()).catch((err) =>
+          console.error("Failed to refresh the offline cache:", err)
+        )
+      );
+    }
+    return response;
+  } catch (err) {
+    const cached = await cache.match(HOME_URL);
+    if (cached) {
+      return cached;
+    }
+    throw err;
+  }
+}
+
+// Answers static asset requests from the cache, storing network responses for next time.
+async function cacheFirst(request) {
+  const cache = await caches.open(CACHE_NAME);
+  const cached = await cache.match(request);
+  if (cached) {
+    return cached;
+  }
+
+  const response = await fetch(request);
+  if (response.ok) {
+    await cache.put(request, response.clone());
+  }
+  return response;
+}
+
+self.addEventListener("install", (event) => {
+  event.waitUntil(precache().then(() => self.skipWaiting()));
+});
+
+// Removes caches left behind by previous versions of this worker.
+self.addEventListener("activate", (event) => {
+  event.waitUntil(
+    caches
+      .keys()
+      .then((keys) =>
+        Promise.all(
+          keys
+            .filter((key) => key !== CACHE_NAME)
+            .map((key) => caches.delete(key))
+        )
+      )
+      .then(() => self.clients.claim())
+  );
+});
+
+self.addEventListener("fetch", (event) => {
+  const { request } = event;
+  const url = new URL(request.url);
+  if (request.method !== "GET" || url.origin !== self.location.origin) {
+    return;
+  }
+
+  if (request.mode === "navigate") {
+    event.respondWith(networkFirst(event));
+  } else if (url.pathname.startsWith(STATIC_PREFIX)) {
+    event.respondWith(cacheFirst(request));
+  }
+});
@@ -0,0 +1,28 @@
+//go:build pwa
+
+package main
+
+import (
+	"embed"
+	"io/fs"
+)
+
+// Build the WebAssembly client, copy its runtime into pwa/, and fingerprint the
+// files before compiling with the pwa tag.
+//
+//go:generate sh -c "GOOS=js GOARCH=wasm go build -trimpath -o pwa/main.wasm ../../build/gh-pages/wasm"
+//go:generate sh -c "cp \"$(go env GOROOT)/lib/wasm/wasm_exec.js\" pwa/"
+//go:generate go run ../../build/fingerprint pwa
+
+//go:embed pwa
+var pwaFS embed.FS // Embeds the service worker, web manifest, icons, and WebAssembly client.
+
+// embeddedPWA returns the files of the offline-capable client, rooted at pwa/.
+func embeddedPWA() fs.FS {
+	files, err := fs.Sub(pwaFS, "pwa")
+	if err != nil {
+		return nil
+	}
+
+	return files
+}
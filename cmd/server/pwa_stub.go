@@ -0,0 +1,11 @@
+//go:build !pwa
+
+package main
+
+import "io/fs"
+
+// embeddedPWA returns nil because the offline-capable client is only embedded
+// in binaries built with the pwa tag.
+func embeddedPWA() fs.FS {
+	return nil
+}
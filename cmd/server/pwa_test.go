@@ -0,0 +1,137 @@
+package main
+
+import (
+	"io"
+	"io/fs"
+	"net/http"
+	"regexp"
+	"testing"
+	"testing/fstest"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPWAFiles returns a stand-in for the embedded offline-capable client.
+func testPWAFiles() fstest.MapFS {
+	return fstest.MapFS{
+		"sw.js":                {Data: []byte("self.addEventListener('fetch', () => {});")},
+		"manifest.webmanifest": {Data: []byte(`{"name":"EUI-64 Calculator"}`)},
+		"main.wasm":            {Data: []byte("\x00asm\x01\x00\x00\x00")},
+		"wasm_exec.js":         {Data: []byte("globalThis.Go = class {};")},
+	}
+}
+
+// TestPWA tests serving the offline-capable client.
+// It verifies that the home page links the web manifest and WebAssembly client,
+// that the service worker is served from the root without caching, and that the
+// client is left out when disabled or not embedded.
+func TestPWA(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		enablePWA     bool
+		files         fs.FS
+		wantManifest  bool
+		wantSWStatus  int
+		wantSWCaching string
+	}{
+		{
+			name:          "Enabled and embedded",
+			enablePWA:     true,
+			files:         testPWAFiles(),
+			wantManifest:  true,
+			wantSWStatus:  http.StatusOK,
+			wantSWCaching: "no-cache",
+		},
+		{
+			name:          "Disabled",
+			enablePWA:     false,
+			files:         testPWAFiles(),
+			wantManifest:  false,
+			wantSWStatus:  http.StatusNotFound,
+			wantSWCaching: "",
+		},
+		{
+			name:          "Not embedded",
+			enablePWA:     true,
+			files:         nil,
+			wantManifest:  false,
+			wantSWStatus:  http.StatusNotFound,
+			wantSWCaching: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := LoadConfig()
+			config.EnablePWA = tt.enablePWA
+
+			app, err := newApp(config, tt.files)
+			require.NoError(t, err)
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://localhost/", http.NoBody)
+			require.NoError(t, err)
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			manifestLink := regexp.MustCompile(`<link rel="manifest" href="(/static/pwa/manifest\.[0-9a-f]+\.webmanifest)"`)
+			assert.Equal(t, tt.wantManifest, manifestLink.Match(body), "Manifest link")
+			assert.Equal(t, tt.wantManifest, regexp.MustCompile(`<template id="offline-result">`).Match(body),
+				"Offline result template")
+
+			if tt.wantManifest {
+				module := regexp.MustCompile(`<meta name="wasm-module" content="([^"]+)"`).FindSubmatch(body)
+				require.Len(t, module, 2, "WebAssembly module not advertised")
+
+				req, err = http.NewRequestWithContext(t.Context(), http.MethodGet, "http://localhost"+string(module[1]), http.NoBody)
+				require.NoError(t, err)
+
+				wasmResp, err := app.Test(req)
+				require.NoError(t, err)
+
+				defer wasmResp.Body.Close()
+
+				assert.Equal(t, http.StatusOK, wasmResp.StatusCode, "WebAssembly module status")
+				assert.Equal(t, "application/wasm", wasmResp.Header.Get(fiber.HeaderContentType), "WebAssembly content type")
+			}
+
+			req, err = http.NewRequestWithContext(t.Context(), http.MethodGet, "http://localhost"+serviceWorkerPath, http.NoBody)
+			require.NoError(t, err)
+
+			swResp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer swResp.Body.Close()
+
+			assert.Equal(t, tt.wantSWStatus, swResp.StatusCode, "Service worker status")
+			assert.Equal(t, tt.wantSWCaching, swResp.Header.Get(fiber.HeaderCacheControl), "Service worker Cache-Control")
+		})
+	}
+}
+
+// TestPWAIncomplete verifies that setup fails when the embedded client lacks
+// the WebAssembly build produced by go generate.
+func TestPWAIncomplete(t *testing.T) {
+	t.Parallel()
+
+	files := testPWAFiles()
+	delete(files, "main.wasm")
+
+	config := LoadConfig()
+	config.EnablePWA = true
+
+	_, err := newApp(config, files)
+	require.ErrorIs(t, err, ErrPWAIncomplete)
+	require.ErrorIs(t, err, ErrSetupRouter)
+}
@@ -0,0 +1,1027 @@
+// Client-side behavior for the server-rendered EUI-64 calculator.
+// Loaded as an external script so the page can run under a strict Content Security Policy.
+
+// Returns the messages the page provides, in its language, for text shown by this script.
+function messages() {
+  const form = document.querySelector("form[data-messages]");
+  try {
+    return form ? JSON.parse(form.dataset.messages) : {};
+  } catch (err) {
+    console.error("Invalid page messages:", err);
+    return {};
+  }
+}
+
+// Copies the value of an input element to the clipboard and shows the button's "Copied" state for 2 seconds.
+function copyToClipboard(elementId, buttonId) {
+  const input = document.getElementById(elementId);
+  const button = document.getElementById(buttonId);
+
+  if (!input || !button) {
+    console.error(`Element not found: ${elementId} or ${buttonId}`);
+    return;
+  }
+
+  navigator.clipboard
+    .writeText(input.value)
+    .then(() => {
+      button.classList.add("copied");
+      announce(messages().copied);
+      setTimeout(() => {
+        button.classList.remove("copied");
+      }, 2000); // Remove "Copied!" after 2 seconds
+    })
+    .catch((err) => {
+      console.error("Failed to copy: ", err);
+    });
+}
+
+// Handles clicks on any copy button, including those swapped in by HTMX, via its data-copy-target attribute.
+document.addEventListener("click", (event) => {
+  const button = event.target.closest("[data-copy-target]");
+  if (button) {
+    copyToClipboard(button.dataset.copyTarget, button.id);
+  }
+});
+
+// Announces a short message through the page's status region.
+function announce(message) {
+  const announcer = document.getElementById("announcer");
+  if (!announcer) {
+    return;
+  }
+
+  announcer.textContent = message;
+  setTimeout(() => {
+    announcer.textContent = "";
+  }, 2000);
+}
+
+// Marks the form fields named by an error in the result, plan, ULA, analysis,
+// verification, import, or simulation container, or with an inline validation message, as invalid,
+// linking them to the error through their aria-errormessage attribute, and clears the state of
+// any other field.
+function markInvalidField() {
+  const fields = new Set(
+    Array.from(
+      document.querySelectorAll(
+        ".result-container [data-error-field], .plan-result [data-error-field], .ula-result [data-error-field], .analysis-result [data-error-field], .duid-result [data-error-field], .verify-result [data-error-field], .import-result [data-error-field], .ra-result [data-error-field]"
+      ),
+      (error) => error.dataset.errorField
+    )
+  );
+
+  document.querySelectorAll("form [aria-errormessage]").forEach((input) => {
+    const message = document.querySelector(
+      `[data-field-message="${input.id}"]`
+    );
+    if (fields.has(input.id) || (message && message.textContent.trim())) {
+      input.setAttribute("aria-invalid", "true");
+    } else {
+      input.removeAttribute("aria-invalid");
+    }
+  });
+}
+
+// Enters the subnet of a generated ULA prefix into the IPv6 prefix field and
+// validates it as if the user had typed it.
+function usePrefix(button) {
+  const input = document.getElementById("ip-start");
+  if (!input) {
+    return;
+  }
+
+  input.value = button.dataset.usePrefix;
+  input.dispatchEvent(new KeyboardEvent("keyup"));
+  input.focus();
+}
+
+document.addEventListener("click", (event) => {
+  const button = event.target.closest("[data-use-prefix]");
+  if (button) {
+    usePrefix(button);
+  }
+});
+
+// Clears the result and inline validation messages, the subnet plan, or the
+// simulated addresses, and the invalid state they set, when the calculator,
+// subnet planner, or Router Advertisement simulator form is reset.
+function clearResult(event) {
+  if (event.target.matches("[data-plan-form]")) {
+    const planContainer = document.getElementById("plan-result");
+    if (planContainer) {
+      planContainer.replaceChildren();
+    }
+    markInvalidField();
+    return;
+  }
+  if (event.target.matches("[data-ra-form]")) {
+    const raContainer = document.getElementById("ra-result");
+    if (raContainer) {
+      raContainer.replaceChildren();
+    }
+    markInvalidField();
+    return;
+  }
+
+  const resultContainer = document.querySelector(".result-container");
+  if (resultContainer) {
+    resultContainer.replaceChildren();
+  }
+  document
+    .querySelectorAll("[data-field-message]")
+    .forEach((message) => message.replaceChildren());
+  markInvalidField();
+}
+
+// Updates the invalid state of the form fields once HTMX has swapped a result in.
+document.addEventListener("htmx:afterSwap", markInvalidField);
+
+// Marks the result, plan, ULA, analysis, DUID, verification, import, or simulation container busy
+// while a request for it is in flight.
+document.addEventListener("htmx:beforeRequest", (event) => {
+  if (
+    event.detail.target.matches(
+      ".result-container, .plan-result, .ula-result, .analysis-result, .duid-result, .verify-result, .import-result, .ra-result"
+    )
+  ) {
+    event.detail.target.setAttribute("aria-busy", "true");
+  }
+});
+
+document.addEventListener("htmx:afterRequest", (event) => {
+  if (
+    event.detail.target.matches(
+      ".result-container, .plan-result, .ula-result, .analysis-result, .duid-result, .verify-result, .import-result, .ra-result"
+    )
+  ) {
+    event.detail.target.removeAttribute("aria-busy");
+  }
+});
+
+// Clears a field's invalid state as soon as it is edited.
+document.addEventListener("input", (event) => {
+  if (event.target.getAttribute("aria-invalid") === "true") {
+    event.target.removeAttribute("aria-invalid");
+  }
+});
+
+document.addEventListener("reset", clearResult);
+
+// Switches to the page for the language chosen in the language selector.
+document.addEventListener("change", (event) => {
+  if (!event.target.matches("[data-language-select]")) {
+    return;
+  }
+
+  const option = event.target.selectedOptions[0];
+  if (option && option.dataset.href) {
+    window.location.href = option.dataset.href;
+  }
+});
+
+// Returns the pressed key combination in the aria-keyshortcuts syntax, using the
+// physical key for letters so shortcuts work whatever characters Alt produces.
+function keyCombination(event) {
+  const keys = [
+    ["Control", event.ctrlKey],
+    ["Alt", event.altKey],
+    ["Shift", event.shiftKey],
+    ["Meta", event.metaKey],
+  ]
+    .filter(([, pressed]) => pressed)
+    .map(([name]) => name);
+  keys.push(event.code.startsWith("Key") ? event.code.slice(3) : event.key);
+  return keys.join("+");
+}
+
+// Handles the keyboard shortcuts declared by elements' aria-keyshortcuts
+// attributes: fields are focused and buttons are clicked. Escape only clears
+// the form while focus is inside it.
+document.addEventListener("keydown", (event) => {
+  if (event.repeat || event.isComposing) {
+    return;
+  }
+
+  const combination = keyCombination(event);
+  const target = Array.from(
+    document.querySelectorAll("[aria-keyshortcuts]")
+  ).find((element) =>
+    element.getAttribute("aria-keyshortcuts").split(" ").includes(combination)
+  );
+  if (!target) {
+    return;
+  }
+  if (
+    combination === "Escape" &&
+    !(target.form && target.form.contains(event.target))
+  ) {
+    return;
+  }
+
+  event.preventDefault();
+  if (target.matches("input, select, textarea")) {
+    target.focus();
+    target.select();
+  } else {
+    target.click();
+  }
+});
+
+// Shows CSRF and rate-limit errors in the result container instead of discarding them.
+document.addEventListener("htmx:beforeSwap", (event) => {
+  if ([403, 429].includes(event.detail.xhr.status)) {
+    event.detail.shouldSwap = true;
+    event.detail.isError = false;
+  }
+});
+
+// Registers the service worker when the server offers the offline-capable client.
+if (
+  "serviceWorker" in navigator &&
+  document.querySelector('link[rel="manifest"]')
+) {
+  navigator.serviceWorker
+    .register("/sw.js")
+    .catch((err) => console.error("Service worker registration failed:", err));
+}
+
+let wasmReady;
+
+// Loads the Go WebAssembly runtime and calculator advertised by the page, once.
+function loadWasm() {
+  if (wasmReady) {
+    return wasmReady;
+  }
+
+  const runtime = document.querySelector('meta[name="wasm-runtime"]');
+  const module = document.querySelector('meta[name="wasm-module"]');
+  if (!runtime || !module) {
+    return Promise.reject(new Error("WebAssembly client not available"));
+  }
+
+  wasmReady = new Promise((resolve, reject) => {
+    const script = document.createElement("script");
+    script.src = runtime.content;
+    script.onload = resolve;
+    script.onerror = () => reject(new Error("Failed to load WebAssembly runtime"));
+    document.head.appendChild(script);
+  })
+    .then(() => {
+      const go = new Go();
+      return WebAssembly.instantiateStreaming(
+        fetch(module.content),
+        go.importObject
+      ).then((result) => {
+        go.run(result.instance);
+      });
+    })
+    .catch((err) => {
+      wasmReady = undefined;
+      throw err;
+    });
+
+  return wasmReady;
+}
+
+// Replaces the content of the container matching the selector with the given
+// nodes.
+function showIn(selector, ...nodes) {
+  const container = document.querySelector(selector);
+  if (!container) {
+    return;
+  }
+
+  container.replaceChildren(...nodes);
+  markInvalidField();
+}
+
+// Replaces the result container's content with the given nodes.
+function showResult(...nodes) {
+  showIn(".result-container", ...nodes);
+}
+
+// Returns an error message with the same markup as the server's and the given
+// id, optionally naming the form field it refers to.
+function errorElement(id, message, field) {
+  const error = document.createElement("p");
+  error.className = "error-message";
+  error.id = id;
+  error.setAttribute("role", "alert");
+  if (field) {
+    error.dataset.errorField = field;
+  }
+  error.textContent = message;
+  return error;
+}
+
+// Returns the input a validation error returned by WebAssembly refers to, with
+// the offending part marked like the server does, or nothing if the error does
+// not locate one.
+function highlightElements(error, input) {
+  if (!error.value) {
+    return [];
+  }
+
+  const highlight = document.createElement("p");
+  highlight.className = "error-input";
+  const code = document.createElement("code");
+  const mark = document.createElement("mark");
+  mark.textContent = input.slice(error.start, error.end);
+  code.append(input.slice(0, error.start), mark, input.slice(error.end));
+  highlight.append(code);
+  return [highlight];
+}
+
+// Shows an error message in the result container, with the same markup as the
+// server's, optionally naming the form field it refers to.
+function showError(message, field) {
+  showResult(errorElement("result-error", message, field));
+}
+
+// Shows the validation error returned by a WebAssembly validator for the given
+// field, followed by the input with the part the error refers to marked, when
+// the error locates one, like the server does.
+function showValidationError(error, field, input) {
+  showResult(
+    errorElement("result-error", error.message, field),
+    ...highlightElements(error, input)
+  );
+}
+
+// Fills the prefix classification of a cloned result template from a
+// WebAssembly result, revealing the type and any warning about the prefix.
+function showPrefixType(fragment, result) {
+  const prefixType = fragment.querySelector(".prefix-type");
+  const warning = fragment.querySelector(".prefix-warning");
+  if (!prefixType || !warning) {
+    return;
+  }
+  prefixType.dataset.prefixType = result.prefixType;
+  prefixType.dataset.slaac = String(result.slaac);
+  prefixType.querySelector(".prefix-type-name").textContent =
+    result.prefixTypeName;
+  prefixType.hidden = !result.prefixType;
+  warning.textContent = result.prefixWarning;
+  warning.hidden = !result.prefixWarning;
+}
+
+// Fills the DUID list of a cloned result template from a WebAssembly result,
+// revealing it when the MAC address has DUIDs.
+function showDUIDs(fragment, result) {
+  const list = fragment.querySelector(".duid-list");
+  if (!list) {
+    return;
+  }
+  list.querySelector(".duid-ll").textContent = result.duidLL;
+  list.querySelector(".duid-llt").textContent = result.duidLLT;
+  list.hidden = !result.duidLL;
+}
+
+// The calculator's form fields, by the calculateRange argument they provide.
+const rangeFields = {
+  start: "mac",
+  end: "mac-end",
+  count: "mac-count",
+  prefix: "ip-start",
+  linkType: "link-type",
+};
+
+// The calculator's DUID fields, by the calculateEUI64 argument they provide.
+const duidFields = {
+  hardware: "duid-hardware",
+  time: "duid-time",
+};
+
+// Renders the addresses of a MAC range computed by WebAssembly with the page's
+// range template, adding a table row per address.
+function rangeFragment(template, range) {
+  const fragment = template.content.cloneNode(true);
+  fragment.querySelector("caption").textContent = range.caption;
+
+  const body = fragment.querySelector("tbody");
+  range.addresses.forEach((address) => {
+    const row = document.createElement("tr");
+    [address.mac, address.interfaceID, address.fullIP].forEach((value) => {
+      const cell = document.createElement("td");
+      const code = document.createElement("code");
+      code.textContent = value;
+      cell.append(code);
+      row.append(cell);
+    });
+    body.append(row);
+  });
+  showPrefixType(fragment, range);
+
+  return fragment;
+}
+
+// Calculates the EUI-64 addresses of a MAC range in the browser, rendering
+// them with the same markup as the server's result.
+function calculateRangeOffline(form) {
+  const template = document.getElementById("offline-range-result");
+  const values = Object.fromEntries(
+    Object.entries(rangeFields).map(([arg, id]) => [
+      arg,
+      form.elements[id].value,
+    ])
+  );
+
+  loadWasm()
+    .then(() => {
+      const result = window.calculateRange(
+        values.start,
+        values.end,
+        values.count,
+        values.prefix,
+        values.linkType
+      );
+      if (typeof result === "string") {
+        showError(messages().calculation);
+        return;
+      }
+      if (result.message) {
+        showValidationError(
+          result,
+          rangeFields[result.input],
+          values[result.input]
+        );
+        return;
+      }
+
+      showResult(rangeFragment(template, result));
+    })
+    .catch((err) => {
+      console.error("Offline range calculation failed:", err);
+      showError(messages().offline);
+    });
+}
+
+// Calculates the EUI-64 address in the browser, rendering it with the same
+// markup as the server's result. An end address or count makes the MAC address
+// the start of a range, whose addresses are calculated instead.
+function calculateOffline(form) {
+  const end = form.elements["mac-end"];
+  const count = form.elements["mac-count"];
+  if ((end && end.value.trim()) || (count && count.value.trim())) {
+    calculateRangeOffline(form);
+    return;
+  }
+
+  const template = document.getElementById("offline-result");
+  const mac = form.elements.mac.value;
+  const prefix = form.elements["ip-start"].value;
+  const link = linkType(form);
+  const duid = Object.fromEntries(
+    Object.entries(duidFields).map(([arg, id]) => {
+      const input = form.elements[id];
+      return [arg, input ? input.value : ""];
+    })
+  );
+
+  loadWasm()
+    .then(() => {
+      const macError = window.validateMAC(mac, link);
+      if (macError) {
+        showValidationError(macError, "mac", mac);
+        return;
+      }
+      const prefixError = window.validateIPv6Prefix(prefix);
+      if (prefixError) {
+        showValidationError(prefixError, "ip-start", prefix);
+        return;
+      }
+
+      const result = window.calculateEUI64(
+        mac,
+        prefix,
+        link,
+        duid.hardware,
+        duid.time
+      );
+      if (typeof result === "string") {
+        showError(messages().calculation);
+        return;
+      }
+      if (result.message) {
+        showValidationError(
+          result,
+          duidFields[result.input],
+          duid[result.input]
+        );
+        return;
+      }
+
+      const fragment = template.content.cloneNode(true);
+      fragment.querySelector("#interface-id").value = result.interfaceID;
+      fragment.querySelector("#ip-full").value = result.fullIP;
+      showPrefixType(fragment, result);
+      showDUIDs(fragment, result);
+      showResult(fragment);
+    })
+    .catch((err) => {
+      console.error("Offline calculation failed:", err);
+      showError(messages().offline);
+    });
+}
+
+// Returns the link type selected in a form, or an empty string, meaning
+// Ethernet, if the form has no link type selector.
+function linkType(form) {
+  const select = form && form.elements["link-type"];
+  return select ? select.value : "";
+}
+
+// The WebAssembly functions validating each form field, by field id.
+const offlineValidators = {
+  mac: "validateMAC",
+  "ip-start": "validateIPv6Prefix",
+};
+
+// Validates a form field in the browser, showing the message in the field's
+// inline validation message like the server does.
+function validateOffline(input) {
+  const message = document.querySelector(`[data-field-message="${input.id}"]`);
+  if (!message) {
+    return;
+  }
+
+  const value = input.value;
+  loadWasm()
+    .then(() => {
+      const error = value.trim()
+        ? window[offlineValidators[input.id]](value, linkType(input.form))
+        : "";
+      message.textContent = error ? error.message : "";
+      markInvalidField();
+    })
+    .catch((err) => console.error("Offline validation failed:", err));
+}
+
+// The subnet planner's form fields, by the planSubnets argument they provide.
+const planFields = {
+  mac: "plan-mac",
+  parent: "plan-parent",
+  ids: "plan-ids",
+};
+
+// Renders a subnet plan computed by WebAssembly with the page's plan template,
+// in the page's language, adding a table row per subnet.
+function planFragment(template, plan) {
+  const fragment = template.content.cloneNode(true);
+  fragment.querySelector(".plan-interface-id").textContent = plan.interfaceID;
+
+  const body = fragment.querySelector("tbody");
+  plan.subnets.forEach((subnet) => {
+    const row = document.createElement("tr");
+    const id = document.createElement("td");
+    id.textContent = subnet.id;
+    row.append(id);
+    [subnet.prefix, subnet.address].forEach((value) => {
+      const cell = document.createElement("td");
+      const code = document.createElement("code");
+      code.textContent = value;
+      cell.append(code);
+      row.append(cell);
+    });
+    body.append(row);
+  });
+
+  return fragment;
+}
+
+// Plans subnets in the browser, rendering the plan with the same markup as the
+// server's.
+function planOffline(form) {
+  const template = document.getElementById("offline-plan-result");
+  const values = Object.fromEntries(
+    Object.entries(planFields).map(([arg, id]) => [
+      arg,
+      form.elements[id].value,
+    ])
+  );
+
+  loadWasm()
+    .then(() => {
+      const plan = window.planSubnets(values.mac, values.parent, values.ids);
+      if (typeof plan === "string") {
+        showIn(
+          "#plan-result",
+          errorElement("plan-error", messages().calculation)
+        );
+        return;
+      }
+      if (plan.message) {
+        showIn(
+          "#plan-result",
+          errorElement("plan-error", plan.message, planFields[plan.input]),
+          ...highlightElements(plan, values[plan.input])
+        );
+        return;
+      }
+
+      showIn("#plan-result", planFragment(template, plan));
+    })
+    .catch((err) => {
+      console.error("Offline subnet plan failed:", err);
+      showIn("#plan-result", errorElement("plan-error", messages().offline));
+    });
+}
+
+// The ULA generator's inputs, by the generateULA argument they provide.
+const ulaFields = {
+  mac: "mac",
+  subnet: "ula-subnet",
+};
+
+// Generates a ULA prefix in the browser, rendering it with the same markup as
+// the server's.
+function ulaOffline(form) {
+  const template = document.getElementById("offline-ula-result");
+  const values = {
+    mac: document.getElementById(ulaFields.mac).value,
+    method: form.elements["ula-method"].value,
+    subnet: form.elements[ulaFields.subnet].value,
+  };
+
+  loadWasm()
+    .then(() => {
+      const ula = window.generateULA(values.mac, values.method, values.subnet);
+      if (typeof ula === "string") {
+        showIn(
+          "#ula-result",
+          errorElement("ula-error", messages().calculation)
+        );
+        return;
+      }
+      if (ula.message) {
+        // The MAC address field belongs to the calculator form, so only the
+        // subnet ID field is marked invalid, as the server does.
+        const field = ula.input === "subnet" ? ulaFields.subnet : "";
+        showIn(
+          "#ula-result",
+          errorElement("ula-error", ula.message, field),
+          ...highlightElements(ula, values[ula.input])
+        );
+        return;
+      }
+
+      const fragment = template.content.cloneNode(true);
+      fragment.querySelector(".ula-prefix").textContent = ula.prefix;
+      fragment.querySelector(".ula-subnet").textContent = ula.subnet;
+      fragment.querySelector("[data-use-prefix]").dataset.usePrefix =
+        ula.value;
+      showIn("#ula-result", fragment);
+    })
+    .catch((err) => {
+      console.error("Offline ULA generation failed:", err);
+      showIn("#ula-result", errorElement("ula-error", messages().offline));
+    });
+}
+
+// Returns the description list of facts describing an analyzed address or a
+// decoded DUID, with the same markup as the server's.
+function factsElement(facts) {
+  const list = document.createElement("dl");
+  list.className = "analysis-facts";
+  facts.forEach((fact) => {
+    const label = document.createElement("dt");
+    label.textContent = fact.label;
+    const value = document.createElement("dd");
+    const code = document.createElement("code");
+    code.textContent = fact.value;
+    value.append(code);
+    list.append(label, value);
+  });
+  return list;
+}
+
+// Analyzes an address in the browser, rendering the facts describing it with
+// the same markup as the server's.
+function analyzeOffline(form) {
+  loadWasm()
+    .then(() => {
+      const analysis = window.analyzeAddress(form.elements.address.value);
+      if (typeof analysis === "string") {
+        showIn(
+          "#analysis-result",
+          errorElement("analyzer-error", messages().calculation)
+        );
+        return;
+      }
+      if (analysis.message) {
+        showIn(
+          "#analysis-result",
+          errorElement("analyzer-error", analysis.message, "address")
+        );
+        return;
+      }
+
+      showIn("#analysis-result", factsElement(analysis.facts));
+    })
+    .catch((err) => {
+      console.error("Offline address analysis failed:", err);
+      showIn(
+        "#analysis-result",
+        errorElement("analyzer-error", messages().offline)
+      );
+    });
+}
+
+// Decodes a DUID in the browser, rendering the facts describing it with the
+// same markup as the server's.
+function decodeDUIDOffline(form) {
+  loadWasm()
+    .then(() => {
+      const decoded = window.decodeDUID(form.elements.duid.value);
+      if (typeof decoded === "string") {
+        showIn(
+          "#duid-result",
+          errorElement("duid-error", messages().calculation)
+        );
+        return;
+      }
+      if (decoded.message) {
+        showIn(
+          "#duid-result",
+          errorElement("duid-error", decoded.message, "duid")
+        );
+        return;
+      }
+
+      showIn("#duid-result", factsElement(decoded.facts));
+    })
+    .catch((err) => {
+      console.error("Offline DUID decoding failed:", err);
+      showIn("#duid-result", errorElement("duid-error", messages().offline));
+    });
+}
+
+// The address verifier's inputs, by the verifyAddress argument they provide.
+const verifyFields = {
+  mac: "verify-mac",
+  address: "verify-address",
+  prefix: "verify-prefix",
+};
+
+// Verifies a pair in the browser, rendering the verdict and the facts
+// supporting it with the same markup as the server's.
+function verifyOffline(form) {
+  const values = Object.fromEntries(
+    Object.entries(verifyFields).map(([arg, id]) => [
+      arg,
+      form.elements[id].value,
+    ])
+  );
+
+  loadWasm()
+    .then(() => {
+      const result = window.verifyAddress(
+        values.mac,
+        values.address,
+        values.prefix
+      );
+      if (typeof result === "string") {
+        showIn(
+          "#verify-result",
+          errorElement("verify-error", messages().calculation)
+        );
+        return;
+      }
+      if (result.message) {
+        showIn(
+          "#verify-result",
+          errorElement("verify-error", result.message, verifyFields[result.input]),
+          ...highlightElements(result, values[result.input])
+        );
+        return;
+      }
+
+      const verdict = document.createElement("p");
+      verdict.className = "verify-verdict";
+      verdict.dataset.verifyStatus = result.status;
+      verdict.textContent = result.verdict;
+      const list = document.createElement("dl");
+      list.className = "verify-facts";
+      result.facts.forEach((fact) => {
+        const label = document.createElement("dt");
+        label.textContent = fact.label;
+        const value = document.createElement("dd");
+        const code = document.createElement("code");
+        code.textContent = fact.value;
+        value.append(code);
+        list.append(label, value);
+      });
+      showIn("#verify-result", verdict, list);
+    })
+    .catch((err) => {
+      console.error("Offline address verification failed:", err);
+      showIn("#verify-result", errorElement("verify-error", messages().offline));
+    });
+}
+
+// The importer's form fields, by the importMACs argument they provide.
+const importFields = {
+  file: "import-file",
+  format: "import-format",
+  prefix: "import-prefix",
+};
+
+// Renders the MAC addresses imported by WebAssembly with the page's import
+// template, adding a table row per MAC address and explaining those whose
+// address could not be calculated.
+function importFragment(template, imported) {
+  const fragment = template.content.cloneNode(true);
+  fragment.querySelector("caption").textContent = imported.caption;
+
+  const body = fragment.querySelector("tbody");
+  imported.rows.forEach((entry) => {
+    const row = document.createElement("tr");
+    const line = document.createElement("td");
+    line.textContent = entry.line;
+    const mac = document.createElement("td");
+    const code = document.createElement("code");
+    code.textContent = entry.mac;
+    mac.append(code);
+    const hostname = document.createElement("td");
+    hostname.textContent = entry.hostname;
+    row.append(line, mac, hostname);
+    if (entry.error) {
+      const error = document.createElement("td");
+      error.className = "import-row-error";
+      error.colSpan = 2;
+      error.textContent = entry.error;
+      row.append(error);
+    } else {
+      [entry.interfaceID, entry.fullIP].forEach((value) => {
+        const cell = document.createElement("td");
+        const address = document.createElement("code");
+        address.textContent = value;
+        cell.append(address);
+        row.append(cell);
+      });
+    }
+    body.append(row);
+  });
+  showPrefixType(fragment, imported);
+
+  return fragment;
+}
+
+// Imports the MAC addresses of the chosen file in the browser, rendering their
+// addresses with the same markup as the server's.
+function importOffline(form) {
+  const template = document.getElementById("offline-import-result");
+  const file = form.elements[importFields.file].files[0];
+
+  Promise.all([loadWasm(), file ? file.text() : ""])
+    .then(([, text]) => {
+      const values = {
+        file: text,
+        format: form.elements[importFields.format].value,
+        prefix: form.elements[importFields.prefix].value,
+      };
+      const result = window.importMACs(
+        values.file,
+        values.format,
+        values.prefix
+      );
+      if (typeof result === "string") {
+        showIn(
+          "#import-result",
+          errorElement("import-error", messages().calculation)
+        );
+        return;
+      }
+      if (result.message) {
+        showIn(
+          "#import-result",
+          errorElement("import-error", result.message, importFields[result.input]),
+          ...highlightElements(result, values[result.input])
+        );
+        return;
+      }
+
+      showIn("#import-result", importFragment(template, result));
+    })
+    .catch((err) => {
+      console.error("Offline import failed:", err);
+      showIn("#import-result", errorElement("import-error", messages().offline));
+    });
+}
+
+// The Router Advertisement simulator's form fields, by the simulateRA argument
+// they provide.
+const raFields = {
+  mac: "ra-mac",
+  prefixes: "ra-prefixes",
+};
+
+// Renders the addresses simulated by WebAssembly with the page's simulation
+// template, adding a table row per prefix with the address formed in it, or
+// why none is.
+function raFragment(template, simulation) {
+  const fragment = template.content.cloneNode(true);
+  fragment.querySelector(".ra-interface-id").textContent =
+    simulation.interfaceID;
+
+  const body = fragment.querySelector("tbody");
+  simulation.rows.forEach((entry) => {
+    const row = document.createElement("tr");
+    const prefix = document.createElement("td");
+    const code = document.createElement("code");
+    code.textContent = entry.prefix;
+    prefix.append(code);
+    row.append(prefix);
+    [entry.flags, entry.valid, entry.preferred].forEach((value) => {
+      const cell = document.createElement("td");
+      cell.textContent = value;
+      row.append(cell);
+    });
+    const outcome = document.createElement("td");
+    if (entry.status) {
+      outcome.className = "ra-row-status";
+      outcome.textContent = entry.status;
+    } else {
+      const address = document.createElement("code");
+      address.textContent = entry.address;
+      outcome.append(address);
+      if (entry.deprecated) {
+        const deprecated = document.createElement("span");
+        deprecated.className = "ra-deprecated";
+        deprecated.textContent = entry.deprecated;
+        outcome.append(" ", deprecated);
+      }
+    }
+    row.append(outcome);
+    body.append(row);
+  });
+
+  return fragment;
+}
+
+// Simulates a Router Advertisement in the browser, rendering the addresses
+// formed with the same markup as the server's.
+function raOffline(form) {
+  const template = document.getElementById("offline-ra-result");
+  const values = Object.fromEntries(
+    Object.entries(raFields).map(([arg, id]) => [arg, form.elements[id].value])
+  );
+
+  loadWasm()
+    .then(() => {
+      const result = window.simulateRA(values.mac, values.prefixes);
+      if (typeof result === "string") {
+        showIn("#ra-result", errorElement("ra-error", messages().calculation));
+        return;
+      }
+      if (result.message) {
+        showIn(
+          "#ra-result",
+          errorElement("ra-error", result.message, raFields[result.input]),
+          ...highlightElements(result, values[result.input])
+        );
+        return;
+      }
+
+      showIn("#ra-result", raFragment(template, result));
+    })
+    .catch((err) => {
+      console.error("Offline simulation failed:", err);
+      showIn("#ra-result", errorElement("ra-error", messages().offline));
+    });
+}
+
+// Falls back to calculating, planning, generating, analyzing, decoding,
+// verifying, importing, simulating, and validating in the browser when a
+// request cannot reach the server.
+document.addEventListener("htmx:sendError", (event) => {
+  const elt = event.detail.elt;
+  if (elt.matches("form[data-analyzer-form]")) {
+    analyzeOffline(elt);
+    return;
+  }
+  if (elt.matches("form[data-duid-form]")) {
+    decodeDUIDOffline(elt);
+    return;
+  }
+  if (!document.getElementById("offline-result")) {
+    return;
+  }
+
+  if (elt.matches("form[data-plan-form]")) {
+    planOffline(elt);
+  } else if (elt.matches("form[data-ula-form]")) {
+    ulaOffline(elt);
+  } else if (elt.matches("form[data-verify-form]")) {
+    verifyOffline(elt);
+  } else if (elt.matches("form[data-import-form]")) {
+    importOffline(elt);
+  } else if (elt.matches("form[data-ra-form]")) {
+    raOffline(elt);
+  } else if (elt.matches("form")) {
+    calculateOffline(elt);
+  } else if (elt.id in offlineValidators) {
+    validateOffline(elt);
+  }
+});
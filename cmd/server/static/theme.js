@@ -0,0 +1,64 @@
+// Applies the stored color theme and keeps the theme selector in sync with it.
+// Loaded synchronously in the document head so the chosen theme is in place
+// before the page is first painted. Without a stored choice, the stylesheet
+// follows the system preference.
+(() => {
+  const STORAGE_KEY = "theme";
+  const THEMES = ["light", "dark"];
+  const SYSTEM = "system";
+
+  // Returns the stored theme, or the system setting if none is stored or storage is unavailable.
+  function storedTheme() {
+    try {
+      const theme = localStorage.getItem(STORAGE_KEY);
+      return THEMES.includes(theme) ? theme : SYSTEM;
+    } catch {
+      return SYSTEM;
+    }
+  }
+
+  // Persists a theme choice, clearing it when the system setting is chosen.
+  function storeTheme(theme) {
+    try {
+      if (THEMES.includes(theme)) {
+        localStorage.setItem(STORAGE_KEY, theme);
+      } else {
+        localStorage.removeItem(STORAGE_KEY);
+      }
+    } catch (err) {
+      console.error("Failed to store theme:", err);
+    }
+  }
+
+  // Sets the data-theme attribute the stylesheet keys its colors on.
+  function applyTheme(theme) {
+    if (THEMES.includes(theme)) {
+      document.documentElement.dataset.theme = theme;
+    } else {
+      delete document.documentElement.dataset.theme;
+    }
+  }
+
+  applyTheme(storedTheme());
+
+  document.addEventListener("DOMContentLoaded", () => {
+    const select = document.querySelector("[data-theme-select]");
+    if (!select) {
+      return;
+    }
+
+    select.value = storedTheme();
+    select.addEventListener("change", () => {
+      storeTheme(select.value);
+      applyTheme(select.value);
+    });
+
+    // Follows choices made in other tabs.
+    window.addEventListener("storage", (event) => {
+      if (event.key === STORAGE_KEY) {
+        select.value = storedTheme();
+        applyTheme(select.value);
+      }
+    });
+  });
+})();
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Minimum contrast ratios required by WCAG 2.1 level AA.
+const (
+	// minTextContrast applies to normal-size text (success criterion 1.4.3).
+	minTextContrast = 4.5
+	// minUIContrast applies to icons, borders, and focus indicators (success criterion 1.4.11).
+	minUIContrast = 3.0
+)
+
+// themeSelectors maps theme names to the selectors of the stylesheet rules defining them.
+var themeSelectors = map[string]string{
+	"light":       `:root`,
+	"dark":        `:root[data-theme="dark"]`,
+	"system dark": `:root:not([data-theme="light"])`,
+}
+
+// TestStylesContrast verifies that every theme in the embedded stylesheet meets
+// WCAG AA contrast for each foreground and background pairing the stylesheet uses,
+// that all themes define the same colors, and that the explicit and system dark
+// themes stay identical.
+func TestStylesContrast(t *testing.T) {
+	t.Parallel()
+
+	css, err := staticFS.ReadFile("static/styles.css")
+	require.NoError(t, err)
+
+	pairs := []struct {
+		foreground string
+		background string
+		minimum    float64
+	}{
+		{"--color-text", "--color-background", minTextContrast},
+		{"--color-text", "--color-surface", minTextContrast},
+		{"--color-text-muted", "--color-surface", minTextContrast},
+		{"--color-label", "--color-surface", minTextContrast},
+		{"--color-accent", "--color-surface", minTextContrast},
+		{"--color-error", "--color-surface", minTextContrast},
+		{"--color-field-text", "--color-field-background", minTextContrast},
+		{"--color-field-text", "--color-readonly-background", minTextContrast},
+		{"--color-on-primary", "--color-primary-start", minTextContrast},
+		{"--color-on-primary", "--color-primary-end", minTextContrast},
+		{"--color-on-primary", "--color-primary-hover-start", minTextContrast},
+		{"--color-on-primary", "--color-primary-hover-end", minTextContrast},
+		{"--color-on-primary", "--color-danger-start", minTextContrast},
+		{"--color-on-primary", "--color-danger-end", minTextContrast},
+		{"--color-on-primary", "--color-danger-hover-start", minTextContrast},
+		{"--color-on-primary", "--color-danger-hover-end", minTextContrast},
+		{"--color-tooltip-text", "--color-tooltip-background", minTextContrast},
+		{"--color-field-border", "--color-field-background", minUIContrast},
+		{"--color-field-border", "--color-surface", minUIContrast},
+		{"--color-focus", "--color-surface", minUIContrast},
+		{"--color-icon", "--color-field-background", minUIContrast},
+		{"--color-icon", "--color-readonly-background", minUIContrast},
+		{"--color-icon-hover", "--color-field-background", minUIContrast},
+	}
+
+	themes := map[string]map[string]string{}
+	for name, selector := range themeSelectors {
+		themes[name] = themeVariables(t, string(css), selector)
+	}
+
+	assert.Equal(t, themes["dark"], themes["system dark"], "Dark theme rules should be identical")
+
+	for name, variables := range themes {
+		assert.ElementsMatch(t, keys(themes["light"]), keys(variables), "Theme %q should define the same variables", name)
+
+		for _, pair := range pairs {
+			t.Run(name+" "+pair.foreground+" on "+pair.background, func(t *testing.T) {
+				t.Parallel()
+
+				ratio := contrastRatio(t, variables[pair.foreground], variables[pair.background])
+				assert.GreaterOrEqual(t, ratio, pair.minimum, "%s on %s is %.2f:1",
+					variables[pair.foreground], variables[pair.background], ratio)
+			})
+		}
+	}
+}
+
+// themeVariables returns the custom properties declared by the stylesheet rule
+// with the given selector.
+func themeVariables(t *testing.T, css, selector string) map[string]string {
+	t.Helper()
+
+	rule := regexp.MustCompile(`(?m)^\s*` + regexp.QuoteMeta(selector) + `\s*\{([^}]*)\}`).FindStringSubmatch(css)
+	require.Len(t, rule, 2, "Rule %q not found", selector)
+
+	variables := map[string]string{}
+	for _, declaration := range regexp.MustCompile(`(--[\w-]+)\s*:\s*([^;]+);`).FindAllStringSubmatch(rule[1], -1) {
+		variables[declaration[1]] = strings.TrimSpace(declaration[2])
+	}
+
+	require.NotEmpty(t, variables, "Rule %q declares no variables", selector)
+
+	return variables
+}
+
+// contrastRatio returns the WCAG contrast ratio between two hexadecimal colors.
+func contrastRatio(t *testing.T, foreground, background string) float64 {
+	t.Helper()
+
+	lighter := relativeLuminance(t, foreground)
+	darker := relativeLuminance(t, background)
+
+	if lighter < darker {
+		lighter, darker = darker, lighter
+	}
+
+	return (lighter + 0.05) / (darker + 0.05)
+}
+
+// relativeLuminance returns the WCAG relative luminance of a #rgb or #rrggbb color.
+func relativeLuminance(t *testing.T, color string) float64 {
+	t.Helper()
+
+	hex := strings.TrimPrefix(color, "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+
+	require.Len(t, hex, 6, "Color %q is not a hexadecimal color", color)
+
+	value, err := strconv.ParseUint(hex, 16, 32)
+	require.NoError(t, err, "Color %q is not a hexadecimal color", color)
+
+	channel := func(shift uint) float64 {
+		c := float64((value>>shift)&0xff) / 255
+		if c <= 0.04045 {
+			return c / 12.92
+		}
+
+		return math.Pow((c+0.055)/1.055, 2.4)
+	}
+
+	return 0.2126*channel(16) + 0.7152*channel(8) + 0.0722*channel(0)
+}
+
+// keys returns the keys of a map.
+func keys(m map[string]string) []string {
+	result := make([]string, 0, len(m))
+	for key := range m {
+		result = append(result, key)
+	}
+
+	return result
+}
@@ -0,0 +1,293 @@
+// Package analyzer decodes IPv6 addresses, the reverse of an EUI-64
+// calculation: given any address, it reports its scope and the type of its
+// prefix, how its interface identifier was likely formed, recovering the MAC
+// address of EUI-64 identifiers, and the IPv4 addresses embedded by transition
+// mechanisms such as 6to4, ISATAP, Teredo, IPv4-mapped addresses, and the
+// well-known IPv4/IPv6 translation prefix of RFC 6052. Addresses in the
+// local-use translation prefix of RFC 8215 are reported without an IPv4
+// address, as its position depends on a prefix length chosen by the operator.
+package analyzer
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/classify"
+	"github.com/nicholas-fedor/eui64-calculator/internal/errcode"
+)
+
+// Scope is the scope of an address, see RFC 4007 and RFC 7346.
+type Scope string
+
+// Scopes of addresses. ScopeNone is the scope of the unspecified address, which
+// has none.
+const (
+	ScopeNone              Scope = ""
+	ScopeInterfaceLocal    Scope = "interface_local"
+	ScopeLinkLocal         Scope = "link_local"
+	ScopeRealmLocal        Scope = "realm_local"
+	ScopeAdminLocal        Scope = "admin_local"
+	ScopeSiteLocal         Scope = "site_local"
+	ScopeOrganizationLocal Scope = "organization_local"
+	ScopeGlobal            Scope = "global"
+	ScopeReserved          Scope = "reserved" // ScopeReserved is a multicast scope RFC 7346 leaves unassigned.
+)
+
+// IIDType is how the interface identifier of an address, its low 64 bits, was
+// likely formed.
+type IIDType string
+
+// Types of interface identifiers. IIDNone is the type of addresses without
+// one, such as multicast and IPv4-mapped addresses.
+const (
+	IIDNone         IIDType = ""
+	IIDEUI64        IIDType = "eui64"         // IIDEUI64 is derived from a MAC address, see RFC 4291, appendix A.
+	IIDShortAddress IIDType = "short_address" // IIDShortAddress is derived from an IEEE 802.15.4 short address, see RFC 6282.
+	IIDISATAP       IIDType = "isatap"        // IIDISATAP embeds an IPv4 address, see RFC 5214.
+	IIDTeredo       IIDType = "teredo"        // IIDTeredo encodes a Teredo client's NAT mapping, see RFC 4380.
+	IIDLowByte      IIDType = "low_byte"      // IIDLowByte has only its low bits set, as manually assigned identifiers usually do.
+	IIDRandom       IIDType = "random"        // IIDRandom has no recognizable structure, as privacy and stable opaque identifiers.
+)
+
+// Embedding is a mechanism embedding an IPv4 address in an IPv6 address.
+type Embedding string
+
+// Mechanisms embedding IPv4 addresses.
+const (
+	Embedding6to4   Embedding = "6to4"        // Embedding6to4 embeds it in the prefix, see RFC 3056.
+	EmbeddingISATAP Embedding = "isatap"      // EmbeddingISATAP embeds it in the interface identifier, see RFC 5214.
+	EmbeddingMapped Embedding = "ipv4_mapped" // EmbeddingMapped is an IPv4-mapped address, see RFC 4291.
+	EmbeddingNAT64  Embedding = "nat64"       // EmbeddingNAT64 is a translated address with a /96 prefix, see RFC 6052.
+)
+
+// EmbeddedIPv4 is an IPv4 address embedded in an IPv6 address.
+type EmbeddedIPv4 struct {
+	Embedding Embedding  // Embedding is the mechanism embedding the address.
+	Address   netip.Addr // Address is the IPv4 address.
+}
+
+// Teredo holds the fields of a Teredo address, see RFC 4380, section 4.
+type Teredo struct {
+	Server netip.Addr // Server is the IPv4 address of the Teredo server.
+	Client netip.Addr // Client is the client's public IPv4 address, as mapped by its NAT.
+	Port   uint16     // Port is the client's public UDP port, as mapped by its NAT.
+	Cone   bool       // Cone reports whether the client is behind a cone NAT.
+}
+
+// Analysis describes an IPv6 address.
+type Analysis struct {
+	Address     netip.Addr              // Address is the analyzed address.
+	Scope       Scope                   // Scope is the address's scope.
+	Prefix      classify.Classification // Prefix classifies the address's /64 prefix.
+	InterfaceID string                  // InterfaceID is the interface identifier as four hextets, if the address has one.
+	IIDType     IIDType                 // IIDType is how the interface identifier was likely formed.
+	MAC         string                  // MAC is the MAC address an EUI-64 interface identifier was derived from.
+	IPv4        []EmbeddedIPv4          // IPv4 lists the IPv4 addresses embedded in the address.
+	Teredo      *Teredo                 // Teredo holds the fields of a Teredo address, if it is one.
+	Translation netip.Prefix            // Translation is the local-use translation prefix of RFC 8215 the address is in, if any.
+}
+
+// Static error variables.
+var (
+	ErrAddressRequired = errcode.New("analyzer.required", "IPv6 address is required")
+	ErrInvalidAddress  = errcode.New("analyzer.invalid", "invalid IPv6 address")
+	ErrNotIPv6         = errcode.New("analyzer.not_ipv6", "not an IPv6 address")
+)
+
+// Constants defining the layout of the decoded addresses.
+const (
+	iidOffset       = 8          // iidOffset is the offset of the interface identifier in an address.
+	ipv4Bytes       = 4          // ipv4Bytes is the size of an IPv4 address.
+	sixToFourOffset = 2          // sixToFourOffset is the offset of the IPv4 address in a 6to4 address.
+	isatapOffset    = 12         // isatapOffset is the offset of the IPv4 address in an ISATAP address.
+	isatapUBit      = 0x02       // isatapUBit is the universal/local bit ISATAP identifiers set for global IPv4 addresses.
+	isatapMarker    = 0x5efe     // isatapMarker follows the IANA OUI 00-00-5E in ISATAP identifiers.
+	teredoServer    = 4          // teredoServer is the offset of the server address in a Teredo address.
+	teredoFlags     = 8          // teredoFlags is the offset of the flags in a Teredo address.
+	teredoPort      = 10         // teredoPort is the offset of the obfuscated port in a Teredo address.
+	teredoClient    = 12         // teredoClient is the offset of the obfuscated client address in a Teredo address.
+	teredoConeFlag  = 0x8000     // teredoConeFlag is the flag set for clients behind a cone NAT.
+	obfuscation     = 0xff       // obfuscation is XORed with every byte of the Teredo client's address.
+	portObfuscation = 0xffff     // portObfuscation is XORed with the Teredo client's port.
+	embeddedOffset  = 12         // embeddedOffset is the offset of the IPv4 address in IPv4-mapped and /96 RFC 6052 addresses.
+	eui64MarkerHigh = 0xff       // eui64MarkerHigh is the first byte of the FFFE marker of EUI-64 identifiers.
+	eui64MarkerLow  = 0xfe       // eui64MarkerLow is the second byte of the FFFE marker.
+	eui64Marker     = 3          // eui64Marker is the offset of the FFFE marker in an interface identifier.
+	universalBit    = 0x02       // universalBit is the universal/local bit EUI-64 identifiers invert.
+	lowByteMask     = 0xffffffff // lowByteMask covers the bits a low-byte identifier may set, such as ::1 or ::1:2.
+	scopeMask       = 0x0f       // scopeMask covers the scope field in the second byte of a multicast address.
+
+	// Identifiers of IEEE 802.15.4 short addresses are 0000:00ff:fe00:XXXX.
+	shortAddrMask   = 0xffff       // shortAddrMask covers the short address in the identifier.
+	shortIIDPattern = 0xfffe000000 // shortIIDPattern is the rest of the identifier.
+)
+
+// Address blocks recognized by the analyzer.
+var (
+	siteLocalRange  = netip.MustParsePrefix("fec0::/10")
+	teredoRange     = netip.MustParsePrefix("2001::/32")
+	sixToFourRange  = netip.MustParsePrefix("2002::/16")
+	mappedRange     = netip.MustParsePrefix("::ffff:0:0/96")
+	nat64Range      = netip.MustParsePrefix("64:ff9b::/96")
+	nat64LocalRange = netip.MustParsePrefix("64:ff9b:1::/48")
+)
+
+// multicastScopes maps the scope field of multicast addresses to their scope.
+var multicastScopes = map[byte]Scope{
+	0x1: ScopeInterfaceLocal,
+	0x2: ScopeLinkLocal,
+	0x3: ScopeRealmLocal,
+	0x4: ScopeAdminLocal,
+	0x5: ScopeSiteLocal,
+	0x8: ScopeOrganizationLocal,
+	0xe: ScopeGlobal,
+}
+
+// Analyze decodes an IPv6 address, such as 2001:db8::214:22ff:fe01:2345 or
+// fe80::1%eth0.
+func Analyze(address string) (Analysis, error) {
+	address = strings.TrimSpace(address)
+	if address == "" {
+		return Analysis{}, ErrAddressRequired
+	}
+
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return Analysis{}, fmt.Errorf("%w: %w", ErrInvalidAddress, err)
+	}
+
+	if !addr.Is6() {
+		return Analysis{}, fmt.Errorf("%w: %s", ErrNotIPv6, addr)
+	}
+
+	analysis := Analysis{
+		Address:     addr,
+		Scope:       scope(addr),
+		Prefix:      classify.Address(addr.WithZone("")),
+		InterfaceID: "",
+		IIDType:     IIDNone,
+		MAC:         "",
+		IPv4:        embeddedIPv4(addr),
+		Teredo:      nil,
+		Translation: netip.Prefix{},
+	}
+
+	if nat64LocalRange.Contains(addr.WithZone("")) {
+		analysis.Translation = nat64LocalRange
+	}
+
+	if !hasInterfaceID(addr) {
+		return analysis, nil
+	}
+
+	bytes := addr.As16()
+	iid := bytes[iidOffset:]
+	analysis.InterfaceID = interfaceID(iid)
+
+	switch {
+	case teredoRange.Contains(addr.WithZone("")):
+		analysis.IIDType = IIDTeredo
+		analysis.Teredo = teredo(bytes)
+	case iid[0]&^isatapUBit == 0 && iid[1] == 0 && binary.BigEndian.Uint16(iid[2:]) == isatapMarker:
+		analysis.IIDType = IIDISATAP
+		analysis.IPv4 = append(analysis.IPv4, EmbeddedIPv4{
+			Embedding: EmbeddingISATAP,
+			Address:   netip.AddrFrom4([ipv4Bytes]byte(bytes[isatapOffset:])),
+		})
+	case binary.BigEndian.Uint64(iid)&^shortAddrMask == shortIIDPattern:
+		analysis.IIDType = IIDShortAddress
+	case iid[eui64Marker] == eui64MarkerHigh && iid[eui64Marker+1] == eui64MarkerLow:
+		analysis.IIDType = IIDEUI64
+		analysis.MAC = fmt.Sprintf("%02x-%02x-%02x-%02x-%02x-%02x",
+			iid[0]^universalBit, iid[1], iid[2], iid[5], iid[6], iid[7])
+	case binary.BigEndian.Uint64(iid)&^lowByteMask == 0:
+		analysis.IIDType = IIDLowByte
+	default:
+		analysis.IIDType = IIDRandom
+	}
+
+	return analysis, nil
+}
+
+// scope returns the scope of an address: the scope field of multicast
+// addresses, and that implied by the prefix of unicast addresses, treating the
+// loopback address as link-local as RFC 4007, section 4, requires.
+func scope(addr netip.Addr) Scope {
+	switch {
+	case addr.IsMulticast():
+		if scope, ok := multicastScopes[addr.As16()[1]&scopeMask]; ok {
+			return scope
+		}
+
+		return ScopeReserved
+	case addr.IsUnspecified():
+		return ScopeNone
+	case addr.IsLoopback(), addr.IsLinkLocalUnicast():
+		return ScopeLinkLocal
+	case siteLocalRange.Contains(addr.WithZone("")):
+		return ScopeSiteLocal
+	default:
+		return ScopeGlobal
+	}
+}
+
+// hasInterfaceID reports whether the low 64 bits of an address are an
+// interface identifier, which they are not in multicast addresses, the
+// unspecified and loopback addresses, and addresses embedding IPv4 addresses
+// in their low 32 bits.
+func hasInterfaceID(addr netip.Addr) bool {
+	unzoned := addr.WithZone("")
+
+	return !addr.IsMulticast() && !addr.IsUnspecified() && !addr.IsLoopback() &&
+		!mappedRange.Contains(unzoned) && !nat64Range.Contains(unzoned) && !nat64LocalRange.Contains(unzoned)
+}
+
+// embeddedIPv4 returns the IPv4 addresses embedded in the prefix or the low 32
+// bits of an address; those embedded in its interface identifier are found by
+// Analyze.
+func embeddedIPv4(addr netip.Addr) []EmbeddedIPv4 {
+	bytes := addr.As16()
+	unzoned := addr.WithZone("")
+
+	switch {
+	case sixToFourRange.Contains(unzoned):
+		return []EmbeddedIPv4{{
+			Embedding: Embedding6to4,
+			Address:   netip.AddrFrom4([ipv4Bytes]byte(bytes[sixToFourOffset:])),
+		}}
+	case mappedRange.Contains(unzoned):
+		return []EmbeddedIPv4{{Embedding: EmbeddingMapped, Address: addr.Unmap()}}
+	case nat64Range.Contains(unzoned):
+		return []EmbeddedIPv4{{
+			Embedding: EmbeddingNAT64,
+			Address:   netip.AddrFrom4([ipv4Bytes]byte(bytes[embeddedOffset:])),
+		}}
+	default:
+		return nil
+	}
+}
+
+// teredo decodes the server, flags, and obfuscated client port and address of
+// a Teredo address.
+func teredo(bytes [16]byte) *Teredo {
+	var client [ipv4Bytes]byte
+	for i := range client {
+		client[i] = bytes[teredoClient+i] ^ obfuscation
+	}
+
+	return &Teredo{
+		Server: netip.AddrFrom4([ipv4Bytes]byte(bytes[teredoServer:])),
+		Client: netip.AddrFrom4(client),
+		Port:   binary.BigEndian.Uint16(bytes[teredoPort:]) ^ portObfuscation,
+		Cone:   binary.BigEndian.Uint16(bytes[teredoFlags:])&teredoConeFlag != 0,
+	}
+}
+
+// interfaceID formats an interface identifier as four hextets, as the
+// calculator does.
+func interfaceID(iid []byte) string {
+	return fmt.Sprintf("%02x%02x:%02x%02x:%02x%02x:%02x%02x",
+		iid[0], iid[1], iid[2], iid[3], iid[4], iid[5], iid[6], iid[7])
+}
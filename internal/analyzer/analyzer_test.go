@@ -0,0 +1,267 @@
+package analyzer
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/classify"
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+)
+
+// TestAnalyze tests the Analyze function with addresses of each scope, type of
+// interface identifier, and IPv4 embedding.
+func TestAnalyze(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		address     string
+		wantScope   Scope
+		wantPrefix  classify.Type
+		wantIID     string
+		wantIIDType IIDType
+		wantMAC     string
+		wantIPv4    []EmbeddedIPv4
+	}{
+		{
+			name:        "EUI-64",
+			address:     "2001:db8::214:22ff:fe01:2345",
+			wantScope:   ScopeGlobal,
+			wantPrefix:  classify.TypeDocumentation,
+			wantIID:     "0214:22ff:fe01:2345",
+			wantIIDType: IIDEUI64,
+			wantMAC:     "00-14-22-01-23-45",
+			wantIPv4:    nil,
+		},
+		{
+			name:        "Link-local EUI-64 with zone",
+			address:     " fe80::a00:27ff:fe4e:66a1%eth0 ",
+			wantScope:   ScopeLinkLocal,
+			wantPrefix:  classify.TypeLinkLocal,
+			wantIID:     "0a00:27ff:fe4e:66a1",
+			wantIIDType: IIDEUI64,
+			wantMAC:     "08-00-27-4e-66-a1",
+			wantIPv4:    nil,
+		},
+		{
+			name:        "6LoWPAN short address, not EUI-64 despite FFFE",
+			address:     "fe80::ff:fe00:1a2b",
+			wantScope:   ScopeLinkLocal,
+			wantPrefix:  classify.TypeLinkLocal,
+			wantIID:     "0000:00ff:fe00:1a2b",
+			wantIIDType: IIDShortAddress,
+			wantMAC:     "",
+			wantIPv4:    nil,
+		},
+		{
+			name:        "Random",
+			address:     "fd12:3456:789a:1:a1b2:c3d4:e5f6:789",
+			wantScope:   ScopeGlobal,
+			wantPrefix:  classify.TypeULA,
+			wantIID:     "a1b2:c3d4:e5f6:0789",
+			wantIIDType: IIDRandom,
+			wantMAC:     "",
+			wantIPv4:    nil,
+		},
+		{
+			name:        "Low-byte",
+			address:     "2a01:4f8:1:2::53",
+			wantScope:   ScopeGlobal,
+			wantPrefix:  classify.TypeGUA,
+			wantIID:     "0000:0000:0000:0053",
+			wantIIDType: IIDLowByte,
+			wantMAC:     "",
+			wantIPv4:    nil,
+		},
+		{
+			name:        "ISATAP with private IPv4",
+			address:     "fe80::5efe:c0a8:101",
+			wantScope:   ScopeLinkLocal,
+			wantPrefix:  classify.TypeLinkLocal,
+			wantIID:     "0000:5efe:c0a8:0101",
+			wantIIDType: IIDISATAP,
+			wantMAC:     "",
+			wantIPv4:    []EmbeddedIPv4{{EmbeddingISATAP, netip.MustParseAddr("192.168.1.1")}},
+		},
+		{
+			name:        "ISATAP in 6to4 with global IPv4",
+			address:     "2002:c000:204:1:200:5efe:c000:204",
+			wantScope:   ScopeGlobal,
+			wantPrefix:  classify.Type6to4,
+			wantIID:     "0200:5efe:c000:0204",
+			wantIIDType: IIDISATAP,
+			wantMAC:     "",
+			wantIPv4: []EmbeddedIPv4{
+				{Embedding6to4, netip.MustParseAddr("192.0.2.4")},
+				{EmbeddingISATAP, netip.MustParseAddr("192.0.2.4")},
+			},
+		},
+		{
+			name:        "IPv4-mapped",
+			address:     "::ffff:192.0.2.1",
+			wantScope:   ScopeGlobal,
+			wantPrefix:  classify.TypeReserved,
+			wantIID:     "",
+			wantIIDType: IIDNone,
+			wantMAC:     "",
+			wantIPv4:    []EmbeddedIPv4{{EmbeddingMapped, netip.MustParseAddr("192.0.2.1")}},
+		},
+		{
+			name:        "NAT64 well-known prefix",
+			address:     "64:ff9b::c000:221",
+			wantScope:   ScopeGlobal,
+			wantPrefix:  classify.TypeReserved,
+			wantIID:     "",
+			wantIIDType: IIDNone,
+			wantMAC:     "",
+			wantIPv4:    []EmbeddedIPv4{{EmbeddingNAT64, netip.MustParseAddr("192.0.2.33")}},
+		},
+		{
+			name:        "Multicast",
+			address:     "ff02::1",
+			wantScope:   ScopeLinkLocal,
+			wantPrefix:  classify.TypeMulticast,
+			wantIID:     "",
+			wantIIDType: IIDNone,
+			wantMAC:     "",
+			wantIPv4:    nil,
+		},
+		{
+			name:        "Multicast with reserved scope",
+			address:     "ff0f::1",
+			wantScope:   ScopeReserved,
+			wantPrefix:  classify.TypeMulticast,
+			wantIID:     "",
+			wantIIDType: IIDNone,
+			wantMAC:     "",
+			wantIPv4:    nil,
+		},
+		{
+			name:        "Loopback",
+			address:     "::1",
+			wantScope:   ScopeLinkLocal,
+			wantPrefix:  classify.TypeReserved,
+			wantIID:     "",
+			wantIIDType: IIDNone,
+			wantMAC:     "",
+			wantIPv4:    nil,
+		},
+		{
+			name:        "Unspecified",
+			address:     "::",
+			wantScope:   ScopeNone,
+			wantPrefix:  classify.TypeReserved,
+			wantIID:     "",
+			wantIIDType: IIDNone,
+			wantMAC:     "",
+			wantIPv4:    nil,
+		},
+		{
+			name:        "Site-local",
+			address:     "fec0::1:2",
+			wantScope:   ScopeSiteLocal,
+			wantPrefix:  classify.TypeReserved,
+			wantIID:     "0000:0000:0001:0002",
+			wantIIDType: IIDLowByte,
+			wantMAC:     "",
+			wantIPv4:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Analyze(tt.address)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantScope, got.Scope, "Scope")
+			assert.Equal(t, tt.wantPrefix, got.Prefix.Type, "Prefix type")
+			assert.Equal(t, tt.wantIID, got.InterfaceID, "Interface ID")
+			assert.Equal(t, tt.wantIIDType, got.IIDType, "Interface ID type")
+			assert.Equal(t, tt.wantMAC, got.MAC, "MAC address")
+			assert.Equal(t, tt.wantIPv4, got.IPv4, "Embedded IPv4 addresses")
+			assert.Nil(t, got.Teredo, "Teredo")
+		})
+	}
+}
+
+// TestAnalyzeTeredo tests the Analyze function with the Teredo address of RFC
+// 4380's example, verifying the decoded server, client, port, and NAT type.
+func TestAnalyzeTeredo(t *testing.T) {
+	t.Parallel()
+
+	got, err := Analyze("2001:0:4136:e378:8000:63bf:3fff:fdd2")
+	require.NoError(t, err)
+
+	assert.Equal(t, ScopeGlobal, got.Scope)
+	assert.Equal(t, classify.TypeTeredo, got.Prefix.Type)
+	assert.Equal(t, IIDTeredo, got.IIDType)
+	assert.Equal(t, &Teredo{
+		Server: netip.MustParseAddr("65.54.227.120"),
+		Client: netip.MustParseAddr("192.0.2.45"),
+		Port:   40000,
+		Cone:   true,
+	}, got.Teredo)
+}
+
+// TestAnalyzeNAT64Local tests the Analyze function with an address in the
+// local-use translation prefix of RFC 8215, verifying that the prefix is
+// reported without decoding an IPv4 address from an unknown position.
+func TestAnalyzeNAT64Local(t *testing.T) {
+	t.Parallel()
+
+	got, err := Analyze("64:ff9b:1:c000:2:2100::")
+	require.NoError(t, err)
+
+	assert.Equal(t, netip.MustParsePrefix("64:ff9b:1::/48"), got.Translation)
+	assert.Empty(t, got.IPv4)
+	assert.Equal(t, IIDNone, got.IIDType)
+
+	got, err = Analyze("64:ff9b::c000:221")
+	require.NoError(t, err)
+	assert.False(t, got.Translation.IsValid(), "Well-known prefix")
+}
+
+// TestAnalyzeRoundTrip verifies that the MAC address recovered from a calculated
+// EUI-64 address is the one it was calculated from.
+func TestAnalyzeRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	_, address, err := eui64.CalculateEUI64("3c:22:fb:9a:10:0e", "2001:db8:1:2")
+	require.NoError(t, err)
+
+	got, err := Analyze(address)
+	require.NoError(t, err)
+	assert.Equal(t, IIDEUI64, got.IIDType)
+	assert.Equal(t, "3c-22-fb-9a-10-0e", got.MAC)
+}
+
+// TestAnalyzeInvalid tests the Analyze function with blank, malformed, and IPv4
+// addresses.
+func TestAnalyzeInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		address string
+		wantErr error
+	}{
+		{"Blank", " ", ErrAddressRequired},
+		{"Malformed", "2001:db8::g", ErrInvalidAddress},
+		{"Prefix", "2001:db8::/32", ErrInvalidAddress},
+		{"IPv4", "192.0.2.1", ErrNotIPv6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Analyze(tt.address)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
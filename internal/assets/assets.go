@@ -0,0 +1,380 @@
+// Package assets serves embedded static files with fingerprinted names,
+// precompressed variants, and HTTP caching headers. Fingerprints are generated
+// at build time into the FingerprintsFile embedded with the files, see
+// WriteFingerprints, so fingerprinted names are fixed in the binary. Every file
+// is read, checked against its fingerprint, and compressed once when the
+// Manifest is built.
+package assets
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io/fs"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gofiber/fiber/v3"
+)
+
+// Asset is a single static file held in memory with its precompressed variants.
+type Asset struct {
+	// Name is the logical path of the file within the file system (e.g., "styles.css").
+	Name string
+	// HashedName is Name with a content fingerprint inserted before the extension
+	// (e.g., "styles.3f2a9c1d04.css").
+	HashedName string
+	// ContentType is the MIME type sent with the file.
+	ContentType string
+	// ETag is the strong entity tag of the uncompressed representation.
+	ETag string
+
+	identity []byte
+	gzip     []byte
+	brotli   []byte
+}
+
+// Manifest maps logical and fingerprinted asset names to their contents.
+type Manifest struct {
+	prefix string
+	byName map[string]*Asset
+	byPath map[string]*Asset
+}
+
+// Constants defining fingerprinting, compression, and caching behavior.
+const (
+	// hashLength is the number of hexadecimal characters in a fingerprint.
+	hashLength = 10
+	// etagLength is the number of hexadecimal characters in an entity tag.
+	etagLength = 16
+	// immutableCacheControl is sent for fingerprinted names, whose contents never change.
+	immutableCacheControl = "public, max-age=31536000, immutable"
+	// revalidateCacheControl is sent for logical names, which must be revalidated
+	// with the ETag because their contents change between releases.
+	revalidateCacheControl = "public, max-age=0, must-revalidate"
+	// encodingBrotli is the Content-Encoding token for brotli.
+	encodingBrotli = "br"
+	// encodingGzip is the Content-Encoding token for gzip.
+	encodingGzip = "gzip"
+	// defaultContentType is sent for files with an unknown extension.
+	defaultContentType = "application/octet-stream"
+	// largeAssetSize is the size above which files are compressed with
+	// largeAssetBrotliLevel, since maximum brotli compression of multi-megabyte
+	// files such as WebAssembly modules takes seconds and would delay startup.
+	largeAssetSize = 256 << 10
+	// largeAssetBrotliLevel is the brotli level used for large files.
+	largeAssetBrotliLevel = 6
+)
+
+// compressibleTypes lists media type prefixes worth precompressing. Other types,
+// such as PNG images, are typically compressed already.
+var compressibleTypes = []string{
+	"text/",
+	"application/javascript",
+	"application/json",
+	"application/manifest+json",
+	"application/wasm",
+	"image/svg+xml",
+	"image/x-icon",
+	"image/vnd.microsoft.icon",
+}
+
+// extraContentTypes supplements the platform MIME table for extensions it may lack.
+var extraContentTypes = map[string]string{
+	".ico":         "image/x-icon",
+	".webmanifest": "application/manifest+json",
+}
+
+// ErrDuplicateAsset is returned when two files map to the same served name.
+var ErrDuplicateAsset = errors.New("duplicate asset name")
+
+// New reads every file in fsys and builds a Manifest whose URLs start with prefix
+// (e.g., "/static/"). It returns an error if a file cannot be read or compressed.
+func New(fsys fs.FS, prefix string) (*Manifest, error) {
+	manifest := &Manifest{
+		prefix: strings.TrimSuffix(prefix, "/") + "/",
+		byName: map[string]*Asset{},
+		byPath: map[string]*Asset{},
+	}
+
+	if err := manifest.Add(fsys, ""); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// Add reads every file in fsys into the manifest under the directory dir, so a
+// file "a.js" added with dir "extra" is served as "extra/a.js". An empty dir adds
+// the files at the root. Files are fingerprinted as listed in the
+// FingerprintsFile of fsys or, without one, as WriteFingerprints would list
+// them. It returns an error if a file cannot be read or compressed, if a name
+// is already taken, or, wrapping ErrStaleFingerprint, if a file is not listed
+// with its fingerprint.
+func (m *Manifest) Add(fsys fs.FS, dir string) error {
+	fingerprints, err := readFingerprints(fsys)
+	if err != nil {
+		return fmt.Errorf("building asset manifest: %w", err)
+	}
+
+	err = fs.WalkDir(fsys, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() || name == FingerprintsFile {
+			return nil
+		}
+
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return fmt.Errorf("reading asset %q: %w", name, err)
+		}
+
+		fingerprint := Fingerprint(data)
+		if fingerprints != nil && fingerprints[name] != fingerprint {
+			return fmt.Errorf("%w: %q", ErrStaleFingerprint, name)
+		}
+
+		asset, err := newAsset(path.Join(dir, name), data, fingerprint)
+		if err != nil {
+			return err
+		}
+
+		for _, key := range []string{asset.Name, asset.HashedName} {
+			if _, exists := m.byPath[key]; exists {
+				return fmt.Errorf("%w: %q", ErrDuplicateAsset, key)
+			}
+
+			m.byPath[key] = asset
+		}
+
+		m.byName[asset.Name] = asset
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("building asset manifest: %w", err)
+	}
+
+	return nil
+}
+
+// Handler returns a Fiber handler serving the manifest's files. It must be
+// mounted on a wildcard route below the manifest prefix (e.g., "/static/*").
+// Fingerprinted names are cached indefinitely, logical names are revalidated
+// with their ETag, and brotli or gzip variants are chosen from Accept-Encoding.
+//
+//nolint:wrapcheck // Returning Fiber response directly
+func (m *Manifest) Handler() fiber.Handler {
+	return func(c fiber.Ctx) error {
+		asset, ok := m.byPath[c.Params("*")]
+		if !ok {
+			return fiber.ErrNotFound
+		}
+
+		cacheControl := revalidateCacheControl
+		if c.Params("*") == asset.HashedName {
+			cacheControl = immutableCacheControl
+		}
+
+		body, encoding := asset.negotiate(c.Get(fiber.HeaderAcceptEncoding))
+		etag := asset.ETag
+		if encoding != "" {
+			etag = strings.TrimSuffix(etag, `"`) + "-" + encoding + `"`
+		}
+
+		c.Set(fiber.HeaderCacheControl, cacheControl)
+		c.Set(fiber.HeaderETag, etag)
+		c.Set(fiber.HeaderVary, fiber.HeaderAcceptEncoding)
+
+		if matchesETag(c.Get(fiber.HeaderIfNoneMatch), etag) {
+			return c.SendStatus(http.StatusNotModified)
+		}
+
+		c.Set(fiber.HeaderContentType, asset.ContentType)
+
+		if encoding != "" {
+			c.Set(fiber.HeaderContentEncoding, encoding)
+		}
+
+		return c.Send(body)
+	}
+}
+
+// Path returns the URL of the fingerprinted variant of the named asset, or the
+// URL of the logical name if the asset is unknown.
+func (m *Manifest) Path(name string) string {
+	if asset, ok := m.byName[name]; ok {
+		return m.prefix + asset.HashedName
+	}
+
+	return m.prefix + name
+}
+
+// negotiate returns the smallest representation acceptable to the client, along
+// with its Content-Encoding, which is empty for the uncompressed file.
+func (a *Asset) negotiate(acceptEncoding string) ([]byte, string) {
+	accepted := parseAcceptEncoding(acceptEncoding)
+
+	if a.brotli != nil && accepted[encodingBrotli] {
+		return a.brotli, encodingBrotli
+	}
+
+	if a.gzip != nil && accepted[encodingGzip] {
+		return a.gzip, encodingGzip
+	}
+
+	return a.identity, ""
+}
+
+// newAsset names a file's contents with their fingerprint and, where
+// worthwhile, compresses them. Compressed variants that are not smaller than
+// the original are discarded.
+func newAsset(name string, data []byte, fingerprint string) (*Asset, error) {
+	asset := &Asset{
+		Name:        name,
+		HashedName:  hashedName(name, fingerprint),
+		ContentType: contentType(name),
+		ETag:        `"` + digest(data)[:etagLength] + `"`,
+		identity:    data,
+		gzip:        nil,
+		brotli:      nil,
+	}
+
+	if !isCompressible(asset.ContentType) {
+		return asset, nil
+	}
+
+	var gzipped bytes.Buffer
+
+	gzipWriter, err := gzip.NewWriterLevel(&gzipped, gzip.BestCompression)
+	if err != nil {
+		return nil, fmt.Errorf("compressing asset %q: %w", name, err)
+	}
+
+	if _, err := gzipWriter.Write(data); err != nil {
+		return nil, fmt.Errorf("compressing asset %q: %w", name, err)
+	}
+
+	if err := gzipWriter.Close(); err != nil {
+		return nil, fmt.Errorf("compressing asset %q: %w", name, err)
+	}
+
+	brotliLevel := brotli.BestCompression
+	if len(data) > largeAssetSize {
+		brotliLevel = largeAssetBrotliLevel
+	}
+
+	var brotlied bytes.Buffer
+
+	brotliWriter := brotli.NewWriterLevel(&brotlied, brotliLevel)
+	if _, err := brotliWriter.Write(data); err != nil {
+		return nil, fmt.Errorf("compressing asset %q: %w", name, err)
+	}
+
+	if err := brotliWriter.Close(); err != nil {
+		return nil, fmt.Errorf("compressing asset %q: %w", name, err)
+	}
+
+	if gzipped.Len() < len(data) {
+		asset.gzip = gzipped.Bytes()
+	}
+
+	if brotlied.Len() < len(data) {
+		asset.brotli = brotlied.Bytes()
+	}
+
+	return asset, nil
+}
+
+// contentType returns the MIME type for a file name based on its extension.
+func contentType(name string) string {
+	ext := strings.ToLower(path.Ext(name))
+	if contentType, ok := extraContentTypes[ext]; ok {
+		return contentType
+	}
+
+	if contentType := mime.TypeByExtension(ext); contentType != "" {
+		return contentType
+	}
+
+	return defaultContentType
+}
+
+// hashedName inserts hash before the extension of name, so "app.js" becomes
+// "app.<hash>.js". Names without an extension get the hash appended.
+func hashedName(name, hash string) string {
+	ext := path.Ext(name)
+
+	return strings.TrimSuffix(name, ext) + "." + hash + ext
+}
+
+// isCompressible reports whether files of the given MIME type benefit from compression.
+func isCompressible(contentType string) bool {
+	for _, prefix := range compressibleTypes {
+		if strings.HasPrefix(contentType, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesETag reports whether an If-None-Match header value matches etag,
+// using the weak comparison required by RFC 9110 for conditional GET requests.
+func matchesETag(ifNoneMatch, etag string) bool {
+	if strings.TrimSpace(ifNoneMatch) == "*" {
+		return true
+	}
+
+	for candidate := range strings.SplitSeq(ifNoneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseAcceptEncoding returns the set of content codings the client accepts.
+// Codings with a quality value of zero are excluded, and a wildcard accepts
+// only the codings the header does not list.
+func parseAcceptEncoding(header string) map[string]bool {
+	accepted := map[string]bool{}
+	listed := map[string]bool{}
+
+	for part := range strings.SplitSeq(header, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+
+		if coding == "" {
+			continue
+		}
+
+		listed[coding] = true
+
+		if quality, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if value, err := strconv.ParseFloat(quality, 64); err == nil && value <= 0 {
+				continue
+			}
+		}
+
+		accepted[coding] = true
+	}
+
+	if accepted["*"] {
+		for _, coding := range []string{encodingBrotli, encodingGzip} {
+			if !listed[coding] {
+				accepted[coding] = true
+			}
+		}
+	}
+
+	return accepted
+}
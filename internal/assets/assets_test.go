@@ -0,0 +1,321 @@
+package assets
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCSS is a stylesheet large enough to benefit from compression.
+var testCSS = strings.Repeat("body { color: #333; }\n", 50)
+
+// newTestManifest builds a Manifest over a small in-memory file system.
+func newTestManifest(t *testing.T) *Manifest {
+	t.Helper()
+
+	manifest, err := New(fstest.MapFS{
+		"styles.css":   {Data: []byte(testCSS)},
+		"app.js":       {Data: []byte("console.log(1);")},
+		"img/logo.png": {Data: []byte("\x89PNG not really an image")},
+	}, "/static")
+	require.NoError(t, err)
+
+	return manifest
+}
+
+// TestManifestPath verifies that known assets resolve to fingerprinted URLs that
+// change with their content, and that unknown assets keep their logical name.
+func TestManifestPath(t *testing.T) {
+	t.Parallel()
+
+	manifest := newTestManifest(t)
+
+	stylesPath := manifest.Path("styles.css")
+	assert.Regexp(t, `^/static/styles\.[0-9a-f]{10}\.css$`, stylesPath, "Fingerprinted stylesheet")
+	assert.Regexp(t, `^/static/img/logo\.[0-9a-f]{10}\.png$`, manifest.Path("img/logo.png"), "Nested asset")
+	assert.Equal(t, "/static/missing.css", manifest.Path("missing.css"), "Unknown asset")
+
+	changed, err := New(fstest.MapFS{"styles.css": {Data: []byte(testCSS + "a {}")}}, "/static/")
+	require.NoError(t, err)
+	assert.NotEqual(t, stylesPath, changed.Path("styles.css"), "Fingerprint should follow content")
+
+	same, err := New(fstest.MapFS{"styles.css": {Data: []byte(testCSS)}}, "/static/")
+	require.NoError(t, err)
+	assert.Equal(t, stylesPath, same.Path("styles.css"), "Fingerprint should be deterministic")
+}
+
+// TestManifestHandler tests serving assets by logical and fingerprinted name.
+// It verifies cache headers, content negotiation between brotli, gzip, and the
+// uncompressed file, conditional requests, and unknown paths.
+func TestManifestHandler(t *testing.T) {
+	t.Parallel()
+
+	manifest := newTestManifest(t)
+
+	tests := []struct {
+		name             string
+		path             string
+		acceptEncoding   string
+		ifNoneMatch      func(etag string) string
+		wantStatus       int
+		wantCacheControl string
+		wantEncoding     string
+		wantContentType  string
+		wantBody         string
+	}{
+		{
+			name:             "Fingerprinted name is immutable",
+			path:             manifest.Path("styles.css"),
+			acceptEncoding:   "",
+			ifNoneMatch:      nil,
+			wantStatus:       http.StatusOK,
+			wantCacheControl: immutableCacheControl,
+			wantEncoding:     "",
+			wantContentType:  "text/css",
+			wantBody:         testCSS,
+		},
+		{
+			name:             "Logical name is revalidated",
+			path:             "/static/styles.css",
+			acceptEncoding:   "",
+			ifNoneMatch:      nil,
+			wantStatus:       http.StatusOK,
+			wantCacheControl: revalidateCacheControl,
+			wantEncoding:     "",
+			wantContentType:  "text/css",
+			wantBody:         testCSS,
+		},
+		{
+			name:             "Brotli preferred",
+			path:             manifest.Path("styles.css"),
+			acceptEncoding:   "gzip, deflate, br",
+			ifNoneMatch:      nil,
+			wantStatus:       http.StatusOK,
+			wantCacheControl: immutableCacheControl,
+			wantEncoding:     encodingBrotli,
+			wantContentType:  "text/css",
+			wantBody:         testCSS,
+		},
+		{
+			name:             "Gzip when brotli refused",
+			path:             manifest.Path("styles.css"),
+			acceptEncoding:   "gzip, br;q=0",
+			ifNoneMatch:      nil,
+			wantStatus:       http.StatusOK,
+			wantCacheControl: immutableCacheControl,
+			wantEncoding:     encodingGzip,
+			wantContentType:  "text/css",
+			wantBody:         testCSS,
+		},
+		{
+			name:             "Gzip when brotli refused before a wildcard",
+			path:             manifest.Path("styles.css"),
+			acceptEncoding:   "br;q=0, *",
+			ifNoneMatch:      nil,
+			wantStatus:       http.StatusOK,
+			wantCacheControl: immutableCacheControl,
+			wantEncoding:     encodingGzip,
+			wantContentType:  "text/css",
+			wantBody:         testCSS,
+		},
+		{
+			name:             "Compression skipped when not smaller",
+			path:             "/static/app.js",
+			acceptEncoding:   "br, gzip",
+			ifNoneMatch:      nil,
+			wantStatus:       http.StatusOK,
+			wantCacheControl: revalidateCacheControl,
+			wantEncoding:     "",
+			wantContentType:  "javascript",
+			wantBody:         "console.log(1);",
+		},
+		{
+			name:             "Compression skipped for images",
+			path:             "/static/img/logo.png",
+			acceptEncoding:   "br, gzip",
+			ifNoneMatch:      nil,
+			wantStatus:       http.StatusOK,
+			wantCacheControl: revalidateCacheControl,
+			wantEncoding:     "",
+			wantContentType:  "image/png",
+			wantBody:         "\x89PNG not really an image",
+		},
+		{
+			name:             "Matching ETag returns Not Modified",
+			path:             "/static/styles.css",
+			acceptEncoding:   "br",
+			ifNoneMatch:      func(etag string) string { return `"other", W/` + etag },
+			wantStatus:       http.StatusNotModified,
+			wantCacheControl: revalidateCacheControl,
+			wantEncoding:     "",
+			wantContentType:  "",
+			wantBody:         "",
+		},
+		{
+			name:             "Stale ETag returns file",
+			path:             "/static/styles.css",
+			acceptEncoding:   "",
+			ifNoneMatch:      func(string) string { return `"stale"` },
+			wantStatus:       http.StatusOK,
+			wantCacheControl: revalidateCacheControl,
+			wantEncoding:     "",
+			wantContentType:  "text/css",
+			wantBody:         testCSS,
+		},
+		{
+			name:             "Unknown asset",
+			path:             "/static/missing.css",
+			acceptEncoding:   "",
+			ifNoneMatch:      nil,
+			wantStatus:       http.StatusNotFound,
+			wantCacheControl: "",
+			wantEncoding:     "",
+			wantContentType:  "",
+			wantBody:         "",
+		},
+	}
+
+	app := fiber.New()
+	app.Get("/static/*", manifest.Handler())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://localhost"+tt.path, http.NoBody)
+			require.NoError(t, err)
+			req.Header.Set(fiber.HeaderAcceptEncoding, tt.acceptEncoding)
+
+			if tt.ifNoneMatch != nil {
+				first, err := app.Test(req)
+				require.NoError(t, err)
+				require.NoError(t, first.Body.Close())
+
+				req.Header.Set(fiber.HeaderIfNoneMatch, tt.ifNoneMatch(first.Header.Get(fiber.HeaderETag)))
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.wantStatus, resp.StatusCode, "Status code")
+			assert.Equal(t, tt.wantCacheControl, resp.Header.Get(fiber.HeaderCacheControl), "Cache-Control")
+
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			assert.Equal(t, tt.wantEncoding, resp.Header.Get(fiber.HeaderContentEncoding), "Content-Encoding")
+			assert.Contains(t, resp.Header.Get(fiber.HeaderContentType), tt.wantContentType, "Content-Type")
+			assert.Equal(t, fiber.HeaderAcceptEncoding, resp.Header.Get(fiber.HeaderVary), "Vary")
+			assert.NotEmpty(t, resp.Header.Get(fiber.HeaderETag), "ETag")
+			assert.Equal(t, tt.wantBody, decode(t, resp), "Body")
+		})
+	}
+}
+
+// TestNewDuplicateAsset verifies that a file whose name collides with another
+// file's fingerprinted name is rejected.
+func TestNewDuplicateAsset(t *testing.T) {
+	t.Parallel()
+
+	data := []byte("a {}")
+
+	first, err := New(fstest.MapFS{"a.css": {Data: data}}, "/static/")
+	require.NoError(t, err)
+
+	hashed := strings.TrimPrefix(first.Path("a.css"), "/static/")
+
+	_, err = New(fstest.MapFS{"a.css": {Data: data}, hashed: {Data: data}}, "/static/")
+	require.ErrorIs(t, err, ErrDuplicateAsset)
+}
+
+// TestManifestAdd verifies that files added under a directory are resolved and
+// served below it, and that names already in the manifest are rejected.
+func TestManifestAdd(t *testing.T) {
+	t.Parallel()
+
+	manifest := newTestManifest(t)
+
+	require.NoError(t, manifest.Add(fstest.MapFS{"sw.js": {Data: []byte("self.skipWaiting();")}}, "pwa"))
+	assert.Regexp(t, `^/static/pwa/sw\.[0-9a-f]{10}\.js$`, manifest.Path("pwa/sw.js"), "Added asset")
+
+	err := manifest.Add(fstest.MapFS{"styles.css": {Data: []byte("a {}")}}, "")
+	require.ErrorIs(t, err, ErrDuplicateAsset)
+
+	app := fiber.New()
+	app.Get("/static/*", manifest.Handler())
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://localhost/static/pwa/sw.js", http.NoBody)
+	require.NoError(t, err)
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "Status code")
+	assert.Equal(t, "self.skipWaiting();", decode(t, resp), "Body")
+}
+
+// TestParseAcceptEncoding tests parsing of Accept-Encoding headers, including
+// quality values and the wildcard coding.
+func TestParseAcceptEncoding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		header string
+		want   map[string]bool
+	}{
+		{name: "Empty", header: "", want: map[string]bool{}},
+		{name: "List", header: "gzip, BR", want: map[string]bool{"gzip": true, "br": true}},
+		{name: "Zero quality excluded", header: "gzip;q=0.5, br;q=0", want: map[string]bool{"gzip": true}},
+		{name: "Wildcard", header: "*", want: map[string]bool{"*": true, "gzip": true, "br": true}},
+		{name: "Wildcard after refused coding", header: "br;q=0, *", want: map[string]bool{"*": true, "gzip": true}},
+		{name: "Refused wildcard", header: "*;q=0", want: map[string]bool{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, parseAcceptEncoding(tt.header))
+		})
+	}
+}
+
+// decode reads a response body, undoing any Content-Encoding.
+func decode(t *testing.T, resp *http.Response) string {
+	t.Helper()
+
+	raw, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	var reader io.Reader = bytes.NewReader(raw)
+
+	switch resp.Header.Get(fiber.HeaderContentEncoding) {
+	case encodingBrotli:
+		reader = brotli.NewReader(reader)
+	case encodingGzip:
+		gzipReader, err := gzip.NewReader(reader)
+		require.NoError(t, err)
+
+		reader = gzipReader
+	}
+
+	body, err := io.ReadAll(reader)
+	require.NoError(t, err)
+
+	return string(body)
+}
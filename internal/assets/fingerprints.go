@@ -0,0 +1,112 @@
+package assets
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
+)
+
+// FingerprintsFile is the name of the file, at the root of an asset file
+// system, listing the fingerprints of its other files, as written at build time
+// by WriteFingerprints. It is read by Manifest.Add and not served.
+const FingerprintsFile = "fingerprints.json"
+
+// ErrStaleFingerprint is returned when FingerprintsFile does not list a file or
+// lists a fingerprint other than its contents', because the file changed since
+// the fingerprints were generated.
+var ErrStaleFingerprint = errors.New(`stale asset fingerprint, run "go generate"`)
+
+// Fingerprints maps the names of the files of an asset file system to the
+// fingerprints of their contents.
+type Fingerprints map[string]string
+
+// Fingerprint returns the fingerprint of a file's contents, the prefix of their
+// SHA-256 digest inserted into fingerprinted names.
+func Fingerprint(data []byte) string {
+	return digest(data)[:hashLength]
+}
+
+// WriteFingerprints fingerprints every file in fsys, other than
+// FingerprintsFile and the files go:embed leaves out, whose names start with a
+// dot or underscore, and writes the fingerprints to w as the JSON contents of
+// FingerprintsFile. It is meant to be run by go generate, so fingerprinted
+// names are fixed when the files are embedded.
+func WriteFingerprints(fsys fs.FS, w io.Writer) error {
+	fingerprints := Fingerprints{}
+
+	err := fs.WalkDir(fsys, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if name != "." && isHidden(entry.Name()) {
+			if entry.IsDir() {
+				return fs.SkipDir
+			}
+
+			return nil
+		}
+
+		if entry.IsDir() || name == FingerprintsFile {
+			return nil
+		}
+
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return fmt.Errorf("reading asset %q: %w", name, err)
+		}
+
+		fingerprints[name] = Fingerprint(data)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("fingerprinting assets: %w", err)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(fingerprints); err != nil {
+		return fmt.Errorf("writing asset fingerprints: %w", err)
+	}
+
+	return nil
+}
+
+// readFingerprints reads the FingerprintsFile of fsys, returning nil if there
+// is none.
+func readFingerprints(fsys fs.FS) (Fingerprints, error) {
+	data, err := fs.ReadFile(fsys, FingerprintsFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading asset fingerprints: %w", err)
+	}
+
+	var fingerprints Fingerprints
+	if err := json.Unmarshal(data, &fingerprints); err != nil {
+		return nil, fmt.Errorf("reading asset fingerprints: %w", err)
+	}
+
+	return fingerprints, nil
+}
+
+// isHidden reports whether go:embed leaves out files of the given base name.
+func isHidden(base string) bool {
+	return strings.HasPrefix(base, ".") || strings.HasPrefix(base, "_")
+}
+
+// digest returns the hexadecimal SHA-256 digest of a file's contents.
+func digest(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
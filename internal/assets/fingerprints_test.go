@@ -0,0 +1,71 @@
+package assets
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWriteFingerprints verifies that the fingerprints written at build time
+// list the files go:embed includes, and that the manifest names the files with
+// them.
+func TestWriteFingerprints(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"styles.css":     {Data: []byte(testCSS)},
+		"img/logo.png":   {Data: []byte("\x89PNG not really an image")},
+		".gitignore":     {Data: []byte("/main.wasm\n")},
+		"_drafts/a.css":  {Data: []byte("a {}")},
+		FingerprintsFile: {Data: []byte("{}")},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteFingerprints(fsys, &buf))
+
+	var fingerprints Fingerprints
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &fingerprints))
+	assert.Equal(t, Fingerprints{
+		"styles.css":   Fingerprint([]byte(testCSS)),
+		"img/logo.png": Fingerprint([]byte("\x89PNG not really an image")),
+	}, fingerprints)
+
+	manifest, err := New(fstest.MapFS{
+		"styles.css":     fsys["styles.css"],
+		"img/logo.png":   fsys["img/logo.png"],
+		FingerprintsFile: {Data: buf.Bytes()},
+	}, "/static/")
+	require.NoError(t, err)
+	assert.Equal(t, "/static/styles."+fingerprints["styles.css"]+".css", manifest.Path("styles.css"))
+	assert.Equal(t, "/static/"+FingerprintsFile, manifest.Path(FingerprintsFile), "Fingerprints should not be served")
+}
+
+// TestNewStaleFingerprint verifies that files changed or added since their
+// fingerprints were generated are rejected.
+func TestNewStaleFingerprint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		fingerprints string
+	}{
+		{name: "Changed file", fingerprints: `{"styles.css": "0000000000"}`},
+		{name: "Added file", fingerprints: `{}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := New(fstest.MapFS{
+				"styles.css":     {Data: []byte(testCSS)},
+				FingerprintsFile: {Data: []byte(tt.fingerprints)},
+			}, "/static/")
+			require.ErrorIs(t, err, ErrStaleFingerprint)
+		})
+	}
+}
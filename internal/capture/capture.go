@@ -0,0 +1,352 @@
+// Package capture analyzes packet captures offline, mapping the hosts on a link
+// to their IPv6 addresses from the Neighbor Discovery (NDP) and DHCPv6 messages
+// they exchange. It reads pcap and pcapng files of Ethernet, Linux cooked and
+// raw IPv6 captures, such as those written by tcpdump, dumpcap and Wireshark,
+// and reports the prefixes routers advertise, the addresses seen for each MAC
+// address, verified against its EUI-64 addresses, and which hosts configured
+// EUI-64 addresses in each advertised prefix. Addresses seen without a MAC
+// address are mapped to one by the reverse calculation where their interface
+// identifiers are EUI-64 identifiers.
+package capture
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"slices"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/analyzer"
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+	"github.com/nicholas-fedor/eui64-calculator/internal/verify"
+)
+
+// Source is the protocol an address was observed in.
+type Source string
+
+// Protocols addresses are observed in.
+const (
+	SourceNDP    Source = "ndp"    // SourceNDP is a Neighbor Discovery message, see RFC 4861.
+	SourceDHCPv6 Source = "dhcpv6" // SourceDHCPv6 is a DHCPv6 message binding the address to a client, see RFC 8415.
+)
+
+// InfiniteLifetime is the lifetime of prefixes advertised without expiry.
+const InfiniteLifetime = 0xffffffff
+
+// Static error variables.
+var (
+	ErrUnknownFormat = errors.New("not a pcap or pcapng file")
+	ErrInvalidFile   = errors.New("the capture file is corrupt")
+)
+
+// Report is what a capture reveals about the hosts on its links.
+type Report struct {
+	Packets   int       // Packets is the number of packets read.
+	Messages  int       // Messages is the number of NDP and DHCPv6 messages decoded.
+	Truncated bool      // Truncated reports whether the capture ends in a partial packet, as when the capture was interrupted.
+	Prefixes  []Prefix  // Prefixes are the prefixes advertised by routers, in the order first seen.
+	Hosts     []Host    // Hosts are the hosts seen, by MAC address, in the order first seen.
+	Unmapped  []Address // Unmapped lists the addresses seen without a MAC address whose interface identifiers do not reveal one.
+}
+
+// Prefix is a prefix advertised in the Prefix Information options of Router
+// Advertisements, with the flags and lifetimes last advertised.
+type Prefix struct {
+	Prefix            netip.Prefix
+	Routers           []netip.Addr // Routers are the addresses of the routers advertising the prefix.
+	OnLink            bool         // OnLink is the L flag: the prefix is on the link.
+	Autonomous        bool         // Autonomous is the A flag: hosts may form addresses in the prefix with SLAAC.
+	ValidLifetime     uint32       // ValidLifetime is in seconds, or InfiniteLifetime.
+	PreferredLifetime uint32       // PreferredLifetime is in seconds, or InfiniteLifetime.
+	SLAAC             bool         // SLAAC reports whether hosts form EUI-64 addresses in the prefix, see eui64.PrefixInformation.Status.
+	EUI64Hosts        []string     // EUI64Hosts are the MAC addresses of the hosts seen with their EUI-64 address in the prefix.
+}
+
+// Host is a MAC address and the addresses seen for it.
+type Host struct {
+	MAC       string
+	Addresses []Address
+}
+
+// Address is an address seen in a capture, verified against the MAC address
+// of its host.
+type Address struct {
+	Address netip.Addr
+	Sources []Source         // Sources are the protocols the address was seen in.
+	Derived bool             // Derived reports whether the MAC address was recovered from the address's EUI-64 interface identifier rather than seen with it.
+	Status  verify.Status    // Status is the outcome of verifying the address against its host's MAC address.
+	IIDType analyzer.IIDType // IIDType is how the address's interface identifier was likely formed.
+}
+
+// collector accumulates the messages of a capture, keeping everything in the
+// order first seen.
+type collector struct {
+	prefixes  []Prefix
+	prefixAt  map[netip.Prefix]int
+	hosts     []Host
+	hostAt    map[string]int
+	addressAt map[string]map[netip.Addr]int // addressAt indexes the addresses of each host.
+	unbound   []Address                     // unbound lists the addresses seen without a MAC address.
+	unboundAt map[netip.Addr]int
+}
+
+// Analyze reads a pcap or pcapng capture and reports the prefixes advertised in
+// it and the hosts seen, verifying their addresses with calc. A capture ending
+// in a partial packet is analyzed up to it and reported as truncated.
+func Analyze(calc eui64.Calculator, r io.Reader) (Report, error) {
+	packets, err := newReader(r)
+	if err != nil {
+		return Report{}, err
+	}
+
+	report := Report{Packets: 0, Messages: 0, Truncated: false, Prefixes: nil, Hosts: nil, Unmapped: nil}
+	collected := newCollector()
+
+	for {
+		packet, err := packets.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			report.Truncated = true
+
+			break
+		}
+
+		if err != nil {
+			return Report{}, err
+		}
+
+		report.Packets++
+
+		msg, ok := decode(packet)
+		if !ok {
+			continue
+		}
+
+		report.Messages++
+
+		collected.add(msg)
+	}
+
+	collected.resolve()
+	collected.verify(calc)
+
+	report.Prefixes = collected.prefixes
+	report.Hosts = collected.hosts
+	report.Unmapped = collected.unbound
+
+	return report, nil
+}
+
+// newCollector returns an empty collector.
+func newCollector() *collector {
+	return &collector{
+		prefixes:  nil,
+		prefixAt:  make(map[netip.Prefix]int),
+		hosts:     nil,
+		hostAt:    make(map[string]int),
+		addressAt: make(map[string]map[netip.Addr]int),
+		unbound:   nil,
+		unboundAt: make(map[netip.Addr]int),
+	}
+}
+
+// add records the bindings and advertised prefixes of a message.
+func (c *collector) add(msg message) {
+	for _, ad := range msg.prefixes {
+		c.advertise(ad)
+	}
+
+	for _, bound := range msg.bindings {
+		if bound.mac == "" {
+			c.addUnbound(bound.address, msg.source)
+
+			continue
+		}
+
+		c.bind(bound.mac, bound.address, msg.source, false)
+	}
+}
+
+// advertise records a prefix advertised by a router, keeping the flags and
+// lifetimes last advertised.
+func (c *collector) advertise(ad advertisement) {
+	at, seen := c.prefixAt[ad.prefix]
+	if !seen {
+		at = len(c.prefixes)
+		c.prefixAt[ad.prefix] = at
+		c.prefixes = append(c.prefixes, Prefix{
+			Prefix:            ad.prefix,
+			Routers:           nil,
+			OnLink:            false,
+			Autonomous:        false,
+			ValidLifetime:     0,
+			PreferredLifetime: 0,
+			SLAAC:             false,
+			EUI64Hosts:        nil,
+		})
+	}
+
+	prefix := &c.prefixes[at]
+	prefix.OnLink = ad.onLink
+	prefix.Autonomous = ad.autonomous
+	prefix.ValidLifetime = ad.validLifetime
+	prefix.PreferredLifetime = ad.preferredLifetime
+	prefix.SLAAC = eui64.PrefixInformation{
+		Prefix:            ad.prefix,
+		OnLink:            ad.onLink,
+		Autonomous:        ad.autonomous,
+		ValidLifetime:     ad.validLifetime,
+		PreferredLifetime: ad.preferredLifetime,
+	}.Status() == eui64.SLAACFormed
+
+	if ad.router.IsValid() && !slices.Contains(prefix.Routers, ad.router) {
+		prefix.Routers = append(prefix.Routers, ad.router)
+	}
+}
+
+// bind records an address seen for a MAC address.
+func (c *collector) bind(mac string, addr netip.Addr, source Source, derived bool) {
+	at, seen := c.hostAt[mac]
+	if !seen {
+		at = len(c.hosts)
+		c.hostAt[mac] = at
+		c.hosts = append(c.hosts, Host{MAC: mac, Addresses: nil})
+		c.addressAt[mac] = make(map[netip.Addr]int)
+	}
+
+	host := &c.hosts[at]
+
+	addrAt, seen := c.addressAt[mac][addr]
+	if !seen {
+		c.addressAt[mac][addr] = len(host.Addresses)
+		host.Addresses = append(host.Addresses, newAddress(addr, source, derived))
+
+		return
+	}
+
+	address := &host.Addresses[addrAt]
+	if !slices.Contains(address.Sources, source) {
+		address.Sources = append(address.Sources, source)
+	}
+
+	address.Derived = address.Derived && derived
+}
+
+// addUnbound records an address seen without a MAC address.
+func (c *collector) addUnbound(addr netip.Addr, source Source) {
+	at, seen := c.unboundAt[addr]
+	if !seen {
+		c.unboundAt[addr] = len(c.unbound)
+		c.unbound = append(c.unbound, newAddress(addr, source, false))
+
+		return
+	}
+
+	if !slices.Contains(c.unbound[at].Sources, source) {
+		c.unbound[at].Sources = append(c.unbound[at].Sources, source)
+	}
+}
+
+// resolve maps the addresses seen without a MAC address: those also seen with
+// one are dropped, and those with EUI-64 interface identifiers are bound to
+// the MAC address recovered from them. The rest remain unbound.
+func (c *collector) resolve() {
+	unmapped := make([]Address, 0, len(c.unbound))
+
+	for _, address := range c.unbound {
+		if c.isBound(address.Address) {
+			continue
+		}
+
+		analysis, err := analyzer.Analyze(address.Address.String())
+		if err != nil || analysis.IIDType != analyzer.IIDEUI64 {
+			unmapped = append(unmapped, address)
+
+			continue
+		}
+
+		for _, source := range address.Sources {
+			c.bind(analysis.MAC, address.Address, source, true)
+		}
+	}
+
+	c.unbound = unmapped
+}
+
+// isBound reports whether an address was seen with a MAC address.
+func (c *collector) isBound(addr netip.Addr) bool {
+	for _, addresses := range c.addressAt {
+		if _, seen := addresses[addr]; seen {
+			return true
+		}
+	}
+
+	return false
+}
+
+// verify checks every address against the EUI-64 addresses of its host, the
+// forward calculation, and lists the hosts seen with their EUI-64 address in
+// each advertised prefix.
+func (c *collector) verify(calc eui64.Calculator) {
+	for i := range c.hosts {
+		host := &c.hosts[i]
+
+		for j := range host.Addresses {
+			address := &host.Addresses[j]
+
+			result := verify.Verify(calc, verify.Pair{MAC: host.MAC, Address: address.Address.String(), Prefix: ""})
+			if result.Err != nil {
+				continue
+			}
+
+			address.Status = result.Status
+			address.IIDType = result.IIDType
+
+			if result.Status == verify.StatusMatch {
+				c.addEUI64Host(host.MAC, address.Address)
+			}
+		}
+	}
+
+	for i := range c.unbound {
+		analysis, err := analyzer.Analyze(c.unbound[i].Address.String())
+		if err == nil {
+			c.unbound[i].IIDType = analysis.IIDType
+		}
+	}
+}
+
+// addEUI64Host lists a host in the advertised prefixes containing its EUI-64
+// address.
+func (c *collector) addEUI64Host(mac string, addr netip.Addr) {
+	for i := range c.prefixes {
+		prefix := &c.prefixes[i]
+		if prefix.Prefix.Contains(addr) && !slices.Contains(prefix.EUI64Hosts, mac) {
+			prefix.EUI64Hosts = append(prefix.EUI64Hosts, mac)
+		}
+	}
+}
+
+// newAddress returns an address seen in a protocol, yet to be verified.
+func newAddress(addr netip.Addr, source Source, derived bool) Address {
+	return Address{
+		Address: addr,
+		Sources: []Source{source},
+		Derived: derived,
+		Status:  "",
+		IIDType: analyzer.IIDNone,
+	}
+}
+
+// unexpected reports the end of a capture within a packet or block as
+// io.ErrUnexpectedEOF, and wraps other read errors.
+func unexpected(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return io.ErrUnexpectedEOF
+	}
+
+	return fmt.Errorf("reading capture: %w", err)
+}
@@ -0,0 +1,560 @@
+package capture
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/analyzer"
+	"github.com/nicholas-fedor/eui64-calculator/internal/duid"
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+	"github.com/nicholas-fedor/eui64-calculator/internal/verify"
+)
+
+// MAC addresses of the hosts on the test link.
+var (
+	routerMAC  = []byte{0x00, 0x00, 0x5e, 0x00, 0x53, 0x01}
+	hostMAC    = []byte{0x00, 0x14, 0x22, 0x01, 0x23, 0x45}
+	privacyMAC = []byte{0x00, 0x14, 0x22, 0x01, 0x23, 0x46}
+	dhcpMAC    = []byte{0x00, 0x14, 0x22, 0x01, 0x23, 0x47}
+	relayedMAC = []byte{0x00, 0x14, 0x22, 0x01, 0x23, 0x48}
+)
+
+// Addresses of the hosts on the test link.
+const (
+	routerLinkLocal = "fe80::200:5eff:fe00:5301"
+	hostLinkLocal   = "fe80::214:22ff:fe01:2345"
+	hostGlobal      = "2001:db8:0:1:214:22ff:fe01:2345"
+	privacyGlobal   = "2001:db8:0:1:8d3f:61c2:a94e:17b0"
+	dhcpGlobal      = "2001:db8:0:1::1:47"
+	rawGlobal       = "2001:db8:0:1:214:22ff:fe01:2399"
+	lowByteGlobal   = "2001:db8:0:1::53"
+	allNodes        = "ff02::1"
+)
+
+// Lifetimes advertised by the test router.
+const (
+	validLifetime     = 2592000
+	preferredLifetime = 604800
+)
+
+// routerAdvertisement returns the Ethernet frame of the test router's Router
+// Advertisement of 2001:db8:0:1::/64 and 2001:db8:1::/48.
+func routerAdvertisement() []byte {
+	return ethernet(routerMAC, etherTypeIPv6, ipv6(routerLinkLocal, allNodes, ndpHopLimit, nextICMPv6,
+		icmpv6(typeRouterAdvertisement, make([]byte, raOptions-4),
+			linkLayerOption(optionSourceLinkLayer, routerMAC),
+			prefixOption("2001:db8:0:1::", 64, flagOnLink|flagAutonomous),
+			prefixOption("2001:db8:1::", 48, flagAutonomous),
+		)))
+}
+
+// ethernet returns an Ethernet frame sent by src to the all-nodes group.
+func ethernet(src []byte, etherType uint16, payload []byte) []byte {
+	frame := []byte{0x33, 0x33, 0x00, 0x00, 0x00, 0x01}
+	frame = append(frame, src...)
+	frame = binary.BigEndian.AppendUint16(frame, etherType)
+
+	return append(frame, payload...)
+}
+
+// ipv6 returns an IPv6 packet.
+func ipv6(src, dst string, hopLimit, next byte, payload []byte) []byte {
+	packet := []byte{ipv6Version << versionShift, 0, 0, 0}
+	packet = binary.BigEndian.AppendUint16(packet, uint16(len(payload)))
+	packet = append(packet, next, hopLimit)
+	packet = append(packet, netip.MustParseAddr(src).AsSlice()...)
+	packet = append(packet, netip.MustParseAddr(dst).AsSlice()...)
+
+	return append(packet, payload...)
+}
+
+// icmpv6 returns an ICMPv6 message with code 0, leaving the checksum unset.
+func icmpv6(icmpType byte, body []byte, options ...[]byte) []byte {
+	message := append([]byte{icmpType, 0, 0, 0}, body...)
+
+	return append(message, bytes.Join(options, nil)...)
+}
+
+// neighbor returns the body of a Neighbor Solicitation or Advertisement.
+func neighbor(target string) []byte {
+	return append(make([]byte, nsTarget-4), netip.MustParseAddr(target).AsSlice()...)
+}
+
+// linkLayerOption returns a Source or Target Link-Layer Address option.
+func linkLayerOption(optionType byte, mac []byte) []byte {
+	return append([]byte{optionType, 1}, mac...)
+}
+
+// prefixOption returns a Prefix Information option with the test lifetimes.
+func prefixOption(prefix string, bits int, flags byte) []byte {
+	option := []byte{optionPrefixInformation, 4, byte(bits), flags}
+	option = binary.BigEndian.AppendUint32(option, validLifetime)
+	option = binary.BigEndian.AppendUint32(option, preferredLifetime)
+	option = append(option, 0, 0, 0, 0)
+
+	return append(option, netip.MustParseAddr(prefix).AsSlice()...)
+}
+
+// udp returns a UDP datagram, leaving the checksum unset.
+func udp(src, dst uint16, payload []byte) []byte {
+	datagram := binary.BigEndian.AppendUint16(nil, src)
+	datagram = binary.BigEndian.AppendUint16(datagram, dst)
+	datagram = binary.BigEndian.AppendUint16(datagram, uint16(udpHeader+len(payload)))
+	datagram = append(datagram, 0, 0)
+
+	return append(datagram, payload...)
+}
+
+// dhcpv6Option returns a DHCPv6 option.
+func dhcpv6Option(code uint16, body ...[]byte) []byte {
+	value := bytes.Join(body, nil)
+	option := binary.BigEndian.AppendUint16(nil, code)
+	option = binary.BigEndian.AppendUint16(option, uint16(len(value)))
+
+	return append(option, value...)
+}
+
+// duidLL returns a DUID-LL of an Ethernet MAC address.
+func duidLL(mac []byte) []byte {
+	return append([]byte{0, byte(duid.TypeLL), 0, hardwareEthernet}, mac...)
+}
+
+// iaNA returns an IA_NA option assigning an address.
+func iaNA(address string) []byte {
+	return dhcpv6Option(optionIANA, make([]byte, ianaOptions),
+		dhcpv6Option(optionIAAddress, netip.MustParseAddr(address).AsSlice(), make([]byte, 8)))
+}
+
+// relay wraps a DHCPv6 message in a relay message with the given options.
+func relay(msgType byte, inner []byte, options ...[]byte) []byte {
+	message := append([]byte{msgType, 0}, make([]byte, 2*ipv6Bytes)...)
+	message = append(message, bytes.Join(options, nil)...)
+
+	return append(message, dhcpv6Option(optionRelayMessage, inner)...)
+}
+
+// byteOrder is the byte order of a capture file.
+type byteOrder interface {
+	binary.ByteOrder
+	binary.AppendByteOrder
+}
+
+// pcap returns a pcap file of packets with the given byte order and link type.
+func pcap(order byteOrder, magic uint32, link linkType, packets ...[]byte) []byte {
+	file := order.AppendUint32(nil, magic)
+	file = order.AppendUint16(file, 2)
+	file = order.AppendUint16(file, 4)
+	file = append(file, make([]byte, 8)...)
+	file = order.AppendUint32(file, 1<<18)
+	file = order.AppendUint32(file, uint32(link))
+
+	for _, packet := range packets {
+		file = append(file, make([]byte, 8)...)
+		file = order.AppendUint32(file, uint32(len(packet)))
+		file = order.AppendUint32(file, uint32(len(packet)))
+		file = append(file, packet...)
+	}
+
+	return file
+}
+
+// capturedPacket is a packet captured on an interface of a pcapng file.
+type capturedPacket struct {
+	iface uint32
+	data  []byte
+}
+
+// pcapng returns a pcapng file of a section with the given byte order,
+// interfaces and packets.
+func pcapng(order byteOrder, interfaces []linkType, packets ...capturedPacket) []byte {
+	version := order.AppendUint16(nil, 1)
+	version = order.AppendUint16(version, 0)
+	file := pcapngBlock(order, blockSection, order.AppendUint32(nil, byteOrderMagic),
+		version, bytes.Repeat([]byte{0xff}, 8))
+
+	for _, link := range interfaces {
+		file = append(file, pcapngBlock(order, blockInterface, order.AppendUint16(nil, uint16(link)),
+			[]byte{0, 0}, order.AppendUint32(nil, 1<<18))...)
+	}
+
+	for _, packet := range packets {
+		fields := order.AppendUint32(nil, packet.iface)
+		fields = append(fields, make([]byte, 8)...)
+		fields = order.AppendUint32(fields, uint32(len(packet.data)))
+		fields = order.AppendUint32(fields, uint32(len(packet.data)))
+		padding := make([]byte, (blockAlignment-len(packet.data)%blockAlignment)%blockAlignment)
+		file = append(file, pcapngBlock(order, blockEnhanced, fields, packet.data, padding)...)
+	}
+
+	return file
+}
+
+// pcapngBlock returns a pcapng block of the given type and body.
+func pcapngBlock(order byteOrder, blockType uint32, body ...[]byte) []byte {
+	content := bytes.Join(body, nil)
+	length := uint32(blockHeader + len(content) + blockTrailer)
+
+	block := order.AppendUint32(nil, blockType)
+	block = order.AppendUint32(block, length)
+	block = append(block, content...)
+
+	return order.AppendUint32(block, length)
+}
+
+// TestAnalyze tests that Analyze maps the hosts of a capture to the addresses
+// seen in NDP and DHCPv6 messages, recovering MAC addresses from EUI-64
+// interface identifiers where the link layer has none, and lists the hosts
+// that configured EUI-64 addresses in each advertised prefix.
+func TestAnalyze(t *testing.T) {
+	t.Parallel()
+
+	reply := append([]byte{dhcpReply, 1, 2, 3}, dhcpv6Option(optionClientID, duidLL(dhcpMAC))...)
+	reply = append(reply, iaNA(dhcpGlobal)...)
+
+	file := pcapng(binary.LittleEndian, []linkType{linkEthernet, linkIPv6},
+		capturedPacket{0, routerAdvertisement()},
+		// Duplicate address detection of the host's global address.
+		capturedPacket{0, ethernet(hostMAC, etherTypeIPv6, ipv6("::", "ff02::1:ff01:2345", ndpHopLimit, nextICMPv6,
+			icmpv6(typeNeighborSolicitation, neighbor(hostGlobal))))},
+		capturedPacket{0, ethernet(hostMAC, etherTypeIPv6, ipv6(hostLinkLocal, routerLinkLocal, ndpHopLimit, nextICMPv6,
+			icmpv6(typeNeighborAdvertisement, neighbor(hostGlobal), linkLayerOption(optionTargetLinkLayer, hostMAC))))},
+		capturedPacket{0, ethernet(privacyMAC, etherTypeIPv6, ipv6(privacyGlobal, "ff02::1:ff00:1", ndpHopLimit, nextICMPv6,
+			icmpv6(typeNeighborSolicitation, neighbor("2001:db8:0:1::1"), linkLayerOption(optionSourceLinkLayer, privacyMAC))))},
+		// A forwarded Neighbor Solicitation, which NDP discards.
+		capturedPacket{0, ethernet(relayedMAC, etherTypeIPv6, ipv6("2001:db8:9::1", "ff02::1:ff00:1", 64, nextICMPv6,
+			icmpv6(typeNeighborSolicitation, neighbor("2001:db8:0:1::1"))))},
+		capturedPacket{0, ethernet(routerMAC, etherTypeIPv6, ipv6("2001:db8::547", "2001:db8::1", 64, nextUDP,
+			udp(portServer, portServer, relay(dhcpRelayReply, reply))))},
+		capturedPacket{1, ipv6(rawGlobal, "ff02::1:ff00:1", ndpHopLimit, nextICMPv6,
+			icmpv6(typeNeighborSolicitation, neighbor("2001:db8:0:1::1")))},
+		capturedPacket{1, ipv6(lowByteGlobal, "ff02::1:ff00:1", ndpHopLimit, nextICMPv6,
+			icmpv6(typeNeighborSolicitation, neighbor("2001:db8:0:1::1")))},
+		capturedPacket{0, ethernet(hostMAC, 0x0806, make([]byte, 28))},
+	)
+
+	got, err := Analyze(&eui64.DefaultCalculator{}, bytes.NewReader(file))
+	require.NoError(t, err)
+
+	routers := []netip.Addr{netip.MustParseAddr(routerLinkLocal)}
+	assert.Equal(t, Report{
+		Packets:   9,
+		Messages:  7,
+		Truncated: false,
+		Prefixes: []Prefix{
+			{
+				Prefix:            netip.MustParsePrefix("2001:db8:0:1::/64"),
+				Routers:           routers,
+				OnLink:            true,
+				Autonomous:        true,
+				ValidLifetime:     validLifetime,
+				PreferredLifetime: preferredLifetime,
+				SLAAC:             true,
+				EUI64Hosts:        []string{"00-14-22-01-23-45", "00-14-22-01-23-99"},
+			},
+			{
+				Prefix:            netip.MustParsePrefix("2001:db8:1::/48"),
+				Routers:           routers,
+				OnLink:            false,
+				Autonomous:        true,
+				ValidLifetime:     validLifetime,
+				PreferredLifetime: preferredLifetime,
+				SLAAC:             false,
+				EUI64Hosts:        nil,
+			},
+		},
+		Hosts: []Host{
+			{MAC: "00-00-5e-00-53-01", Addresses: []Address{
+				{netip.MustParseAddr(routerLinkLocal), []Source{SourceNDP}, false, verify.StatusMatch, analyzer.IIDEUI64},
+			}},
+			{MAC: "00-14-22-01-23-45", Addresses: []Address{
+				{netip.MustParseAddr(hostGlobal), []Source{SourceNDP}, false, verify.StatusMatch, analyzer.IIDEUI64},
+				{netip.MustParseAddr(hostLinkLocal), []Source{SourceNDP}, false, verify.StatusMatch, analyzer.IIDEUI64},
+			}},
+			{MAC: "00-14-22-01-23-46", Addresses: []Address{
+				{netip.MustParseAddr(privacyGlobal), []Source{SourceNDP}, false, verify.StatusNotEUI64, analyzer.IIDRandom},
+			}},
+			{MAC: "00-14-22-01-23-47", Addresses: []Address{
+				{netip.MustParseAddr(dhcpGlobal), []Source{SourceDHCPv6}, false, verify.StatusNotEUI64, analyzer.IIDLowByte},
+			}},
+			{MAC: "00-14-22-01-23-99", Addresses: []Address{
+				{netip.MustParseAddr(rawGlobal), []Source{SourceNDP}, true, verify.StatusMatch, analyzer.IIDEUI64},
+			}},
+		},
+		Unmapped: []Address{
+			{netip.MustParseAddr(lowByteGlobal), []Source{SourceNDP}, false, "", analyzer.IIDLowByte},
+		},
+	}, got)
+}
+
+// TestAnalyzeFormats tests that Analyze reads pcap files of either byte order
+// and timestamp precision, pcapng files, and the link layers it decodes.
+func TestAnalyzeFormats(t *testing.T) {
+	t.Parallel()
+
+	ra := routerAdvertisement()
+	raPacket := ra[ethernetHeader:]
+
+	sll := []byte{0, 4, 0, arphrdEther, 0, macBytes}
+	sll = append(sll, routerMAC...)
+	sll = append(sll, 0, 0, 0x86, 0xdd)
+
+	sll2 := []byte{0x86, 0xdd, 0, 0, 0, 0, 0, 2, 0, arphrdEther, 4, macBytes}
+	sll2 = append(sll2, routerMAC...)
+	sll2 = append(sll2, 0, 0)
+
+	vlan := append(bytes.Clone(ra[:ethernetType]), 0x81, 0x00, 0x00, 0x0a, 0x86, 0xdd)
+	vlan = append(vlan, raPacket...)
+
+	tests := []struct {
+		name string
+		file []byte
+	}{
+		{"pcap, little-endian", pcap(binary.LittleEndian, pcapMagicMicro, linkEthernet, ra)},
+		{"pcap, big-endian nanoseconds", pcap(binary.BigEndian, pcapMagicNano, linkEthernet, ra)},
+		{"pcapng, big-endian", pcapng(binary.BigEndian, []linkType{linkEthernet}, capturedPacket{0, ra})},
+		{"Linux cooked", pcap(binary.LittleEndian, pcapMagicMicro, linkLinuxSLL, append(sll, raPacket...))},
+		{"Linux cooked v2", pcap(binary.LittleEndian, pcapMagicMicro, linkLinuxSLL2, append(sll2, raPacket...))},
+		{"VLAN", pcap(binary.LittleEndian, pcapMagicMicro, linkEthernet, vlan)},
+		{"Raw IP", pcap(binary.LittleEndian, pcapMagicMicro, linkRaw, raPacket)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Analyze(&eui64.DefaultCalculator{}, bytes.NewReader(tt.file))
+			require.NoError(t, err)
+			assert.Equal(t, 1, got.Packets, "Packets")
+			assert.Equal(t, 1, got.Messages, "Messages")
+			require.Len(t, got.Prefixes, 2)
+			require.Len(t, got.Hosts, 1)
+			assert.Equal(t, "00-00-5e-00-53-01", got.Hosts[0].MAC)
+		})
+	}
+}
+
+// TestAnalyzeInvalid tests that Analyze rejects files that are not captures or
+// are corrupt, and analyzes captures ending in a partial packet up to it.
+func TestAnalyzeInvalid(t *testing.T) {
+	t.Parallel()
+
+	ra := routerAdvertisement()
+	valid := pcap(binary.LittleEndian, pcapMagicMicro, linkEthernet, ra)
+
+	oversized := pcap(binary.LittleEndian, pcapMagicMicro, linkEthernet)
+	oversized = append(oversized, make([]byte, 8)...)
+	oversized = binary.LittleEndian.AppendUint32(oversized, maxRecordSize+1)
+
+	badBlock := pcapng(binary.LittleEndian, []linkType{linkEthernet})
+	badBlock = append(badBlock, pcapngBlock(binary.LittleEndian, blockEnhanced, make([]byte, 2))...)
+
+	tests := []struct {
+		name    string
+		file    []byte
+		wantErr error
+	}{
+		{"Empty", nil, ErrUnknownFormat},
+		{"Text", []byte("hello world"), ErrUnknownFormat},
+		{"Truncated header", valid[:pcapHeader-1], ErrInvalidFile},
+		{"Oversized packet", append(oversized, make([]byte, 4)...), ErrInvalidFile},
+		{"Undescribed interface", pcapng(binary.LittleEndian, nil, capturedPacket{0, ra}), ErrInvalidFile},
+		{"Short packet block", badBlock, ErrInvalidFile},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Analyze(&eui64.DefaultCalculator{}, bytes.NewReader(tt.file))
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	truncated := append(bytes.Clone(valid), valid[pcapHeader:len(valid)-10]...)
+
+	got, err := Analyze(&eui64.DefaultCalculator{}, bytes.NewReader(truncated))
+	require.NoError(t, err)
+	assert.True(t, got.Truncated, "Truncated")
+	assert.Equal(t, 1, got.Packets, "Packets")
+}
+
+// TestDecode tests how decode binds the addresses of NDP and DHCPv6 messages to
+// MAC addresses, and that it discards packets NDP and DHCPv6 would.
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	renew := append([]byte{dhcpRenew, 1, 2, 3},
+		dhcpv6Option(optionClientID, []byte{0, byte(duid.TypeLLT), 0, hardwareEthernet, 1, 2, 3, 4}, dhcpMAC)...)
+	renew = append(renew, iaNA(dhcpGlobal)...)
+
+	rebind := append([]byte{dhcpRebind, 1, 2, 3}, dhcpv6Option(optionClientID, []byte{0, 2, 0, 0, 0, 9, 1})...)
+	rebind = append(rebind, dhcpv6Option(optionIATA, make([]byte, iataOptions),
+		dhcpv6Option(optionIAAddress, netip.MustParseAddr(dhcpGlobal).AsSlice(), make([]byte, 8)))...)
+
+	clientLinkLayer := dhcpv6Option(optionClientLLAddr, []byte{0, hardwareEthernet}, relayedMAC)
+
+	solicitation := icmpv6(typeRouterSolicitation, make([]byte, rsOptions-4),
+		linkLayerOption(optionSourceLinkLayer, hostMAC))
+	hopByHop := append([]byte{nextICMPv6, 0}, make([]byte, 6)...)
+	fragment := []byte{nextICMPv6, 0, 0, 0x08, 0, 0, 0, 1}
+
+	tests := []struct {
+		name         string
+		packet       []byte
+		wantOK       bool
+		wantBindings []binding
+	}{
+		{
+			name: "Relayed Renew with a client link-layer address",
+			packet: ipv6("2001:db8::2", "2001:db8::547", 64, nextUDP, udp(portServer, portServer,
+				relay(dhcpRelayForward, renew, clientLinkLayer))),
+			wantOK:       true,
+			wantBindings: []binding{{"00-14-22-01-23-48", netip.MustParseAddr(dhcpGlobal)}},
+		},
+		{
+			name:         "Renew with a DUID-LLT",
+			packet:       ipv6(hostLinkLocal, "ff02::1:2", 1, nextUDP, udp(portClient, portServer, renew)),
+			wantOK:       true,
+			wantBindings: []binding{{"00-14-22-01-23-47", netip.MustParseAddr(dhcpGlobal)}},
+		},
+		{
+			name:         "Rebind with a DUID-EN",
+			packet:       ipv6(hostLinkLocal, "ff02::1:2", 1, nextUDP, udp(portClient, portServer, rebind)),
+			wantOK:       true,
+			wantBindings: []binding{{"", netip.MustParseAddr(dhcpGlobal)}},
+		},
+		{
+			name:         "Other UDP",
+			packet:       ipv6(hostLinkLocal, "ff02::fb", 255, nextUDP, udp(5353, 5353, renew)),
+			wantOK:       false,
+			wantBindings: nil,
+		},
+		{
+			name:         "Hop-by-Hop Options",
+			packet:       ipv6(hostLinkLocal, "ff02::2", ndpHopLimit, nextHopByHop, append(hopByHop, solicitation...)),
+			wantOK:       true,
+			wantBindings: []binding{{"00-14-22-01-23-45", netip.MustParseAddr(hostLinkLocal)}},
+		},
+		{
+			name:         "Later fragment",
+			packet:       ipv6(hostLinkLocal, "ff02::2", ndpHopLimit, nextFragment, append(fragment, solicitation...)),
+			wantOK:       false,
+			wantBindings: nil,
+		},
+		{
+			name: "Malformed option",
+			packet: ipv6(hostLinkLocal, "ff02::2", ndpHopLimit, nextICMPv6,
+				icmpv6(typeRouterSolicitation, make([]byte, rsOptions-4), []byte{optionSourceLinkLayer, 0})),
+			wantOK:       true,
+			wantBindings: []binding{{"", netip.MustParseAddr(hostLinkLocal)}},
+		},
+		{
+			name: "Nonzero code",
+			packet: ipv6(hostLinkLocal, "ff02::2", ndpHopLimit, nextICMPv6,
+				append([]byte{typeRouterSolicitation, 1}, solicitation[2:]...)),
+			wantOK:       false,
+			wantBindings: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := decode(packet{linkType: linkIPv6, data: tt.packet})
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantBindings, got.bindings)
+		})
+	}
+}
+
+// TestDecodeDHCPv6Sender tests that the addresses of DHCPv6 messages sent by
+// clients are bound to the MAC address they were sent from, rather than to the
+// one in their DUID, which may be another interface's, while those of replies
+// are bound to the DUID's.
+func TestDecodeDHCPv6Sender(t *testing.T) {
+	t.Parallel()
+
+	clientID := dhcpv6Option(optionClientID, duidLL(dhcpMAC))
+
+	renew := append([]byte{dhcpRenew, 1, 2, 3}, clientID...)
+	renew = append(renew, iaNA(dhcpGlobal)...)
+
+	reply := append([]byte{dhcpReply, 1, 2, 3}, clientID...)
+	reply = append(reply, iaNA(dhcpGlobal)...)
+
+	tests := []struct {
+		name   string
+		packet []byte
+		want   string
+	}{
+		{
+			name: "Renew",
+			packet: ethernet(hostMAC, etherTypeIPv6, ipv6(hostLinkLocal, "ff02::1:2", 1, nextUDP,
+				udp(portClient, portServer, renew))),
+			want: "00-14-22-01-23-45",
+		},
+		{
+			name: "Reply",
+			packet: ethernet(routerMAC, etherTypeIPv6, ipv6(routerLinkLocal, hostLinkLocal, 1, nextUDP,
+				udp(portServer, portClient, reply))),
+			want: "00-14-22-01-23-47",
+		},
+		{
+			name: "Relayed Renew",
+			packet: ethernet(routerMAC, etherTypeIPv6, ipv6("2001:db8::2", "2001:db8::547", 64, nextUDP,
+				udp(portServer, portServer, relay(dhcpRelayForward, renew)))),
+			want: "00-14-22-01-23-47",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := decode(packet{linkType: linkEthernet, data: tt.packet})
+			require.True(t, ok)
+			assert.Equal(t, []binding{{tt.want, netip.MustParseAddr(dhcpGlobal)}}, got.bindings)
+		})
+	}
+}
+
+// TestAdvertiseSLAAC tests that prefixes are reported as forming SLAAC
+// addresses only when hosts would form them, not when they are withdrawn with
+// a zero valid lifetime or are link-local.
+func TestAdvertiseSLAAC(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		prefix        string
+		validLifetime uint32
+		want          bool
+	}{
+		{name: "Autonomous /64", prefix: "2001:db8:0:1::/64", validLifetime: validLifetime, want: true},
+		{name: "Withdrawn", prefix: "2001:db8:0:1::/64", validLifetime: 0, want: false},
+		{name: "Link-local", prefix: "fe80::/64", validLifetime: validLifetime, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newCollector()
+			c.advertise(advertisement{
+				router:            netip.MustParseAddr(routerLinkLocal),
+				prefix:            netip.MustParsePrefix(tt.prefix),
+				onLink:            true,
+				autonomous:        true,
+				validLifetime:     tt.validLifetime,
+				preferredLifetime: 0,
+			})
+			assert.Equal(t, tt.want, c.prefixes[0].SLAAC)
+		})
+	}
+}
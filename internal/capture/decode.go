@@ -0,0 +1,513 @@
+package capture
+
+import (
+	"bytes"
+	"encoding/binary"
+	"iter"
+	"net"
+	"net/netip"
+	"strings"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/duid"
+)
+
+// Constants describing the link layers.
+const (
+	macBytes        = 6      // macBytes is the size of a MAC address.
+	ethernetHeader  = 14     // ethernetHeader is the size of an Ethernet header.
+	ethernetSource  = 6      // ethernetSource is the offset of the source MAC address in an Ethernet header.
+	ethernetType    = 12     // ethernetType is the offset of the EtherType in an Ethernet header.
+	vlanTag         = 4      // vlanTag is the size of an IEEE 802.1Q tag, which moves the EtherType.
+	etherTypeIPv6   = 0x86dd // etherTypeIPv6 is the EtherType of IPv6.
+	etherTypeVLAN   = 0x8100 // etherTypeVLAN is the EtherType of IEEE 802.1Q tags.
+	etherTypeQinQ   = 0x88a8 // etherTypeQinQ is the EtherType of IEEE 802.1ad service tags.
+	arphrdEther     = 1      // arphrdEther is the Linux hardware type of Ethernet.
+	sllHeader       = 16     // sllHeader is the size of a Linux cooked header.
+	sllHardware     = 2      // sllHardware is the offset of the hardware type in a Linux cooked header.
+	sllAddressSize  = 4      // sllAddressSize is the offset of the address length in a Linux cooked header.
+	sllAddress      = 6      // sllAddress is the offset of the address in a Linux cooked header.
+	sllProtocol     = 14     // sllProtocol is the offset of the protocol in a Linux cooked header.
+	sll2Header      = 20     // sll2Header is the size of a version 2 Linux cooked header.
+	sll2Hardware    = 8      // sll2Hardware is the offset of the hardware type in a version 2 Linux cooked header.
+	sll2AddressSize = 11     // sll2AddressSize is the offset of the address length in a version 2 Linux cooked header.
+	sll2Address     = 12     // sll2Address is the offset of the address in a version 2 Linux cooked header.
+	sll2Protocol    = 0      // sll2Protocol is the offset of the protocol in a version 2 Linux cooked header.
+)
+
+// Constants describing IPv6 and its extension headers, see RFC 8200.
+const (
+	ipv6Header      = 40 // ipv6Header is the size of the IPv6 header.
+	ipv6Version     = 6  // ipv6Version is the version of IPv6 headers.
+	ipv6Length      = 4  // ipv6Length is the offset of the payload length in the IPv6 header.
+	ipv6Next        = 6  // ipv6Next is the offset of the next header in the IPv6 header.
+	ipv6HopLimit    = 7  // ipv6HopLimit is the offset of the hop limit in the IPv6 header.
+	ipv6Source      = 8  // ipv6Source is the offset of the source address in the IPv6 header.
+	ipv6Bytes       = 16 // ipv6Bytes is the size of an IPv6 address.
+	versionShift    = 4  // versionShift moves the version into the low bits of the first byte.
+	nextHopByHop    = 0  // nextHopByHop is the Hop-by-Hop Options header.
+	nextUDP         = 17 // nextUDP is UDP.
+	nextRouting     = 43 // nextRouting is the Routing header.
+	nextFragment    = 44 // nextFragment is the Fragment header.
+	nextICMPv6      = 58 // nextICMPv6 is ICMPv6.
+	nextDestination = 60 // nextDestination is the Destination Options header.
+	extensionUnit   = 8  // extensionUnit is the unit of extension header lengths.
+	fragmentHeader  = 8  // fragmentHeader is the size of the Fragment header.
+	fragmentOffset  = 2  // fragmentOffset is the offset of the fragment offset in the Fragment header.
+	offsetMask      = 0xfff8
+)
+
+// Constants describing Neighbor Discovery, see RFC 4861.
+const (
+	typeRouterSolicitation    = 133
+	typeRouterAdvertisement   = 134
+	typeNeighborSolicitation  = 135
+	typeNeighborAdvertisement = 136
+	ndpHopLimit               = 255 // ndpHopLimit is the hop limit of valid NDP messages, which never leave the link.
+	icmpCode                  = 1   // icmpCode is the offset of the code in an ICMPv6 header.
+	rsOptions                 = 8   // rsOptions is the offset of the options of Router Solicitations.
+	raOptions                 = 16  // raOptions is the offset of the options of Router Advertisements.
+	nsTarget                  = 8   // nsTarget is the offset of the target address of Neighbor Solicitations and Advertisements.
+	nsOptions                 = 24  // nsOptions is the offset of the options of Neighbor Solicitations and Advertisements.
+	optionSourceLinkLayer     = 1   // optionSourceLinkLayer is the Source Link-Layer Address option.
+	optionTargetLinkLayer     = 2   // optionTargetLinkLayer is the Target Link-Layer Address option.
+	optionPrefixInformation   = 3   // optionPrefixInformation is the Prefix Information option.
+	optionHeader              = 2   // optionHeader is the size of the type and length of an NDP option.
+	optionUnit                = 8   // optionUnit is the unit of NDP option lengths.
+	prefixInformation         = 30  // prefixInformation is the size of the body of a Prefix Information option.
+	prefixLength              = 0   // prefixLength is the offset of the prefix length in a Prefix Information option.
+	prefixFlags               = 1   // prefixFlags is the offset of the flags in a Prefix Information option.
+	prefixValid               = 2   // prefixValid is the offset of the valid lifetime in a Prefix Information option.
+	prefixPreferred           = 6   // prefixPreferred is the offset of the preferred lifetime in a Prefix Information option.
+	prefixAddress             = 14  // prefixAddress is the offset of the prefix in a Prefix Information option.
+	flagOnLink                = 0x80
+	flagAutonomous            = 0x40
+)
+
+// Constants describing DHCPv6, see RFC 8415.
+const (
+	udpHeader           = 8   // udpHeader is the size of a UDP header.
+	udpLength           = 4   // udpLength is the offset of the length in a UDP header.
+	portClient          = 546 // portClient is the UDP port of DHCPv6 clients.
+	portServer          = 547 // portServer is the UDP port of DHCPv6 servers and relays.
+	dhcpConfirm         = 4
+	dhcpRenew           = 5
+	dhcpRebind          = 6
+	dhcpReply           = 7
+	dhcpRelayForward    = 12
+	dhcpRelayReply      = 13
+	dhcpOptions         = 4  // dhcpOptions is the offset of the options of client and server messages.
+	relayOptions        = 34 // relayOptions is the offset of the options of relay messages.
+	maxRelayDepth       = 32 // maxRelayDepth bounds the nesting of relay messages, as their hop count does.
+	dhcpOptionHeader    = 4  // dhcpOptionHeader is the size of the code and length of a DHCPv6 option.
+	optionClientID      = 1
+	optionIANA          = 3
+	optionIATA          = 4
+	optionIAAddress     = 5
+	optionRelayMessage  = 9
+	optionClientLLAddr  = 79 // optionClientLLAddr is the Client Link-Layer Address option of relays, see RFC 6939.
+	ianaOptions         = 12 // ianaOptions is the offset of the options of IA_NA options.
+	iataOptions         = 4  // iataOptions is the offset of the options of IA_TA options.
+	linkLayerAddrOffset = 2  // linkLayerAddrOffset is the offset of the address in a Client Link-Layer Address option.
+	hardwareEthernet    = 1  // hardwareEthernet is the IANA hardware type of Ethernet.
+)
+
+// message is what an NDP or DHCPv6 message reveals about the hosts on a link.
+type message struct {
+	source   Source
+	bindings []binding
+	prefixes []advertisement
+}
+
+// binding is an address seen for a MAC address, or without one if mac is
+// empty.
+type binding struct {
+	mac     string
+	address netip.Addr
+}
+
+// advertisement is a Prefix Information option of a Router Advertisement.
+type advertisement struct {
+	router            netip.Addr
+	prefix            netip.Prefix
+	onLink            bool
+	autonomous        bool
+	validLifetime     uint32
+	preferredLifetime uint32
+}
+
+// decode decodes an NDP or DHCPv6 message from a packet, reporting whether the
+// packet holds one.
+func decode(p packet) (message, bool) {
+	mac, data, ok := linkLayer(p)
+	if !ok || len(data) < ipv6Header || data[0]>>versionShift != ipv6Version {
+		return message{}, false
+	}
+
+	if length := int(binary.BigEndian.Uint16(data[ipv6Length:])); ipv6Header+length < len(data) {
+		data = data[:ipv6Header+length] // Trim Ethernet padding.
+	}
+
+	source := netip.AddrFrom16([ipv6Bytes]byte(data[ipv6Source:]))
+	hopLimit := data[ipv6HopLimit]
+
+	next, payload, ok := skipExtensions(data[ipv6Next], data[ipv6Header:])
+	if !ok {
+		return message{}, false
+	}
+
+	switch next {
+	case nextICMPv6:
+		return decodeNDP(mac, source, hopLimit, payload)
+	case nextUDP:
+		return decodeDHCPv6(mac, payload)
+	default:
+		return message{}, false
+	}
+}
+
+// linkLayer returns the source MAC address of a packet, empty if its link type
+// has none, and its IPv6 packet, reporting whether it holds one.
+func linkLayer(p packet) (string, []byte, bool) {
+	data := p.data
+
+	switch p.linkType {
+	case linkEthernet:
+		if len(data) < ethernetHeader {
+			return "", nil, false
+		}
+
+		mac := formatMAC(data[ethernetSource : ethernetSource+macBytes])
+		etherType := binary.BigEndian.Uint16(data[ethernetType:])
+		offset := ethernetHeader
+
+		for (etherType == etherTypeVLAN || etherType == etherTypeQinQ) && len(data) >= offset+vlanTag {
+			etherType = binary.BigEndian.Uint16(data[offset+vlanTag-2:])
+			offset += vlanTag
+		}
+
+		return mac, data[offset:], etherType == etherTypeIPv6
+	case linkLinuxSLL:
+		if len(data) < sllHeader {
+			return "", nil, false
+		}
+
+		mac := cookedMAC(data, sllHardware, int(binary.BigEndian.Uint16(data[sllAddressSize:])), sllAddress)
+
+		return mac, data[sllHeader:], binary.BigEndian.Uint16(data[sllProtocol:]) == etherTypeIPv6
+	case linkLinuxSLL2:
+		if len(data) < sll2Header {
+			return "", nil, false
+		}
+
+		mac := cookedMAC(data, sll2Hardware, int(data[sll2AddressSize]), sll2Address)
+
+		return mac, data[sll2Header:], binary.BigEndian.Uint16(data[sll2Protocol:]) == etherTypeIPv6
+	case linkRaw, linkIPv6:
+		return "", data, true
+	default:
+		return "", nil, false
+	}
+}
+
+// cookedMAC returns the source MAC address of a Linux cooked header, empty if
+// the packet was not captured on an Ethernet interface.
+func cookedMAC(data []byte, hardware, size, address int) string {
+	if binary.BigEndian.Uint16(data[hardware:]) != arphrdEther || size != macBytes {
+		return ""
+	}
+
+	return formatMAC(data[address : address+macBytes])
+}
+
+// skipExtensions skips the extension headers of an IPv6 packet, returning the
+// upper-layer protocol and its payload. Fragments other than the first are
+// skipped, as their payloads have no upper-layer header.
+func skipExtensions(next byte, data []byte) (byte, []byte, bool) {
+	for {
+		switch next {
+		case nextHopByHop, nextRouting, nextDestination:
+			if len(data) < extensionUnit {
+				return 0, nil, false
+			}
+
+			length := (int(data[1]) + 1) * extensionUnit
+			if len(data) < length {
+				return 0, nil, false
+			}
+
+			next, data = data[0], data[length:]
+		case nextFragment:
+			if len(data) < fragmentHeader || binary.BigEndian.Uint16(data[fragmentOffset:])&offsetMask != 0 {
+				return 0, nil, false
+			}
+
+			next, data = data[0], data[fragmentHeader:]
+		default:
+			return next, data, true
+		}
+	}
+}
+
+// decodeNDP decodes a Neighbor Discovery message sent from source by the MAC
+// address mac, empty if the link layer has none, binding the addresses it
+// reveals to MAC addresses: the source's to its Source Link-Layer Address
+// option, or the link layer's, a Neighbor Advertisement's target to its
+// Target Link-Layer Address option, and the tentative address of a duplicate
+// address detection probe, sent from the unspecified address, to the sender.
+func decodeNDP(mac string, source netip.Addr, hopLimit byte, icmp []byte) (message, bool) {
+	if len(icmp) < rsOptions || hopLimit != ndpHopLimit || icmp[icmpCode] != 0 {
+		return message{}, false
+	}
+
+	msg := message{source: SourceNDP, bindings: nil, prefixes: nil}
+	icmpType := icmp[0]
+
+	var options []byte
+
+	target := netip.Addr{}
+
+	switch icmpType {
+	case typeRouterSolicitation:
+		options = icmp[rsOptions:]
+	case typeRouterAdvertisement:
+		if len(icmp) < raOptions {
+			return message{}, false
+		}
+
+		options = icmp[raOptions:]
+	case typeNeighborSolicitation, typeNeighborAdvertisement:
+		if len(icmp) < nsOptions {
+			return message{}, false
+		}
+
+		target = netip.AddrFrom16([ipv6Bytes]byte(icmp[nsTarget:]))
+		options = icmp[nsOptions:]
+	default:
+		return message{}, false
+	}
+
+	sourceMAC, targetMAC := mac, ""
+
+	for optionType, body := range ndpOptions(options) {
+		switch {
+		case optionType == optionSourceLinkLayer && len(body) == macBytes:
+			sourceMAC = formatMAC(body)
+		case optionType == optionTargetLinkLayer && len(body) == macBytes:
+			targetMAC = formatMAC(body)
+		case optionType == optionPrefixInformation && icmpType == typeRouterAdvertisement:
+			if ad, ok := prefixAdvertisement(source, body); ok {
+				msg.prefixes = append(msg.prefixes, ad)
+			}
+		}
+	}
+
+	switch {
+	case icmpType == typeNeighborSolicitation && source.IsUnspecified():
+		msg.bind(mac, target)
+	case icmpType == typeNeighborAdvertisement:
+		if targetMAC == "" {
+			targetMAC = mac
+		}
+
+		msg.bind(mac, source)
+		msg.bind(targetMAC, target)
+	default:
+		msg.bind(sourceMAC, source)
+	}
+
+	return msg, true
+}
+
+// ndpOptions returns the type and body of each option of an NDP message,
+// stopping at a malformed option as RFC 4861 requires discarding the message.
+func ndpOptions(data []byte) iter.Seq2[byte, []byte] {
+	return func(yield func(byte, []byte) bool) {
+		for len(data) >= optionHeader {
+			length := int(data[1]) * optionUnit
+			if length == 0 || length > len(data) {
+				return
+			}
+
+			if !yield(data[0], data[optionHeader:length]) {
+				return
+			}
+
+			data = data[length:]
+		}
+	}
+}
+
+// prefixAdvertisement decodes the body of a Prefix Information option
+// advertised by router.
+func prefixAdvertisement(router netip.Addr, body []byte) (advertisement, bool) {
+	if len(body) != prefixInformation {
+		return advertisement{}, false
+	}
+
+	addr := netip.AddrFrom16([ipv6Bytes]byte(body[prefixAddress:]))
+
+	prefix, err := addr.Prefix(int(body[prefixLength]))
+	if err != nil {
+		return advertisement{}, false
+	}
+
+	return advertisement{
+		router:            router,
+		prefix:            prefix,
+		onLink:            body[prefixFlags]&flagOnLink != 0,
+		autonomous:        body[prefixFlags]&flagAutonomous != 0,
+		validLifetime:     binary.BigEndian.Uint32(body[prefixValid:]),
+		preferredLifetime: binary.BigEndian.Uint32(body[prefixPreferred:]),
+	}, true
+}
+
+// decodeDHCPv6 decodes a DHCPv6 message from a UDP datagram between DHCPv6
+// clients, relays and servers, sent by the MAC address mac.
+func decodeDHCPv6(mac string, udp []byte) (message, bool) {
+	if len(udp) < udpHeader {
+		return message{}, false
+	}
+
+	source, destination := binary.BigEndian.Uint16(udp), binary.BigEndian.Uint16(udp[2:])
+	if !isDHCPv6Port(source) || !isDHCPv6Port(destination) {
+		return message{}, false
+	}
+
+	if length := int(binary.BigEndian.Uint16(udp[udpLength:])); length >= udpHeader && length < len(udp) {
+		udp = udp[:length]
+	}
+
+	msg := message{source: SourceDHCPv6, bindings: nil, prefixes: nil}
+	msg.dhcpv6(udp[udpHeader:], mac, "", 0)
+
+	return msg, true
+}
+
+// isDHCPv6Port reports whether a UDP port is a DHCPv6 port.
+func isDHCPv6Port(port uint16) bool {
+	return port == portClient || port == portServer
+}
+
+// dhcpv6 binds the addresses a DHCPv6 message assigns or confirms to its
+// client: to the MAC address its relay reports in a Client Link-Layer Address
+// option, relayMAC, to the MAC address senderMAC the client sent it from, or
+// else to the one in its DUID, which may be another interface's. Relay
+// messages are unwrapped.
+func (m *message) dhcpv6(data []byte, senderMAC, relayMAC string, depth int) {
+	if len(data) == 0 {
+		return
+	}
+
+	switch data[0] {
+	case dhcpRelayForward, dhcpRelayReply:
+		if len(data) < relayOptions || depth >= maxRelayDepth {
+			return
+		}
+
+		var inner []byte
+
+		for code, body := range dhcpv6Options(data[relayOptions:]) {
+			switch code {
+			case optionRelayMessage:
+				inner = body
+			case optionClientLLAddr:
+				if len(body) == linkLayerAddrOffset+macBytes &&
+					binary.BigEndian.Uint16(body) == hardwareEthernet {
+					relayMAC = formatMAC(body[linkLayerAddrOffset:])
+				}
+			}
+		}
+
+		m.dhcpv6(inner, "", relayMAC, depth+1)
+	case dhcpConfirm, dhcpRenew, dhcpRebind, dhcpReply:
+		if len(data) < dhcpOptions {
+			return
+		}
+
+		mac := relayMAC
+		if mac == "" && data[0] != dhcpReply {
+			mac = senderMAC
+		}
+
+		var addresses []netip.Addr
+
+		for code, body := range dhcpv6Options(data[dhcpOptions:]) {
+			switch {
+			case code == optionClientID && mac == "":
+				mac = duidMAC(body)
+			case code == optionIANA && len(body) >= ianaOptions:
+				addresses = append(addresses, iaAddresses(body[ianaOptions:])...)
+			case code == optionIATA && len(body) >= iataOptions:
+				addresses = append(addresses, iaAddresses(body[iataOptions:])...)
+			}
+		}
+
+		for _, addr := range addresses {
+			m.bind(mac, addr)
+		}
+	}
+}
+
+// dhcpv6Options returns the code and body of each option of a DHCPv6 message,
+// stopping at a truncated option.
+func dhcpv6Options(data []byte) iter.Seq2[uint16, []byte] {
+	return func(yield func(uint16, []byte) bool) {
+		for len(data) >= dhcpOptionHeader {
+			code := binary.BigEndian.Uint16(data)
+			length := dhcpOptionHeader + int(binary.BigEndian.Uint16(data[2:]))
+
+			if length > len(data) || !yield(code, data[dhcpOptionHeader:length]) {
+				return
+			}
+
+			data = data[length:]
+		}
+	}
+}
+
+// iaAddresses returns the addresses of the IA Address options within an
+// identity association.
+func iaAddresses(data []byte) []netip.Addr {
+	var addresses []netip.Addr
+
+	for code, body := range dhcpv6Options(data) {
+		if code == optionIAAddress && len(body) >= ipv6Bytes {
+			addresses = append(addresses, netip.AddrFrom16([ipv6Bytes]byte(body)))
+		}
+	}
+
+	return addresses
+}
+
+// duidMAC returns the Ethernet MAC address of a DUID-LLT or DUID-LL, empty for
+// other DUIDs.
+func duidMAC(id []byte) string {
+	decoded, err := duid.Decode(id)
+	if err != nil || decoded.MAC() == "" {
+		return ""
+	}
+
+	return formatMAC(decoded.LinkLayerAddress)
+}
+
+// bind binds an address to a MAC address, dropping addresses that identify no
+// interface, such as multicast addresses, and MAC addresses that identify no
+// host, such as the broadcast address.
+func (m *message) bind(mac string, addr netip.Addr) {
+	if !addr.IsValid() || addr.IsUnspecified() || addr.IsMulticast() || addr.IsLoopback() {
+		return
+	}
+
+	m.bindings = append(m.bindings, binding{mac: mac, address: addr})
+}
+
+// formatMAC formats a MAC address as six pairs of lowercase hexadecimal digits
+// separated by hyphens, as the calculator accepts it, or returns an empty string
+// for group and all-zero addresses.
+func formatMAC(address []byte) string {
+	if address[0]&1 != 0 || bytes.Equal(address, make([]byte, macBytes)) {
+		return ""
+	}
+
+	return strings.ReplaceAll(net.HardwareAddr(address).String(), ":", "-")
+}
@@ -0,0 +1,257 @@
+package capture
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// linkType is the link-layer header type of captured packets, see
+// https://www.tcpdump.org/linktypes.html.
+type linkType uint16
+
+// Link types the analyzer decodes.
+const (
+	linkEthernet  linkType = 1   // linkEthernet is IEEE 802.3 Ethernet.
+	linkRaw       linkType = 101 // linkRaw is raw IP, starting with the IP header.
+	linkLinuxSLL  linkType = 113 // linkLinuxSLL is the Linux "cooked" header of captures on any interface.
+	linkIPv6      linkType = 229 // linkIPv6 is raw IPv6.
+	linkLinuxSLL2 linkType = 276 // linkLinuxSLL2 is the second version of the Linux "cooked" header.
+)
+
+// Constants describing the file formats, see
+// https://www.ietf.org/archive/id/draft-ietf-opsawg-pcap-04.html and
+// https://www.ietf.org/archive/id/draft-ietf-opsawg-pcapng-03.html.
+const (
+	pcapMagicMicro   = 0xa1b2c3d4 // pcapMagicMicro starts pcap files with microsecond timestamps.
+	pcapMagicNano    = 0xa1b23c4d // pcapMagicNano starts pcap files with nanosecond timestamps.
+	pcapHeader       = 24         // pcapHeader is the size of the pcap file header.
+	pcapLinkType     = 20         // pcapLinkType is the offset of the link type in the pcap file header.
+	pcapRecord       = 16         // pcapRecord is the size of the header of a pcap packet record.
+	pcapCaptured     = 8          // pcapCaptured is the offset of the captured length in a packet record header.
+	blockSection     = 0x0a0d0d0a // blockSection is the type of pcapng Section Header Blocks, the same in either byte order.
+	blockInterface   = 1          // blockInterface is the type of pcapng Interface Description Blocks.
+	blockPacket      = 2          // blockPacket is the type of obsolete pcapng Packet Blocks.
+	blockSimple      = 3          // blockSimple is the type of pcapng Simple Packet Blocks.
+	blockEnhanced    = 6          // blockEnhanced is the type of pcapng Enhanced Packet Blocks.
+	byteOrderMagic   = 0x1a2b3c4d // byteOrderMagic follows the length of Section Header Blocks, giving the section's byte order.
+	blockHeader      = 8          // blockHeader is the size of the type and length starting a pcapng block.
+	blockLength      = 4          // blockLength is the offset of the length in a block header.
+	blockTrailer     = 4          // blockTrailer is the size of the length ending a pcapng block.
+	blockAlignment   = 4          // blockAlignment is the alignment of pcapng block lengths.
+	interfaceBody    = 8          // interfaceBody is the minimum size of the body of an Interface Description Block.
+	enhancedBody     = 20         // enhancedBody is the size of the fields preceding the packet in an Enhanced Packet Block.
+	enhancedCaptured = 12         // enhancedCaptured is the offset of the captured length in an Enhanced Packet Block.
+	packetInterface  = 0          // packetInterface is the offset of the interface ID in Enhanced and obsolete Packet Blocks.
+	simpleBody       = 4          // simpleBody is the size of the original length preceding the packet in a Simple Packet Block.
+	magicBytes       = 4          // magicBytes is the size of the magic numbers identifying the formats.
+	linkTypeMask     = 0xffff     // linkTypeMask covers the link type in the pcap header, whose upper bits may describe FCS.
+	// maxRecordSize bounds the size of packets and blocks, well above the 262144-byte
+	// snapshot length of tcpdump, so a corrupt length cannot exhaust memory.
+	maxRecordSize = 1 << 20
+)
+
+// packet is a captured packet and the link type of its interface.
+type packet struct {
+	linkType linkType
+	data     []byte
+}
+
+// packetReader reads the packets of a capture in order. next returns io.EOF
+// after the last packet, and io.ErrUnexpectedEOF if the capture ends within a
+// packet.
+type packetReader interface {
+	next() (packet, error)
+}
+
+// pcapReader reads the packets of a pcap file.
+type pcapReader struct {
+	r        *bufio.Reader
+	order    binary.ByteOrder
+	linkType linkType
+}
+
+// pcapngReader reads the packets of a pcapng file, tracking the byte order and
+// interfaces of its current section.
+type pcapngReader struct {
+	r          *bufio.Reader
+	order      binary.ByteOrder
+	interfaces []linkType // interfaces lists the link types of the section's interfaces, by interface ID.
+}
+
+// newReader returns a reader of a pcap or pcapng file, identified by its magic
+// number.
+func newReader(r io.Reader) (packetReader, error) {
+	buffered := bufio.NewReader(r)
+
+	magic, err := buffered.Peek(magicBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnknownFormat, err)
+	}
+
+	if binary.LittleEndian.Uint32(magic) == blockSection {
+		return &pcapngReader{r: buffered, order: binary.LittleEndian, interfaces: nil}, nil
+	}
+
+	var order binary.ByteOrder
+
+	switch {
+	case isPcapMagic(binary.LittleEndian.Uint32(magic)):
+		order = binary.LittleEndian
+	case isPcapMagic(binary.BigEndian.Uint32(magic)):
+		order = binary.BigEndian
+	default:
+		return nil, ErrUnknownFormat
+	}
+
+	var header [pcapHeader]byte
+	if _, err := io.ReadFull(buffered, header[:]); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFile, unexpected(err))
+	}
+
+	return &pcapReader{
+		r:        buffered,
+		order:    order,
+		linkType: linkType(order.Uint32(header[pcapLinkType:]) & linkTypeMask),
+	}, nil
+}
+
+// isPcapMagic reports whether a number is the magic number of pcap files.
+func isPcapMagic(magic uint32) bool {
+	return magic == pcapMagicMicro || magic == pcapMagicNano
+}
+
+// next reads the next packet record.
+func (p *pcapReader) next() (packet, error) {
+	var header [pcapRecord]byte
+	if _, err := io.ReadFull(p.r, header[:]); err != nil {
+		if errors.Is(err, io.EOF) {
+			return packet{}, io.EOF
+		}
+
+		return packet{}, unexpected(err)
+	}
+
+	length := p.order.Uint32(header[pcapCaptured:])
+	if length > maxRecordSize {
+		return packet{}, fmt.Errorf("%w: packet of %d bytes", ErrInvalidFile, length)
+	}
+
+	data := make([]byte, length)
+	if _, err := io.ReadFull(p.r, data); err != nil {
+		return packet{}, unexpected(err)
+	}
+
+	return packet{linkType: p.linkType, data: data}, nil
+}
+
+// next reads blocks up to the next packet, starting new sections and
+// recording interfaces on the way and skipping other blocks.
+func (p *pcapngReader) next() (packet, error) {
+	for {
+		blockType, body, err := p.block()
+		if err != nil {
+			return packet{}, err
+		}
+
+		switch blockType {
+		case blockInterface:
+			if len(body) < interfaceBody {
+				return packet{}, fmt.Errorf("%w: short interface description", ErrInvalidFile)
+			}
+
+			p.interfaces = append(p.interfaces, linkType(p.order.Uint16(body)))
+		case blockEnhanced, blockPacket:
+			return p.packet(blockType, body)
+		case blockSimple:
+			if len(body) < simpleBody {
+				return packet{}, fmt.Errorf("%w: short simple packet", ErrInvalidFile)
+			}
+
+			data := body[simpleBody:]
+			if original := int(p.order.Uint32(body)); original < len(data) {
+				data = data[:original]
+			}
+
+			return p.withInterface(0, data)
+		}
+	}
+}
+
+// block reads the next block, returning its type and body. A Section Header
+// Block sets the byte order of the blocks following it and clears the
+// interfaces of the previous section.
+func (p *pcapngReader) block() (uint32, []byte, error) {
+	var header [blockHeader]byte
+	if _, err := io.ReadFull(p.r, header[:]); err != nil {
+		if errors.Is(err, io.EOF) {
+			return 0, nil, io.EOF
+		}
+
+		return 0, nil, unexpected(err)
+	}
+
+	blockType := p.order.Uint32(header[:])
+	if blockType == blockSection {
+		magic, err := p.r.Peek(magicBytes)
+		if err != nil {
+			return 0, nil, unexpected(err)
+		}
+
+		switch {
+		case binary.LittleEndian.Uint32(magic) == byteOrderMagic:
+			p.order = binary.LittleEndian
+		case binary.BigEndian.Uint32(magic) == byteOrderMagic:
+			p.order = binary.BigEndian
+		default:
+			return 0, nil, fmt.Errorf("%w: unknown byte order", ErrInvalidFile)
+		}
+
+		p.interfaces = nil
+	}
+
+	length := p.order.Uint32(header[blockLength:])
+	if length < blockHeader+blockTrailer || length%blockAlignment != 0 || length > maxRecordSize {
+		return 0, nil, fmt.Errorf("%w: block of %d bytes", ErrInvalidFile, length)
+	}
+
+	body := make([]byte, length-blockHeader)
+	if _, err := io.ReadFull(p.r, body); err != nil {
+		return 0, nil, unexpected(err)
+	}
+
+	return blockType, body[:len(body)-blockTrailer], nil
+}
+
+// packet returns the packet of an Enhanced Packet Block or an obsolete Packet
+// Block, which share the layout of their fields but for the size of the
+// interface ID.
+func (p *pcapngReader) packet(blockType uint32, body []byte) (packet, error) {
+	if len(body) < enhancedBody {
+		return packet{}, fmt.Errorf("%w: short packet block", ErrInvalidFile)
+	}
+
+	captured := int(p.order.Uint32(body[enhancedCaptured:]))
+	if captured > len(body)-enhancedBody {
+		return packet{}, fmt.Errorf("%w: packet of %d bytes exceeds its block", ErrInvalidFile, captured)
+	}
+
+	id := int(p.order.Uint32(body[packetInterface:]))
+	if blockType == blockPacket {
+		id = int(p.order.Uint16(body[packetInterface:]))
+	}
+
+	return p.withInterface(id, body[enhancedBody:enhancedBody+captured])
+}
+
+// withInterface returns a packet captured on the interface of the section
+// with the given ID.
+func (p *pcapngReader) withInterface(id int, data []byte) (packet, error) {
+	if id >= len(p.interfaces) {
+		return packet{}, fmt.Errorf("%w: packet on undescribed interface %d", ErrInvalidFile, id)
+	}
+
+	return packet{linkType: p.interfaces[id], data: data}, nil
+}
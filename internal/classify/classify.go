@@ -0,0 +1,131 @@
+// Package classify identifies the kind of IPv6 address space a prefix belongs
+// to, such as global unicast, unique local, link-local, documentation, or
+// multicast space, and whether hosts can form EUI-64 addresses in it with
+// stateless address autoconfiguration (SLAAC). Prefixes in which they cannot,
+// or should not, carry a warning explaining why.
+package classify
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// Type is the kind of address space a prefix belongs to.
+type Type string
+
+// Types of address space, in the order they are checked.
+const (
+	TypeMulticast     Type = "multicast"     // TypeMulticast is ff00::/8.
+	TypeLinkLocal     Type = "link_local"    // TypeLinkLocal is fe80::/10.
+	TypeULA           Type = "ula"           // TypeULA is fc00::/7, unique local addresses.
+	TypeDocumentation Type = "documentation" // TypeDocumentation is 2001:db8::/32 and 3fff::/20.
+	TypeTeredo        Type = "teredo"        // TypeTeredo is 2001::/32.
+	Type6to4          Type = "6to4"          // Type6to4 is 2002::/16.
+	TypeGUA           Type = "gua"           // TypeGUA is the rest of 2000::/3, global unicast addresses.
+	TypeReserved      Type = "reserved"      // TypeReserved is any other address space.
+)
+
+// Warning explains why EUI-64 SLAAC is not applicable in a prefix, or why the
+// prefix should not be used for it.
+type Warning string
+
+// Warnings about prefixes. WarningNone is the absence of a warning.
+const (
+	WarningNone            Warning = ""
+	WarningMulticast       Warning = "multicast"       // Multicast addresses identify groups, not interfaces.
+	WarningLinkLocalBits   Warning = "link_local_bits" // Link-local prefixes are fe80::/64, with the bits after fe80 zero.
+	WarningULAUnassigned   Warning = "ula_unassigned"  // fc00::/8 is reserved for a future central assignment.
+	WarningDocumentation   Warning = "documentation"   // Documentation prefixes are not used on networks.
+	WarningTeredo          Warning = "teredo"          // Teredo interface IDs encode IPv4 addresses instead.
+	WarningDeprecated6to4  Warning = "6to4_deprecated" // 6to4 is deprecated by RFC 7526.
+	WarningReservedUnicast Warning = "reserved"        // The space is not assigned for unicast addresses.
+)
+
+// Classification describes the address space of a prefix.
+type Classification struct {
+	Type    Type         // Type is the kind of address space.
+	Range   netip.Prefix // Range is the block of addresses defining Type, such as 2001:db8::/32.
+	SLAAC   bool         // SLAAC reports whether hosts can form EUI-64 addresses in the prefix with SLAAC.
+	Warning Warning      // Warning explains a problem with the prefix, if any.
+}
+
+// maxHextets is the number of hextets of a /64 prefix, the most a prefix may have.
+const maxHextets = 4
+
+// ErrInvalidPrefix is returned for prefixes that are not up to four hextets,
+// optionally followed by "::".
+var ErrInvalidPrefix = errors.New("invalid IPv6 prefix")
+
+// Address blocks defining the types of address space, see RFC 6890 and the IANA
+// IPv6 Special-Purpose Address Registry.
+var (
+	multicastRange     = netip.MustParsePrefix("ff00::/8")
+	linkLocalRange     = netip.MustParsePrefix("fe80::/10")
+	linkLocalSubnet    = netip.MustParsePrefix("fe80::/64")
+	ulaRange           = netip.MustParsePrefix("fc00::/7")
+	ulaLocalRange      = netip.MustParsePrefix("fd00::/8")
+	documentationRange = netip.MustParsePrefix("2001:db8::/32")
+	documentation2     = netip.MustParsePrefix("3fff::/20")
+	teredoRange        = netip.MustParsePrefix("2001::/32")
+	sixToFourRange     = netip.MustParsePrefix("2002::/16")
+	guaRange           = netip.MustParsePrefix("2000::/3")
+	reservedRange      = netip.MustParsePrefix("::/0")
+)
+
+// Prefix classifies a prefix as entered in the calculator: up to four hextets,
+// such as 2001:db8:85a3:0, optionally followed by "::". A leading empty hextet,
+// as in :1, is zero, as in the calculation.
+func Prefix(prefix string) (Classification, error) {
+	prefix = strings.TrimSpace(prefix)
+
+	hextets := strings.Split(strings.TrimSuffix(prefix, "::"), ":")
+	if len(hextets) > maxHextets {
+		return Classification{}, fmt.Errorf("%w %q: more than %d hextets", ErrInvalidPrefix, prefix, maxHextets)
+	}
+
+	if hextets[0] == "" {
+		hextets[0] = "0"
+	}
+
+	addr, err := netip.ParseAddr(strings.Join(hextets, ":") + "::")
+	if err != nil {
+		return Classification{}, fmt.Errorf("%w %q: %w", ErrInvalidPrefix, prefix, err)
+	}
+
+	return Address(addr), nil
+}
+
+// Address classifies the address space of an IPv6 address, such as a full
+// EUI-64 address, by the /64 prefix it belongs to.
+func Address(addr netip.Addr) Classification {
+	switch {
+	case multicastRange.Contains(addr):
+		return Classification{Type: TypeMulticast, Range: multicastRange, SLAAC: false, Warning: WarningMulticast}
+	case linkLocalRange.Contains(addr):
+		if !linkLocalSubnet.Contains(addr) {
+			return Classification{Type: TypeLinkLocal, Range: linkLocalRange, SLAAC: false, Warning: WarningLinkLocalBits}
+		}
+
+		return Classification{Type: TypeLinkLocal, Range: linkLocalRange, SLAAC: true, Warning: WarningNone}
+	case ulaRange.Contains(addr):
+		if !ulaLocalRange.Contains(addr) {
+			return Classification{Type: TypeULA, Range: ulaRange, SLAAC: true, Warning: WarningULAUnassigned}
+		}
+
+		return Classification{Type: TypeULA, Range: ulaRange, SLAAC: true, Warning: WarningNone}
+	case documentationRange.Contains(addr):
+		return Classification{Type: TypeDocumentation, Range: documentationRange, SLAAC: false, Warning: WarningDocumentation}
+	case documentation2.Contains(addr):
+		return Classification{Type: TypeDocumentation, Range: documentation2, SLAAC: false, Warning: WarningDocumentation}
+	case teredoRange.Contains(addr):
+		return Classification{Type: TypeTeredo, Range: teredoRange, SLAAC: false, Warning: WarningTeredo}
+	case sixToFourRange.Contains(addr):
+		return Classification{Type: Type6to4, Range: sixToFourRange, SLAAC: true, Warning: WarningDeprecated6to4}
+	case guaRange.Contains(addr):
+		return Classification{Type: TypeGUA, Range: guaRange, SLAAC: true, Warning: WarningNone}
+	default:
+		return Classification{Type: TypeReserved, Range: reservedRange, SLAAC: false, Warning: WarningReservedUnicast}
+	}
+}
@@ -0,0 +1,80 @@
+package classify
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPrefix tests the Prefix function with prefixes of each type of address
+// space, verifying their classification, SLAAC applicability, and warnings.
+func TestPrefix(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		prefix      string
+		wantType    Type
+		wantRange   string
+		wantSLAAC   bool
+		wantWarning Warning
+	}{
+		{"Global unicast", "2a01:4f8:1:2", TypeGUA, "2000::/3", true, WarningNone},
+		{"Global unicast with trailing colons", "2600:1f18::", TypeGUA, "2000::/3", true, WarningNone},
+		{"Unique local", "fd12:3456:789a:1", TypeULA, "fc00::/7", true, WarningNone},
+		{"Unique local without L bit", "fc00:1:2:3", TypeULA, "fc00::/7", true, WarningULAUnassigned},
+		{"Link-local", "fe80::", TypeLinkLocal, "fe80::/10", true, WarningNone},
+		{"Link-local with subnet bits", "fe80:0:0:1", TypeLinkLocal, "fe80::/10", false, WarningLinkLocalBits},
+		{"Link-local beyond fe80", "febf:1", TypeLinkLocal, "fe80::/10", false, WarningLinkLocalBits},
+		{"Documentation", "2001:db8:85a3:0", TypeDocumentation, "2001:db8::/32", false, WarningDocumentation},
+		{"Documentation 3fff::/20", "3fff:abc", TypeDocumentation, "3fff::/20", false, WarningDocumentation},
+		{"Multicast", "ff02::", TypeMulticast, "ff00::/8", false, WarningMulticast},
+		{"6to4", "2002:c000:204:1", Type6to4, "2002::/16", true, WarningDeprecated6to4},
+		{"Teredo", "2001:0:4136:e378", TypeTeredo, "2001::/32", false, WarningTeredo},
+		{"Unspecified", "::", TypeReserved, "::/0", false, WarningReservedUnicast},
+		{"Leading empty hextet", ":1", TypeReserved, "::/0", false, WarningReservedUnicast},
+		{"Site-local", "fec0:1", TypeReserved, "::/0", false, WarningReservedUnicast},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Prefix(tt.prefix)
+			require.NoError(t, err)
+			assert.Equal(t, Classification{
+				Type:    tt.wantType,
+				Range:   netip.MustParsePrefix(tt.wantRange),
+				SLAAC:   tt.wantSLAAC,
+				Warning: tt.wantWarning,
+			}, got)
+		})
+	}
+}
+
+// TestPrefixInvalid tests the Prefix function with prefixes that cannot be
+// classified.
+func TestPrefixInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, prefix := range []string{"2001:db8:1:2:3", "2001:db8::1", "gggg", "1:::"} {
+		_, err := Prefix(prefix)
+		require.ErrorIs(t, err, ErrInvalidPrefix, prefix)
+	}
+}
+
+// TestAddress tests the Address function, verifying that full addresses are
+// classified by their /64 prefix.
+func TestAddress(t *testing.T) {
+	t.Parallel()
+
+	got := Address(netip.MustParseAddr("fe80::214:22ff:fe01:2345"))
+	assert.Equal(t, TypeLinkLocal, got.Type)
+	assert.True(t, got.SLAAC)
+
+	got = Address(netip.MustParseAddr("2001:db8:85a3::214:22ff:fe01:2345"))
+	assert.Equal(t, TypeDocumentation, got.Type)
+	assert.Equal(t, WarningDocumentation, got.Warning)
+}
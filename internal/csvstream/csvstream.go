@@ -0,0 +1,68 @@
+// Package csvstream writes rows as CSV while they are produced, flushing the
+// output periodically so the rows reach the client of a streamed response
+// before the rest are computed. It is shared by the CSV outputs of the EUI-64
+// matrix and of bulk verification.
+package csvstream
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+)
+
+// FlushInterval is the number of rows written between flushes.
+const FlushInterval = 256
+
+// errFlush is returned when the output cannot be flushed.
+var errFlush = errors.New("flushing CSV output")
+
+// flusher is implemented by writers buffering their output, such as
+// *bufio.Writer.
+type flusher interface {
+	Flush() error
+}
+
+// Write writes the header row and then a record per row, formatted by record,
+// to w as CSV. The output is flushed every FlushInterval rows and at the end,
+// including w itself if it buffers.
+func Write[T any](w io.Writer, header []string, rows iter.Seq[T], record func(T) []string) error {
+	out := csv.NewWriter(w)
+	if err := out.Write(header); err != nil {
+		return fmt.Errorf("writing CSV header: %w", err)
+	}
+
+	written := 0
+
+	for row := range rows {
+		if err := out.Write(record(row)); err != nil {
+			return fmt.Errorf("writing CSV row: %w", err)
+		}
+
+		if written++; written%FlushInterval == 0 {
+			if err := flush(out, w); err != nil {
+				return err
+			}
+		}
+	}
+
+	return flush(out, w)
+}
+
+// flush flushes the CSV writer and, if it buffers, the writer underneath.
+func flush(out *csv.Writer, w io.Writer) error {
+	out.Flush()
+
+	if err := out.Error(); err != nil {
+		return fmt.Errorf("%w: %w", errFlush, err)
+	}
+
+	if buffered, ok := w.(flusher); ok {
+		if err := buffered.Flush(); err != nil {
+			return fmt.Errorf("%w: %w", errFlush, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package csvstream
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// errTestWrite is returned by failingWriter.
+var errTestWrite = errors.New("connection closed")
+
+// countingWriter buffers its output and counts how often it is flushed.
+type countingWriter struct {
+	strings.Builder
+
+	flushes int
+}
+
+// Flush counts the flush.
+func (w *countingWriter) Flush() error {
+	w.flushes++
+
+	return nil
+}
+
+// failingWriter fails every write.
+type failingWriter struct{}
+
+// Write returns errTestWrite.
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errTestWrite
+}
+
+// TestWrite verifies that the header and a record per row are written, and
+// that the writer underneath is flushed every FlushInterval rows and at the
+// end.
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	rows := make([]int, 2*FlushInterval+1)
+	for i := range rows {
+		rows[i] = i
+	}
+
+	var out countingWriter
+
+	err := Write(&out, []string{"n", "square"}, slices.Values(rows), func(n int) []string {
+		return []string{strconv.Itoa(n), strconv.Itoa(n * n)}
+	})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, len(rows)+1)
+	assert.Equal(t, "n,square", lines[0])
+	assert.Equal(t, "3,9", lines[4])
+	assert.Equal(t, 3, out.flushes)
+}
+
+// TestWriteError verifies that failures to write the output are returned.
+func TestWriteError(t *testing.T) {
+	t.Parallel()
+
+	err := Write(failingWriter{}, []string{"n"}, slices.Values([]int{1}), func(n int) []string {
+		return []string{strconv.Itoa(n)}
+	})
+	require.ErrorIs(t, err, errTestWrite)
+	require.ErrorIs(t, err, errFlush)
+}
@@ -0,0 +1,299 @@
+// Package duid builds and decodes DHCPv6 DHCP Unique Identifiers (DUIDs), see
+// RFC 8415, section 11, by which DHCPv6 servers key their reservations. It
+// builds the DUID-LL and DUID-LLT of a MAC address, and decodes DUID-LLT,
+// DUID-EN, DUID-LL, and DUID-UUID back to their components, recovering the MAC
+// address of those based on an Ethernet address.
+package duid
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/errcode"
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+)
+
+// Type is the type code starting a DUID.
+type Type uint16
+
+// DUID types, see RFC 8415, section 11.1, and RFC 6355.
+const (
+	TypeLLT  Type = 1 // TypeLLT is based on a link-layer address plus time.
+	TypeEN   Type = 2 // TypeEN is assigned by the vendor based on its enterprise number.
+	TypeLL   Type = 3 // TypeLL is based on a link-layer address.
+	TypeUUID Type = 4 // TypeUUID is based on a Universally Unique Identifier.
+)
+
+// HardwareEthernet is the IANA hardware type of Ethernet, the hardware type of
+// DUIDs based on a MAC address.
+const HardwareEthernet = 1
+
+// MaxLength is the maximum length of a DUID in bytes: its type code and up to
+// 128 bytes of identifier.
+const MaxLength = typeBytes + 128
+
+// Constants describing the layout of DUIDs.
+const (
+	typeBytes      = 2  // typeBytes is the size of the type code.
+	hardwareOffset = 2  // hardwareOffset is the offset of the hardware type in DUID-LLT and DUID-LL.
+	timeOffset     = 4  // timeOffset is the offset of the time in a DUID-LLT.
+	lltAddress     = 8  // lltAddress is the offset of the link-layer address in a DUID-LLT.
+	llAddress      = 4  // llAddress is the offset of the link-layer address in a DUID-LL.
+	enIdentifier   = 6  // enIdentifier is the offset of the identifier in a DUID-EN.
+	uuidLength     = 18 // uuidLength is the length of a DUID-UUID, its type code and a 16-byte UUID.
+	macBytes       = 6  // macBytes is the size of an Ethernet MAC address.
+	hardwareBits   = 16 // hardwareBits is the size of the hardware type in bits.
+	timeBits       = 32 // timeBits is the size of a DUID-LLT time in bits.
+)
+
+// separators are the characters DUIDs are written with between their bytes, as
+// in 00:03:00:01:00:14:22:01:23:45, and ignored when parsing them.
+const separators = ":- "
+
+// Epoch is the time DUID-LLT times count seconds from, midnight UTC, January
+// 1, 2000.
+var Epoch = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// uuidGroups are the sizes, in bytes, of the groups UUIDs are written in.
+var uuidGroups = []int{4, 2, 2, 2, 6}
+
+// Errors returned when DUIDs cannot be built or decoded.
+var (
+	ErrTime        = errcode.New("duid.time", "DUID-LLT time must be between 2000 and 2136")
+	ErrSyntax      = errcode.New("duid.syntax", "DUID must be hexadecimal bytes, optionally separated by colons, hyphens, or spaces")
+	ErrLength      = errcode.New("duid.length", "invalid DUID length for its type")
+	ErrTooLong     = errcode.New("duid.too_long", fmt.Sprintf("DUID exceeds %d bytes", MaxLength))
+	ErrUnknownType = errcode.New("duid.unknown_type", "unknown DUID type")
+	ErrHardware    = errcode.New("duid.hardware", "DUID hardware type must be a decimal number from 0 to 65535")
+	ErrTimeSyntax  = errcode.New("duid.time_syntax", "DUID-LLT time must be an RFC 3339 date and time or seconds since 2000")
+)
+
+// DUID is a decoded DUID. The fields other than Type are set for the types
+// noted.
+type DUID struct {
+	Type             Type
+	HardwareType     uint16    // HardwareType is the IANA hardware type of DUID-LLT and DUID-LL.
+	Time             time.Time // Time is when a DUID-LLT was generated, to the second.
+	LinkLayerAddress []byte    // LinkLayerAddress is the address of DUID-LLT and DUID-LL.
+	EnterpriseNumber uint32    // EnterpriseNumber is the IANA enterprise number of a DUID-EN's vendor.
+	Identifier       []byte    // Identifier is the vendor-assigned identifier of a DUID-EN.
+	UUID             string    // UUID is the UUID of a DUID-UUID, as 8-4-4-4-12 hexadecimal digits.
+}
+
+// MAC returns the link-layer address of a DUID-LLT or DUID-LL as six pairs of
+// lowercase hexadecimal digits separated by hyphens, as the calculator accepts
+// it, or an empty string for DUIDs not based on an Ethernet MAC address.
+func (d DUID) MAC() string {
+	if d.HardwareType != HardwareEthernet || len(d.LinkLayerAddress) != macBytes {
+		return ""
+	}
+
+	return strings.ReplaceAll(net.HardwareAddr(d.LinkLayerAddress).String(), ":", "-")
+}
+
+// LL builds the DUID-LL of a MAC address with the given hardware type, usually
+// HardwareEthernet.
+func LL(mac string, hardware uint16) ([]byte, error) {
+	address, err := parseMAC(mac)
+	if err != nil {
+		return nil, err
+	}
+
+	duid := binary.BigEndian.AppendUint16(nil, uint16(TypeLL))
+	duid = binary.BigEndian.AppendUint16(duid, hardware)
+
+	return append(duid, address...), nil
+}
+
+// LLT builds the DUID-LLT of a MAC address with the given hardware type,
+// usually HardwareEthernet, generated at the given time, which is truncated
+// to the second.
+func LLT(mac string, hardware uint16, generated time.Time) ([]byte, error) {
+	address, err := parseMAC(mac)
+	if err != nil {
+		return nil, err
+	}
+
+	seconds := generated.Unix() - Epoch.Unix()
+	if seconds < 0 || seconds > math.MaxUint32 {
+		return nil, fmt.Errorf("%w, got %s", ErrTime, generated.UTC().Format(time.RFC3339))
+	}
+
+	duid := binary.BigEndian.AppendUint16(nil, uint16(TypeLLT))
+	duid = binary.BigEndian.AppendUint16(duid, hardware)
+	duid = binary.BigEndian.AppendUint32(duid, uint32(seconds))
+
+	return append(duid, address...), nil
+}
+
+// ParseHardware parses the decimal IANA hardware type of a DUID-LLT or DUID-LL,
+// returning HardwareEthernet when it is blank.
+func ParseHardware(text string) (uint16, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return HardwareEthernet, nil
+	}
+
+	hardware, err := strconv.ParseUint(text, 10, hardwareBits)
+	if err != nil {
+		return 0, fmt.Errorf("%w, got %q", ErrHardware, text)
+	}
+
+	return uint16(hardware), nil
+}
+
+// ParseTime parses the time a DUID-LLT was generated, written as an RFC 3339
+// date and time, such as 2024-05-01T12:00:00Z, or as the decimal seconds since
+// Epoch a DUID-LLT holds, returning now when it is blank. Times outside the
+// range of a DUID-LLT are left for LLT to reject.
+func ParseTime(text string, now time.Time) (time.Time, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return now, nil
+	}
+
+	if seconds, err := strconv.ParseUint(text, 10, timeBits); err == nil {
+		return Epoch.Add(time.Duration(seconds) * time.Second), nil
+	}
+
+	generated, err := time.Parse(time.RFC3339, text)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w, got %q", ErrTimeSyntax, text)
+	}
+
+	return generated, nil
+}
+
+// parseMAC parses a MAC address as the calculator accepts it.
+func parseMAC(mac string) ([]byte, error) {
+	address, err := net.ParseMAC(mac)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", eui64.ErrParseMAC, err)
+	}
+
+	if len(address) != macBytes {
+		return nil, fmt.Errorf("%w, got %d", eui64.ErrInvalidMACLength, len(address))
+	}
+
+	return address, nil
+}
+
+// Format formats a DUID as pairs of lowercase hexadecimal digits separated by
+// colons, as DHCPv6 servers such as ISC Kea and dnsmasq write them.
+func Format(duid []byte) string {
+	pairs := make([]string, 0, len(duid))
+	for _, b := range duid {
+		pairs = append(pairs, hex.EncodeToString([]byte{b}))
+	}
+
+	return strings.Join(pairs, ":")
+}
+
+// Parse decodes a DUID written as hexadecimal digits, its bytes optionally
+// separated by colons, hyphens, or spaces, see Decode.
+func Parse(text string) (DUID, error) {
+	digits := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(separators, r) {
+			return -1
+		}
+
+		return r
+	}, strings.TrimSpace(text))
+
+	duid, err := hex.DecodeString(digits)
+	if err != nil || len(duid) == 0 {
+		return DUID{}, fmt.Errorf("%w: %q", ErrSyntax, text)
+	}
+
+	return Decode(duid)
+}
+
+// Decode decodes a DUID of a known type into its components.
+func Decode(duid []byte) (DUID, error) {
+	if len(duid) > MaxLength {
+		return DUID{}, fmt.Errorf("%w, got %d", ErrTooLong, len(duid))
+	}
+
+	if len(duid) < typeBytes {
+		return DUID{}, ErrLength
+	}
+
+	decoded := DUID{
+		Type:             Type(binary.BigEndian.Uint16(duid)),
+		HardwareType:     0,
+		Time:             time.Time{},
+		LinkLayerAddress: nil,
+		EnterpriseNumber: 0,
+		Identifier:       nil,
+		UUID:             "",
+	}
+
+	switch decoded.Type {
+	case TypeLLT:
+		if len(duid) <= lltAddress {
+			return DUID{}, fmt.Errorf("%w: DUID-LLT of %d bytes", ErrLength, len(duid))
+		}
+
+		decoded.HardwareType = binary.BigEndian.Uint16(duid[hardwareOffset:])
+		decoded.Time = Epoch.Add(time.Duration(binary.BigEndian.Uint32(duid[timeOffset:])) * time.Second)
+		decoded.LinkLayerAddress = duid[lltAddress:]
+	case TypeEN:
+		if len(duid) <= enIdentifier {
+			return DUID{}, fmt.Errorf("%w: DUID-EN of %d bytes", ErrLength, len(duid))
+		}
+
+		decoded.EnterpriseNumber = binary.BigEndian.Uint32(duid[typeBytes:])
+		decoded.Identifier = duid[enIdentifier:]
+	case TypeLL:
+		if len(duid) <= llAddress {
+			return DUID{}, fmt.Errorf("%w: DUID-LL of %d bytes", ErrLength, len(duid))
+		}
+
+		decoded.HardwareType = binary.BigEndian.Uint16(duid[hardwareOffset:])
+		decoded.LinkLayerAddress = duid[llAddress:]
+	case TypeUUID:
+		if len(duid) != uuidLength {
+			return DUID{}, fmt.Errorf("%w: DUID-UUID of %d bytes, expected %d", ErrLength, len(duid), uuidLength)
+		}
+
+		decoded.UUID = formatUUID(duid[typeBytes:])
+	default:
+		return DUID{}, fmt.Errorf("%w: %d", ErrUnknownType, decoded.Type)
+	}
+
+	return decoded, nil
+}
+
+// formatUUID formats a UUID as groups of 8, 4, 4, 4, and 12 lowercase
+// hexadecimal digits separated by hyphens.
+func formatUUID(uuid []byte) string {
+	groups := make([]string, 0, len(uuidGroups))
+	for _, size := range uuidGroups {
+		groups = append(groups, hex.EncodeToString(uuid[:size]))
+		uuid = uuid[size:]
+	}
+
+	return strings.Join(groups, "-")
+}
+
+// String returns the name of the type, such as DUID-LLT.
+func (t Type) String() string {
+	switch t {
+	case TypeLLT:
+		return "DUID-LLT"
+	case TypeEN:
+		return "DUID-EN"
+	case TypeLL:
+		return "DUID-LL"
+	case TypeUUID:
+		return "DUID-UUID"
+	default:
+		return fmt.Sprintf("DUID type %d", uint16(t))
+	}
+}
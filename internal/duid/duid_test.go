@@ -0,0 +1,259 @@
+package duid
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+)
+
+// generated is the time of the DUID-LLTs built by the tests.
+var generated = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// TestBuild tests that LL and LLT build the DUIDs of a MAC address, in any of
+// the notations the calculator accepts.
+func TestBuild(t *testing.T) {
+	t.Parallel()
+
+	for _, mac := range []string{"00-14-22-01-23-45", "00:14:22:01:23:45", "0014.2201.2345"} {
+		ll, err := LL(mac, HardwareEthernet)
+		require.NoError(t, err)
+		assert.Equal(t, "00:03:00:01:00:14:22:01:23:45", Format(ll))
+
+		llt, err := LLT(mac, HardwareEthernet, generated.Add(999*time.Millisecond))
+		require.NoError(t, err)
+		assert.Equal(t, "00:01:00:01:25:9e:9d:80:00:14:22:01:23:45", Format(llt))
+	}
+
+	ll, err := LL("00-14-22-01-23-45", 6)
+	require.NoError(t, err)
+	assert.Equal(t, "00:03:00:06:00:14:22:01:23:45", Format(ll), "Hardware type")
+}
+
+// TestBuildErrors tests that LL and LLT reject invalid MAC addresses and times
+// DUID-LLTs cannot hold.
+func TestBuildErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := LL("invalid", HardwareEthernet)
+	require.ErrorIs(t, err, eui64.ErrParseMAC)
+
+	_, err = LL("00-14-22-ff-fe-01-23-45", HardwareEthernet)
+	require.ErrorIs(t, err, eui64.ErrInvalidMACLength)
+
+	_, err = LLT("invalid", HardwareEthernet, generated)
+	require.ErrorIs(t, err, eui64.ErrParseMAC)
+
+	_, err = LLT("00-14-22-01-23-45", HardwareEthernet, Epoch.Add(-time.Second))
+	require.ErrorIs(t, err, ErrTime)
+
+	_, err = LLT("00-14-22-01-23-45", HardwareEthernet, Epoch.Add((1<<32)*time.Second))
+	require.ErrorIs(t, err, ErrTime)
+}
+
+// TestParseHardware tests that ParseHardware parses decimal hardware types,
+// defaulting to Ethernet when blank, and rejects anything else.
+func TestParseHardware(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		text    string
+		want    uint16
+		wantErr error
+	}{
+		{name: "Blank", text: " ", want: HardwareEthernet, wantErr: nil},
+		{name: "Ethernet", text: "1", want: HardwareEthernet, wantErr: nil},
+		{name: "IEEE 802", text: " 6 ", want: 6, wantErr: nil},
+		{name: "Largest", text: "65535", want: 65535, wantErr: nil},
+		{name: "Too large", text: "65536", want: 0, wantErr: ErrHardware},
+		{name: "Negative", text: "-1", want: 0, wantErr: ErrHardware},
+		{name: "Hexadecimal", text: "0x6", want: 0, wantErr: ErrHardware},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseHardware(tt.text)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestParseTime tests that ParseTime parses RFC 3339 times and seconds since
+// the epoch, defaulting to now when blank, and rejects anything else.
+func TestParseTime(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		text    string
+		want    time.Time
+		wantErr error
+	}{
+		{name: "Blank", text: "", want: now, wantErr: nil},
+		{name: "RFC 3339", text: "2020-01-01T00:00:00Z", want: generated, wantErr: nil},
+		{name: "RFC 3339 with offset", text: "2020-01-01T01:00:00+01:00", want: generated, wantErr: nil},
+		{name: "Seconds since 2000", text: " 631152000 ", want: generated, wantErr: nil},
+		{name: "Largest seconds", text: "4294967295", want: Epoch.Add(4294967295 * time.Second), wantErr: nil},
+		{name: "Date only", text: "2020-01-01", want: time.Time{}, wantErr: ErrTimeSyntax},
+		{name: "Too many seconds", text: "4294967296", want: time.Time{}, wantErr: ErrTimeSyntax},
+		{name: "Negative seconds", text: "-1", want: time.Time{}, wantErr: ErrTimeSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseTime(tt.text, now)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.True(t, tt.want.Equal(got), "got %s", got)
+		})
+	}
+}
+
+// TestParse tests that Parse decodes each type of DUID into its components,
+// recovering the MAC address of those based on an Ethernet address.
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		text    string
+		want    DUID
+		wantMAC string
+	}{
+		{
+			name: "DUID-LLT",
+			text: "00:01:00:01:25:9e:9d:80:00:14:22:01:23:45",
+			want: DUID{
+				Type:             TypeLLT,
+				HardwareType:     HardwareEthernet,
+				Time:             generated,
+				LinkLayerAddress: []byte{0x00, 0x14, 0x22, 0x01, 0x23, 0x45},
+				EnterpriseNumber: 0,
+				Identifier:       nil,
+				UUID:             "",
+			},
+			wantMAC: "00-14-22-01-23-45",
+		},
+		{
+			name: "DUID-EN",
+			text: "0002-0000-0009-0c0c-0001",
+			want: DUID{
+				Type:             TypeEN,
+				HardwareType:     0,
+				Time:             time.Time{},
+				LinkLayerAddress: nil,
+				EnterpriseNumber: 9,
+				Identifier:       []byte{0x0c, 0x0c, 0x00, 0x01},
+				UUID:             "",
+			},
+			wantMAC: "",
+		},
+		{
+			name: "DUID-LL",
+			text: " 00030001001422012345 ",
+			want: DUID{
+				Type:             TypeLL,
+				HardwareType:     HardwareEthernet,
+				Time:             time.Time{},
+				LinkLayerAddress: []byte{0x00, 0x14, 0x22, 0x01, 0x23, 0x45},
+				EnterpriseNumber: 0,
+				Identifier:       nil,
+				UUID:             "",
+			},
+			wantMAC: "00-14-22-01-23-45",
+		},
+		{
+			name: "DUID-LL of another hardware type",
+			text: "00 03 00 06 00 14 22 01 23 45",
+			want: DUID{
+				Type:             TypeLL,
+				HardwareType:     6,
+				Time:             time.Time{},
+				LinkLayerAddress: []byte{0x00, 0x14, 0x22, 0x01, 0x23, 0x45},
+				EnterpriseNumber: 0,
+				Identifier:       nil,
+				UUID:             "",
+			},
+			wantMAC: "",
+		},
+		{
+			name: "DUID-UUID",
+			text: "00:04:4C:4C:45:44:00:51:50:10:80:4A:B4:C0:4F:4E:4B:32",
+			want: DUID{
+				Type:             TypeUUID,
+				HardwareType:     0,
+				Time:             time.Time{},
+				LinkLayerAddress: nil,
+				EnterpriseNumber: 0,
+				Identifier:       nil,
+				UUID:             "4c4c4544-0051-5010-804a-b4c04f4e4b32",
+			},
+			wantMAC: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			decoded, err := Parse(tt.text)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, decoded)
+			assert.Equal(t, tt.wantMAC, decoded.MAC())
+		})
+	}
+}
+
+// TestParseInvalid tests that Parse rejects malformed DUIDs, DUIDs too short or
+// long for their type, and DUIDs of unknown types.
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		text    string
+		wantErr error
+	}{
+		{name: "Empty", text: " ", wantErr: ErrSyntax},
+		{name: "Not hexadecimal", text: "00:03:00:01:00:14:22:01:23:4g", wantErr: ErrSyntax},
+		{name: "Odd digits", text: "0003000", wantErr: ErrSyntax},
+		{name: "Type only", text: "00", wantErr: ErrLength},
+		{name: "DUID-LLT without address", text: "00:01:00:01:25:9e:9d:80", wantErr: ErrLength},
+		{name: "DUID-EN without identifier", text: "00:02:00:00:00:09", wantErr: ErrLength},
+		{name: "DUID-LL without address", text: "00:03:00:01", wantErr: ErrLength},
+		{name: "Short DUID-UUID", text: "00:04:4c:4c:45:44", wantErr: ErrLength},
+		{name: "Unknown type", text: "00:05:00:01", wantErr: ErrUnknownType},
+		{name: "Too long", text: "0002" + strings.Repeat("00", MaxLength), wantErr: ErrTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(tt.text)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+// TestTypeString tests that types are named as RFC 8415 names them.
+func TestTypeString(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "DUID-LLT", TypeLLT.String())
+	assert.Equal(t, "DUID-EN", TypeEN.String())
+	assert.Equal(t, "DUID-LL", TypeLL.String())
+	assert.Equal(t, "DUID-UUID", TypeUUID.String())
+	assert.Equal(t, "DUID type 9", Type(9).String())
+}
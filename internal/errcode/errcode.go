@@ -0,0 +1,72 @@
+// Package errcode identifies the errors of the EUI-64 calculator's packages by
+// stable, machine-readable codes, so they can be explained to users, such as by
+// the message catalogs of package i18n, without depending on the packages
+// returning them. Sentinel errors are created with New, and errors adding
+// details to them, such as the offending part of the input, implement Located
+// or Detailed.
+package errcode
+
+import "errors"
+
+// Code is a stable, machine-readable identifier of an error, such as
+// "mac.required" or "subnet.too_many_subnets". Codes are namespaced by the
+// input or package they belong to, and may be relied on by clients.
+type Code string
+
+// Error is a sentinel error identified by a Code.
+type Error struct {
+	code    Code
+	message string
+}
+
+// Coded is implemented by errors identified by a Code, such as *Error.
+type Coded interface {
+	error
+	// ErrorCode returns the code identifying the error.
+	ErrorCode() Code
+}
+
+// Located is implemented by errors locating the offending part of their input,
+// such as the validators' errors.
+type Located interface {
+	error
+	// Location returns the offending part of the input, its 1-based position,
+	// and the 1-based number of the IPv6 prefix hextet holding it, or 0. The
+	// part is empty if the error does not locate it.
+	Location() (value string, position, hextet int)
+}
+
+// Detailed is implemented by errors whose explanation takes arguments, such as
+// a limit the input exceeds.
+type Detailed interface {
+	error
+	// ErrorArgs returns the arguments of the error's explanation.
+	ErrorArgs() []any
+}
+
+// New returns a sentinel error with the given code and message.
+func New(code Code, message string) *Error {
+	return &Error{code: code, message: message}
+}
+
+// Error returns the error's message.
+func (e *Error) Error() string {
+	return e.message
+}
+
+// ErrorCode returns the code identifying the error.
+func (e *Error) ErrorCode() Code {
+	return e.code
+}
+
+// Of returns the code of the first error in err's tree identified by one, see
+// errors.As, and whether there is one. Errors wrapping a sentinel error to add
+// context are thus identified by the sentinel's code.
+func Of(err error) (Code, bool) {
+	var coded Coded
+	if !errors.As(err, &coded) {
+		return "", false
+	}
+
+	return coded.ErrorCode(), true
+}
@@ -0,0 +1,51 @@
+package errcode
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// errTest is a sentinel error used by the tests.
+var errTest = New("test.sentinel", "test sentinel")
+
+// TestNew verifies that sentinel errors carry their code and message.
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "test sentinel", errTest.Error())
+	assert.Equal(t, Code("test.sentinel"), errTest.ErrorCode())
+}
+
+// TestOf verifies that the code of the first coded error in an error's tree is
+// found, and that errors without one have none.
+func TestOf(t *testing.T) {
+	t.Parallel()
+
+	outer := New("test.outer", "outer sentinel")
+
+	tests := []struct {
+		name   string
+		err    error
+		want   Code
+		wantOK bool
+	}{
+		{name: "Sentinel", err: errTest, want: "test.sentinel", wantOK: true},
+		{name: "Wrapped sentinel", err: fmt.Errorf("context: %w", errTest), want: "test.sentinel", wantOK: true},
+		{name: "Outer sentinel first", err: fmt.Errorf("%w: %w", outer, errTest), want: "test.outer", wantOK: true},
+		{name: "Uncoded error", err: errors.New("boom"), want: "", wantOK: false},
+		{name: "No error", err: nil, want: "", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			code, ok := Of(tt.err)
+			assert.Equal(t, tt.want, code)
+			assert.Equal(t, tt.wantOK, ok)
+		})
+	}
+}
@@ -1,14 +1,17 @@
 // Package eui64 provides functionality for calculating EUI-64 interface identifiers and full IPv6 addresses from MAC addresses and prefixes.
 // It includes the Calculator interface and a default implementation using the standard EUI-64 algorithm,
 // along with helper functions for parsing, conversion, and string formatting of IPv6 addresses.
+// It also derives the interface identifiers of IEEE 802.15.4 and Bluetooth LE addresses, and completes prefixes
+// with manually assigned interface ID tokens, see LinkType.
 package eui64
 
 import (
-	"errors"
 	"fmt"
 	"net"
 	"strconv"
 	"strings"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/errcode"
 )
 
 // Calculator defines the interface for computing EUI-64 identifiers and IPv6 addresses.
@@ -40,10 +43,11 @@ const (
 
 // Static error variables.
 var (
-	ErrParseMAC             = errors.New("parsing MAC address")
-	ErrInvalidMACLength     = fmt.Errorf("MAC address must be %d bytes", macBytes)
-	ErrPrefixExceedsHextets = fmt.Errorf("IPv6 prefix exceeds %d hextets", prefixMaxHextets)
-	ErrInvalidEmptyHextet   = errors.New("invalid empty hextet in IPv6 prefix")
+	ErrParseMAC             = errcode.New("eui64.mac_malformed", "parsing MAC address")
+	ErrInvalidMACLength     = errcode.New("eui64.mac_length", fmt.Sprintf("MAC address must be %d bytes", macBytes))
+	ErrPrefixExceedsHextets = errcode.New("eui64.prefix_too_many_hextets", fmt.Sprintf("IPv6 prefix exceeds %d hextets", prefixMaxHextets))
+	ErrInvalidEmptyHextet   = errcode.New("eui64.prefix_empty_hextet", "invalid empty hextet in IPv6 prefix")
+	ErrInvalidHextet        = errcode.New("eui64.prefix_invalid_hextet", "invalid hextet")
 )
 
 // CalculateEUI64 computes the EUI-64 interface ID and full IPv6 address from a MAC address and prefix.
@@ -56,22 +60,49 @@ func (d *DefaultCalculator) CalculateEUI64(mac, prefix string) (string, string,
 // It converts the MAC address to an EUI-64 identifier by inserting the FFFE marker and flipping the local/global bit,
 // then constructs an IPv6 address if a prefix is provided. Returns the interface ID, full IPv6 address, and any error.
 func CalculateEUI64(macStr, prefixStr string) (string, string, error) {
+	eui64, err := macInterfaceID(macStr)
+	if err != nil {
+		return "", "", err
+	}
+
+	return interfaceAddress(eui64, prefixStr)
+}
+
+// macInterfaceID returns the modified EUI-64 interface identifier of a MAC
+// address: the MAC address with the FFFE marker inserted in its middle and the
+// local/global bit flipped.
+func macInterfaceID(macStr string) ([]byte, error) {
 	mac, err := net.ParseMAC(macStr)
 	if err != nil {
-		return "", "", fmt.Errorf("%w: %w", ErrParseMAC, err)
+		return nil, fmt.Errorf("%w: %w", ErrParseMAC, err)
 	}
 
 	if len(mac) != macBytes {
-		return "", "", fmt.Errorf("%w, got %d", ErrInvalidMACLength, len(mac))
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidMACLength, len(mac))
 	}
 
+	eui64 := insertFFFE(mac)
+	eui64[0] ^= 0x02 // Flip the local/global bit (7th bit) for EUI-64.
+
+	return eui64, nil
+}
+
+// insertFFFE returns the 8-byte identifier formed from a 6-byte address by
+// inserting the FFFE marker between its third and fourth bytes.
+func insertFFFE(address []byte) []byte {
 	eui64 := make([]byte, eui64Bytes)
-	copy(eui64[0:3], mac[0:3])
+	copy(eui64[0:3], address[0:3])
 	eui64[3] = fffeMarkerLow
 	eui64[4] = fffeMarkerHigh
-	copy(eui64[5:], mac[3:])
-	eui64[0] ^= 0x02 // Flip the local/global bit (7th bit) for EUI-64.
+	copy(eui64[5:], address[3:])
+
+	return eui64
+}
 
+// interfaceAddress formats an 8-byte interface identifier as four hextets and,
+// if a prefix is provided, constructs the full IPv6 address of the identifier
+// in the prefix. Returns the interface ID, full IPv6 address, and any error.
+func interfaceAddress(eui64 []byte, prefixStr string) (string, string, error) {
 	interfaceID := fmt.Sprintf("%02x%02x:%02x%02x:%02x%02x:%02x%02x",
 		eui64[0], eui64[1], eui64[2], eui64[3],
 		eui64[4], eui64[5], eui64[6], eui64[7])
@@ -99,7 +130,8 @@ func CalculateEUI64(macStr, prefixStr string) (string, string, error) {
 		case i < len(prefixParts) && prefixParts[i] != "":
 			if _, err := fmt.Sscanf(prefixParts[i], "%x", &ip6[i]); err != nil {
 				return "", "", fmt.Errorf(
-					"invalid hextet %q in IPv6 prefix: %w",
+					"%w %q in IPv6 prefix: %w",
+					ErrInvalidHextet,
 					prefixParts[i],
 					err,
 				)
@@ -0,0 +1,263 @@
+package eui64
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/errcode"
+)
+
+// LinkType is the kind of link-layer address an interface identifier is
+// derived from.
+type LinkType string
+
+// Link types interface identifiers can be derived from.
+const (
+	// LinkEthernet derives the modified EUI-64 of a 48-bit MAC address, see
+	// RFC 4291, appendix A.
+	LinkEthernet LinkType = "ethernet"
+	// LinkIEEE802154Short derives the identifier of the 16-bit short address of
+	// an IEEE 802.15.4 (6LoWPAN, Thread) node, 0000:00ff:fe00:XXXX, see RFC 4944,
+	// section 6, and RFC 6282, section 3.2.2.
+	LinkIEEE802154Short LinkType = "ieee802154-short"
+	// LinkIEEE802154Extended derives the identifier of the 64-bit extended
+	// address of an IEEE 802.15.4 node by flipping its universal/local bit, see
+	// RFC 4944, section 6.
+	LinkIEEE802154Extended LinkType = "ieee802154-extended"
+	// LinkBLE derives the identifier of a 48-bit Bluetooth Low Energy device
+	// address by inserting the FFFE marker, its universal/local bit set to 0, see
+	// RFC 7668, section 3.2.2.
+	LinkBLE LinkType = "ble"
+	// LinkToken takes the interface identifier itself rather than deriving it
+	// from a link-layer address: a token as set with Linux's ip token or
+	// assigned statically, such as ::1:2, see tokenInterfaceID.
+	LinkToken LinkType = "token"
+)
+
+// LinkTypes lists the link types in the order they are offered, with
+// LinkEthernet first.
+var LinkTypes = []LinkType{LinkEthernet, LinkIEEE802154Short, LinkIEEE802154Extended, LinkBLE, LinkToken}
+
+// Constants describing the link-layer addresses of the link types other than
+// Ethernet, and interface ID tokens.
+const (
+	shortAddressBits     = 16   // shortAddressBits is the size of an IEEE 802.15.4 short address.
+	shortAddressPrefix   = "0x" // shortAddressPrefix optionally starts a short address, as in 0x1a2b.
+	shortAddressDigits   = 4    // shortAddressDigits is the maximum number of digits of a short address.
+	extendedAddressBytes = 8    // extendedAddressBytes is the size of an IEEE 802.15.4 extended address.
+	bleAddressBytes      = 6    // bleAddressBytes is the size of a Bluetooth device address.
+	shortIIDMarker       = 0xFF // shortIIDMarker is the byte preceding the FE00 of 0000:00ff:fe00:XXXX.
+	universalLocalBit    = 0x02 // universalLocalBit is the universal/local bit of the first byte.
+	tokenCompression     = "::" // tokenCompression stands for the zero hextets a token omits.
+	hextetBits           = 16   // hextetBits is the size of a hextet.
+	hextetDigits         = 4    // hextetDigits is the maximum number of digits of a hextet.
+)
+
+// Errors returned when link types or their addresses cannot be parsed.
+var (
+	ErrUnknownLinkType      = errcode.New("link_type.unknown", "unknown link type")
+	ErrParseShortAddress    = errcode.New("eui64.short_address_malformed", "IEEE 802.15.4 short address must be 1 to 4 hexadecimal digits")
+	ErrParseExtendedAddress = errcode.New("eui64.extended_address_malformed", fmt.Sprintf("IEEE 802.15.4 extended address must be %d bytes", extendedAddressBytes))
+	ErrParseBLEAddress      = errcode.New("eui64.ble_address_malformed", fmt.Sprintf("Bluetooth device address must be %d bytes", bleAddressBytes))
+	ErrParseToken           = errcode.New("eui64.token_malformed", fmt.Sprintf("interface ID token must be up to %d hextets", prefixMaxHextets))
+	ErrZeroToken            = errcode.New("eui64.token_zero", "interface ID token must not be zero, which forms the Subnet-Router anycast address")
+)
+
+// ParseLinkType returns the link type of the given name, LinkEthernet if the
+// name is empty.
+func ParseLinkType(name string) (LinkType, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return LinkEthernet, nil
+	}
+
+	for _, link := range LinkTypes {
+		if string(link) == name {
+			return link, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %q", ErrUnknownLinkType, name)
+}
+
+// CalculateLinkAddress computes the interface ID and full IPv6 address of a
+// link-layer address of the given type and an optional IPv6 prefix, as
+// CalculateEUI64 does for MAC addresses, which LinkEthernet delegates to.
+func CalculateLinkAddress(link LinkType, address, prefixStr string) (string, string, error) {
+	interfaceID, err := InterfaceID(link, address)
+	if err != nil {
+		return "", "", err
+	}
+
+	return interfaceAddress(interfaceID, prefixStr)
+}
+
+// CalculateLinkAddress computes the interface ID and full IPv6 address of a
+// link-layer address of the given type and prefix. It delegates to the
+// standalone CalculateLinkAddress function.
+func (d *DefaultCalculator) CalculateLinkAddress(link LinkType, address, prefix string) (string, string, error) {
+	return CalculateLinkAddress(link, address, prefix)
+}
+
+// InterfaceID returns the 8-byte interface identifier derived from a
+// link-layer address of the given type.
+func InterfaceID(link LinkType, address string) ([]byte, error) {
+	address = strings.TrimSpace(address)
+
+	switch link {
+	case LinkEthernet:
+		return macInterfaceID(address)
+	case LinkIEEE802154Short:
+		return shortInterfaceID(address)
+	case LinkIEEE802154Extended:
+		return extendedInterfaceID(address)
+	case LinkBLE:
+		return bleInterfaceID(address)
+	case LinkToken:
+		return tokenInterfaceID(address)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownLinkType, link)
+	}
+}
+
+// shortInterfaceID returns the interface identifier 0000:00ff:fe00:XXXX of a
+// 16-bit short address written as up to four hexadecimal digits, optionally
+// prefixed with 0x.
+func shortInterfaceID(address string) ([]byte, error) {
+	digits := strings.TrimPrefix(strings.ToLower(address), shortAddressPrefix)
+	if len(digits) > shortAddressDigits {
+		return nil, fmt.Errorf("%w, got %q", ErrParseShortAddress, address)
+	}
+
+	short, err := strconv.ParseUint(digits, 16, shortAddressBits)
+	if err != nil {
+		return nil, fmt.Errorf("%w, got %q", ErrParseShortAddress, address)
+	}
+
+	interfaceID := make([]byte, eui64Bytes)
+	interfaceID[3] = shortIIDMarker
+	interfaceID[4] = fffeMarkerHigh
+	binary.BigEndian.PutUint16(interfaceID[6:], uint16(short))
+
+	return interfaceID, nil
+}
+
+// extendedInterfaceID returns the interface identifier of a 64-bit extended
+// address, written in any notation net.ParseMAC accepts or as 16 hexadecimal
+// digits, with its universal/local bit flipped.
+func extendedInterfaceID(address string) ([]byte, error) {
+	extended, err := hex.DecodeString(address)
+	if err != nil {
+		extended, err = net.ParseMAC(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrParseExtendedAddress, err)
+		}
+	}
+
+	if len(extended) != extendedAddressBytes {
+		return nil, fmt.Errorf("%w, got %d", ErrParseExtendedAddress, len(extended))
+	}
+
+	interfaceID := make([]byte, eui64Bytes)
+	copy(interfaceID, extended)
+	interfaceID[0] ^= universalLocalBit
+
+	return interfaceID, nil
+}
+
+// bleInterfaceID returns the interface identifier of a 48-bit Bluetooth device
+// address: the address with the FFFE marker inserted and its universal/local
+// bit set to 0.
+func bleInterfaceID(address string) ([]byte, error) {
+	ble, err := net.ParseMAC(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrParseBLEAddress, err)
+	}
+
+	if len(ble) != bleAddressBytes {
+		return nil, fmt.Errorf("%w, got %d", ErrParseBLEAddress, len(ble))
+	}
+
+	interfaceID := insertFFFE(ble)
+	interfaceID[0] &^= universalLocalBit
+
+	return interfaceID, nil
+}
+
+// tokenInterfaceID returns the interface identifier written as a token of up
+// to four hextets. Like the interface ID of an IPv6 address, a token is
+// right-aligned, so 1:2 and ::1:2 are both 0000:0000:0001:0002, and "::" may
+// stand for the zero hextets anywhere in it, as in 1::2. Zero tokens, such as
+// ::, are rejected, as the zero interface ID forms the Subnet-Router anycast
+// address of the prefix (RFC 4291, section 2.6.1), not a host's.
+func tokenInterfaceID(token string) ([]byte, error) {
+	if strings.Count(token, tokenCompression) > 1 {
+		return nil, fmt.Errorf("%w, got %q", ErrParseToken, token)
+	}
+
+	head, tail, compressed := strings.Cut(token, tokenCompression)
+	if !compressed {
+		head, tail = "", token
+	}
+
+	headHextets, err := parseTokenHextets(head)
+	if err != nil {
+		return nil, fmt.Errorf("%w, got %q: %w", ErrParseToken, token, err)
+	}
+
+	tailHextets, err := parseTokenHextets(tail)
+	if err != nil {
+		return nil, fmt.Errorf("%w, got %q: %w", ErrParseToken, token, err)
+	}
+
+	hextets := len(headHextets) + len(tailHextets)
+	if hextets > prefixMaxHextets || (compressed && hextets == prefixMaxHextets) || (!compressed && hextets == 0) {
+		return nil, fmt.Errorf("%w, got %q", ErrParseToken, token)
+	}
+
+	interfaceID := make([]byte, eui64Bytes)
+
+	for i, hextet := range headHextets {
+		binary.BigEndian.PutUint16(interfaceID[2*i:], hextet)
+	}
+
+	for i, hextet := range tailHextets {
+		binary.BigEndian.PutUint16(interfaceID[eui64Bytes-2*(len(tailHextets)-i):], hextet)
+	}
+
+	if binary.BigEndian.Uint64(interfaceID) == 0 {
+		return nil, fmt.Errorf("%w, got %q", ErrZeroToken, token)
+	}
+
+	return interfaceID, nil
+}
+
+// parseTokenHextets parses the colon-separated hextets of up to four digits of
+// one side of a token's "::", none if the side is empty.
+func parseTokenHextets(side string) ([]uint16, error) {
+	if side == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(side, ":")
+	hextets := make([]uint16, 0, len(parts))
+
+	for _, part := range parts {
+		if len(part) > hextetDigits {
+			return nil, fmt.Errorf("hextet %q exceeds %d digits", part, hextetDigits)
+		}
+
+		hextet, err := strconv.ParseUint(part, 16, hextetBits)
+		if err != nil {
+			return nil, fmt.Errorf("hextet %q: %w", part, err)
+		}
+
+		hextets = append(hextets, uint16(hextet))
+	}
+
+	return hextets, nil
+}
@@ -0,0 +1,200 @@
+package eui64
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCalculateLinkAddress tests that CalculateLinkAddress derives the interface
+// ID of each link type's addresses, in the notations they are written in, and
+// completes the prefix with it like CalculateEUI64 does.
+func TestCalculateLinkAddress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		link            LinkType
+		address         string
+		prefix          string
+		wantInterfaceID string
+		wantFullIP      string
+	}{
+		{
+			name:            "Ethernet",
+			link:            LinkEthernet,
+			address:         "00-14-22-01-23-45",
+			prefix:          "2001:db8::",
+			wantInterfaceID: "0214:22ff:fe01:2345",
+			wantFullIP:      "2001:db8::214:22ff:fe01:2345",
+		},
+		{
+			name:            "802.15.4 short address",
+			link:            LinkIEEE802154Short,
+			address:         "0x1A2B",
+			prefix:          "fe80::",
+			wantInterfaceID: "0000:00ff:fe00:1a2b",
+			wantFullIP:      "fe80::ff:fe00:1a2b",
+		},
+		{
+			name:            "802.15.4 short address without 0x",
+			link:            LinkIEEE802154Short,
+			address:         " 1 ",
+			prefix:          "",
+			wantInterfaceID: "0000:00ff:fe00:0001",
+			wantFullIP:      "",
+		},
+		{
+			name:            "802.15.4 extended address",
+			link:            LinkIEEE802154Extended,
+			address:         "00:12:4b:00:01:02:03:04",
+			prefix:          "2001:db8::",
+			wantInterfaceID: "0212:4b00:0102:0304",
+			wantFullIP:      "2001:db8::212:4b00:102:304",
+		},
+		{
+			name:            "802.15.4 extended address as digits",
+			link:            LinkIEEE802154Extended,
+			address:         "02124B0001020304",
+			prefix:          "",
+			wantInterfaceID: "0012:4b00:0102:0304",
+			wantFullIP:      "",
+		},
+		{
+			name:            "BLE device address",
+			link:            LinkBLE,
+			address:         "00:14:22:01:23:45",
+			prefix:          "fe80::",
+			wantInterfaceID: "0014:22ff:fe01:2345",
+			wantFullIP:      "fe80::14:22ff:fe01:2345",
+		},
+		{
+			name:            "BLE device address with the universal/local bit set",
+			link:            LinkBLE,
+			address:         "c2-14-22-01-23-45",
+			prefix:          "",
+			wantInterfaceID: "c014:22ff:fe01:2345",
+			wantFullIP:      "",
+		},
+		{
+			name:            "Token as set with ip token",
+			link:            LinkToken,
+			address:         "::1:2",
+			prefix:          "2001:db8::",
+			wantInterfaceID: "0000:0000:0001:0002",
+			wantFullIP:      "2001:db8::1:2",
+		},
+		{
+			name:            "Token without ::",
+			link:            LinkToken,
+			address:         "1:2",
+			prefix:          "2001:db8::",
+			wantInterfaceID: "0000:0000:0001:0002",
+			wantFullIP:      "2001:db8::1:2",
+		},
+		{
+			name:            "Token compressed in the middle",
+			link:            LinkToken,
+			address:         "1::2",
+			prefix:          "2001:db8:0:1",
+			wantInterfaceID: "0001:0000:0000:0002",
+			wantFullIP:      "2001:db8:0:1:1::2",
+		},
+		{
+			name:            "Token of four hextets",
+			link:            LinkToken,
+			address:         "DEAD:beef:0:1",
+			prefix:          "",
+			wantInterfaceID: "dead:beef:0000:0001",
+			wantFullIP:      "",
+		},
+		{
+			name:            "Token compressed at the end",
+			link:            LinkToken,
+			address:         "a::",
+			prefix:          "fe80::",
+			wantInterfaceID: "000a:0000:0000:0000",
+			wantFullIP:      "fe80::a:0:0:0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			interfaceID, fullIP, err := CalculateLinkAddress(tt.link, tt.address, tt.prefix)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantInterfaceID, interfaceID)
+			assert.Equal(t, tt.wantFullIP, fullIP)
+
+			interfaceID, fullIP, err = (&DefaultCalculator{}).CalculateLinkAddress(tt.link, tt.address, tt.prefix)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantInterfaceID, interfaceID, "DefaultCalculator")
+			assert.Equal(t, tt.wantFullIP, fullIP, "DefaultCalculator")
+		})
+	}
+}
+
+// TestCalculateLinkAddressInvalid tests that CalculateLinkAddress rejects
+// addresses of the wrong size or notation for their link type, and unknown
+// link types.
+func TestCalculateLinkAddressInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		link    LinkType
+		address string
+		wantErr error
+	}{
+		{name: "Empty short address", link: LinkIEEE802154Short, address: "0x", wantErr: ErrParseShortAddress},
+		{name: "Short address too large", link: LinkIEEE802154Short, address: "1a2b3", wantErr: ErrParseShortAddress},
+		{name: "Short address of 5 digits", link: LinkIEEE802154Short, address: "00000", wantErr: ErrParseShortAddress},
+		{name: "Short address not hexadecimal", link: LinkIEEE802154Short, address: "12g4", wantErr: ErrParseShortAddress},
+		{name: "Extended address of 6 bytes", link: LinkIEEE802154Extended, address: "00:14:22:01:23:45", wantErr: ErrParseExtendedAddress},
+		{name: "Malformed extended address", link: LinkIEEE802154Extended, address: "00124b00010203", wantErr: ErrParseExtendedAddress},
+		{name: "BLE address of 8 bytes", link: LinkBLE, address: "00:12:4b:00:01:02:03:04", wantErr: ErrParseBLEAddress},
+		{name: "Malformed BLE address", link: LinkBLE, address: "invalid", wantErr: ErrParseBLEAddress},
+		{name: "Malformed MAC address", link: LinkEthernet, address: "invalid", wantErr: ErrParseMAC},
+		{name: "Empty token", link: LinkToken, address: "", wantErr: ErrParseToken},
+		{name: "Token of five hextets", link: LinkToken, address: "1:2:3:4:5", wantErr: ErrParseToken},
+		{name: "Compressed token of four hextets", link: LinkToken, address: "1:2::3:4", wantErr: ErrParseToken},
+		{name: "Token compressed twice", link: LinkToken, address: "1::2::3", wantErr: ErrParseToken},
+		{name: "Token with an empty hextet", link: LinkToken, address: ":1", wantErr: ErrParseToken},
+		{name: "Token not hexadecimal", link: LinkToken, address: "::1:g", wantErr: ErrParseToken},
+		{name: "Zero token", link: LinkToken, address: "::", wantErr: ErrZeroToken},
+		{name: "Zero token without ::", link: LinkToken, address: "0", wantErr: ErrZeroToken},
+		{name: "Token hextet of 5 digits", link: LinkToken, address: "00000", wantErr: ErrParseToken},
+		{name: "Token of four hextets, one of 5 digits", link: LinkToken, address: "0001:0000:0000:00001", wantErr: ErrParseToken},
+		{name: "Unknown link type", link: "token-ring", address: "00-14-22-01-23-45", wantErr: ErrUnknownLinkType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := CalculateLinkAddress(tt.link, tt.address, "")
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+// TestParseLinkType tests that ParseLinkType accepts the names of the link
+// types, defaulting to Ethernet, and rejects others.
+func TestParseLinkType(t *testing.T) {
+	t.Parallel()
+
+	for _, link := range LinkTypes {
+		parsed, err := ParseLinkType(string(link))
+		require.NoError(t, err)
+		assert.Equal(t, link, parsed)
+	}
+
+	parsed, err := ParseLinkType(" ")
+	require.NoError(t, err)
+	assert.Equal(t, LinkEthernet, parsed)
+
+	_, err = ParseLinkType("token-ring")
+	require.ErrorIs(t, err, ErrUnknownLinkType)
+}
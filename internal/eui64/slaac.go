@@ -0,0 +1,337 @@
+package eui64
+
+import (
+	"bufio"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/errcode"
+)
+
+// Router Advertisement lifetimes, in seconds.
+const (
+	// InfiniteLifetime is the lifetime of prefixes that never expire.
+	InfiniteLifetime = 0xffffffff
+	// DefaultValidLifetime is the valid lifetime routers advertise by default, 30 days.
+	DefaultValidLifetime = 2592000
+	// DefaultPreferredLifetime is the preferred lifetime routers advertise by default, 7 days.
+	DefaultPreferredLifetime = 604800
+)
+
+// MaxPrefixInformation is the maximum number of Prefix Information options
+// simulated at once, far more than a Router Advertisement on a typical link
+// carries.
+const MaxPrefixInformation = 64
+
+// Constants describing Prefix Information options as parsed by
+// ParsePrefixInformation.
+const (
+	slaacPrefixLength = 64         // slaacPrefixLength is the length of the prefixes EUI-64 interface IDs complete.
+	infiniteText      = "infinite" // infiniteText spells InfiniteLifetime.
+	noFlags           = "-"        // noFlags spells a Prefix Information option with neither flag set.
+	flagOnLink        = 'L'        // flagOnLink spells the on-link flag.
+	flagAutonomous    = 'A'        // flagAutonomous spells the autonomous address-configuration flag.
+	maxPIOFields      = 4          // maxPIOFields is the number of fields of a line: prefix, flags, and the two lifetimes.
+	lifetimeBits      = 32         // lifetimeBits is the size of the lifetime fields of Prefix Information options.
+	defaultFlags      = "LA"       // defaultFlags are the flags of lines giving only a prefix.
+	linkLocalPrefix   = "fe80::"   // linkLocalPrefix is the prefix of link-local addresses, as CalculateEUI64 takes it.
+)
+
+// Errors returned when Prefix Information options cannot be parsed.
+var (
+	ErrPrefixInfoRequired = errcode.New("ra.required", "at least one Prefix Information option is expected")
+	ErrPrefixInfoPrefix   = errcode.New("ra.prefix", "invalid IPv6 prefix in Prefix Information option")
+	ErrPrefixInfoFlags    = errcode.New("ra.flags", "invalid Prefix Information flags, expected L, A, LA, or -")
+	ErrPrefixInfoLifetime = errcode.New("ra.lifetime", "invalid lifetime, expected seconds or infinite")
+	ErrPrefixInfoFields   = errcode.New("ra.fields", fmt.Sprintf("Prefix Information options have at most %d fields", maxPIOFields))
+	ErrTooManyPrefixInfo  = errcode.New("ra.too_many", fmt.Sprintf("exceeds %d Prefix Information options", MaxPrefixInformation))
+)
+
+// LineError is returned when a line of Prefix Information options cannot be
+// parsed, numbering the line.
+type LineError struct {
+	Line int   // Line is the 1-based number of the offending line.
+	Err  error // Err explains why the line cannot be parsed.
+}
+
+// Error returns the message of the underlying error, with the line number.
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// PrefixInformation is a Prefix Information option of a Router Advertisement,
+// see RFC 4861, section 4.6.2.
+type PrefixInformation struct {
+	Prefix            netip.Prefix
+	OnLink            bool   // OnLink is the L flag: the prefix can be used for on-link determination.
+	Autonomous        bool   // Autonomous is the A flag: the prefix can be used for SLAAC.
+	ValidLifetime     uint32 // ValidLifetime is in seconds, InfiniteLifetime if it never expires.
+	PreferredLifetime uint32 // PreferredLifetime is in seconds, InfiniteLifetime if it never expires.
+}
+
+// Flags returns the flags of the option as ParsePrefixInformation parses them:
+// "L", "A", "LA", or "-" for neither.
+func (p PrefixInformation) Flags() string {
+	var flags string
+
+	if p.OnLink {
+		flags += string(flagOnLink)
+	}
+
+	if p.Autonomous {
+		flags += string(flagAutonomous)
+	}
+
+	if flags == "" {
+		return noFlags
+	}
+
+	return flags
+}
+
+// SLAACStatus is the outcome of stateless address autoconfiguration from a
+// Prefix Information option.
+type SLAACStatus string
+
+// Outcomes of SLAAC from a Prefix Information option, in the order a host
+// checks them, see RFC 4862, section 5.5.3.
+const (
+	// SLAACFormed is set when the host forms an address in the prefix.
+	SLAACFormed SLAACStatus = "formed"
+	// SLAACNotAutonomous is set when the A flag is clear.
+	SLAACNotAutonomous SLAACStatus = "not_autonomous"
+	// SLAACLinkLocal is set when the prefix is link-local, fe80::/10.
+	SLAACLinkLocal SLAACStatus = "link_local"
+	// SLAACMulticast is set when the prefix is multicast, ff00::/8, which
+	// identifies groups rather than interfaces.
+	SLAACMulticast SLAACStatus = "multicast"
+	// SLAACInvalidLifetimes is set when the preferred lifetime exceeds the valid lifetime.
+	SLAACInvalidLifetimes SLAACStatus = "invalid_lifetimes"
+	// SLAACExpired is set when the valid lifetime is zero.
+	SLAACExpired SLAACStatus = "expired"
+	// SLAACNot64 is set when the prefix is not a /64, which 64-bit EUI-64
+	// interface IDs cannot complete.
+	SLAACNot64 SLAACStatus = "not_64"
+)
+
+// SimulatedAddress is the outcome of SLAAC from a Prefix Information option:
+// the address formed in its prefix, or the reason none is.
+type SimulatedAddress struct {
+	PrefixInformation
+
+	Status     SLAACStatus
+	Address    string // Address is the address formed, empty unless Status is SLAACFormed.
+	Deprecated bool   // Deprecated is set when the address is formed with a zero preferred lifetime.
+}
+
+// Simulation holds the addresses a host forms from a Router Advertisement:
+// its link-local address, which it forms regardless of the advertisement, and
+// the outcome of each Prefix Information option.
+type Simulation struct {
+	InterfaceID string
+	LinkLocal   string
+	Addresses   []SimulatedAddress
+}
+
+// Simulate simulates stateless address autoconfiguration of a host with the
+// given MAC address receiving a Router Advertisement with the given Prefix
+// Information options, in order. Each option forms the EUI-64 address in its
+// prefix, calculated with calc, unless RFC 4862 has the host ignore it.
+func Simulate(calc Calculator, mac string, prefixes []PrefixInformation) (Simulation, error) {
+	interfaceID, address, err := calc.CalculateEUI64(mac, linkLocalPrefix)
+	if err != nil {
+		return Simulation{}, fmt.Errorf("calculating link-local address: %w", err)
+	}
+
+	simulation := Simulation{
+		InterfaceID: interfaceID,
+		LinkLocal:   address,
+		Addresses:   make([]SimulatedAddress, 0, len(prefixes)),
+	}
+
+	for _, info := range prefixes {
+		simulated := SimulatedAddress{
+			PrefixInformation: info,
+			Status:            info.Status(),
+			Address:           "",
+			Deprecated:        false,
+		}
+
+		if simulated.Status == SLAACFormed {
+			_, simulated.Address, err = calc.CalculateEUI64(mac, prefixHextets(info.Prefix.Addr()))
+			if err != nil {
+				return Simulation{}, fmt.Errorf("calculating address in %s: %w", info.Prefix, err)
+			}
+
+			simulated.Deprecated = info.PreferredLifetime == 0
+		}
+
+		simulation.Addresses = append(simulation.Addresses, simulated)
+	}
+
+	return simulation, nil
+}
+
+// Status returns the outcome of SLAAC from the option, checking the conditions
+// of RFC 4862, section 5.5.3, in order.
+func (p PrefixInformation) Status() SLAACStatus {
+	switch {
+	case !p.Autonomous:
+		return SLAACNotAutonomous
+	case p.Prefix.Addr().IsLinkLocalUnicast():
+		return SLAACLinkLocal
+	case p.Prefix.Addr().IsMulticast():
+		return SLAACMulticast
+	case p.PreferredLifetime > p.ValidLifetime:
+		return SLAACInvalidLifetimes
+	case p.ValidLifetime == 0:
+		return SLAACExpired
+	case p.Prefix.Bits() != slaacPrefixLength:
+		return SLAACNot64
+	default:
+		return SLAACFormed
+	}
+}
+
+// prefixHextets returns the first four hextets of an address, the prefix
+// CalculateEUI64 completes with an interface ID.
+func prefixHextets(addr netip.Addr) string {
+	bytes := addr.As16()
+
+	hextets := make([]string, 0, prefixMaxHextets)
+	for i := range prefixMaxHextets {
+		hextets = append(hextets, strconv.FormatUint(uint64(bytes[2*i])<<byteShift|uint64(bytes[2*i+1]), 16))
+	}
+
+	return strings.Join(hextets, ":")
+}
+
+// ParsePrefixInformation parses Prefix Information options, one per line: a
+// prefix in CIDR notation, optionally followed by its flags ("L", "A", "LA",
+// or "-" for neither), its valid lifetime, and its preferred lifetime, in
+// seconds or "infinite", separated by whitespace. Omitted flags are "LA" and
+// omitted lifetimes the defaults routers advertise, except that an omitted
+// preferred lifetime never exceeds the valid lifetime. Host bits of the prefix
+// are cleared and blank lines skipped. Invalid lines are reported as a
+// *LineError.
+func ParsePrefixInformation(text string) ([]PrefixInformation, error) {
+	var prefixes []PrefixInformation
+
+	scanner := bufio.NewScanner(strings.NewReader(text))
+
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		if len(prefixes) == MaxPrefixInformation {
+			return nil, ErrTooManyPrefixInfo
+		}
+
+		info, err := parsePrefixInformationLine(fields)
+		if err != nil {
+			return nil, &LineError{Line: line, Err: err}
+		}
+
+		prefixes = append(prefixes, info)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading Prefix Information options: %w", err)
+	}
+
+	if len(prefixes) == 0 {
+		return nil, ErrPrefixInfoRequired
+	}
+
+	return prefixes, nil
+}
+
+// parsePrefixInformationLine parses the fields of a line of Prefix
+// Information options.
+func parsePrefixInformationLine(fields []string) (PrefixInformation, error) {
+	if len(fields) > maxPIOFields {
+		return PrefixInformation{}, fmt.Errorf("%w, got %d", ErrPrefixInfoFields, len(fields))
+	}
+
+	prefix, err := netip.ParsePrefix(fields[0])
+	if err != nil || !prefix.Addr().Is6() || prefix.Addr().Is4In6() {
+		return PrefixInformation{}, fmt.Errorf("%w: %q", ErrPrefixInfoPrefix, fields[0])
+	}
+
+	info := PrefixInformation{
+		Prefix:            prefix.Masked(),
+		OnLink:            false,
+		Autonomous:        false,
+		ValidLifetime:     DefaultValidLifetime,
+		PreferredLifetime: DefaultPreferredLifetime,
+	}
+
+	flags := defaultFlags
+	if len(fields) > 1 {
+		flags = fields[1]
+	}
+
+	if info.OnLink, info.Autonomous, err = parseFlags(flags); err != nil {
+		return PrefixInformation{}, err
+	}
+
+	for i, lifetime := range []*uint32{&info.ValidLifetime, &info.PreferredLifetime} {
+		if len(fields) > i+2 {
+			if *lifetime, err = parseLifetime(fields[i+2]); err != nil {
+				return PrefixInformation{}, err
+			}
+		}
+	}
+
+	if len(fields) < maxPIOFields {
+		info.PreferredLifetime = min(info.PreferredLifetime, info.ValidLifetime)
+	}
+
+	return info, nil
+}
+
+// parseFlags parses the flags of a Prefix Information option, in either case
+// and order.
+func parseFlags(text string) (bool, bool, error) {
+	if text == noFlags {
+		return false, false, nil
+	}
+
+	var onLink, autonomous bool
+
+	for _, flag := range strings.ToUpper(text) {
+		switch {
+		case flag == flagOnLink && !onLink:
+			onLink = true
+		case flag == flagAutonomous && !autonomous:
+			autonomous = true
+		default:
+			return false, false, fmt.Errorf("%w: %q", ErrPrefixInfoFlags, text)
+		}
+	}
+
+	return onLink, autonomous, nil
+}
+
+// parseLifetime parses a lifetime in seconds or "infinite".
+func parseLifetime(text string) (uint32, error) {
+	if strings.EqualFold(text, infiniteText) {
+		return InfiniteLifetime, nil
+	}
+
+	seconds, err := strconv.ParseUint(text, 10, lifetimeBits)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrPrefixInfoLifetime, text)
+	}
+
+	return uint32(seconds), nil
+}
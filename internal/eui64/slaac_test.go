@@ -0,0 +1,223 @@
+package eui64
+
+import (
+	"errors"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// errCalculation is returned by failingCalculator.
+var errCalculation = errors.New("calculation failed")
+
+// failingCalculator is a Calculator that always fails.
+type failingCalculator struct{}
+
+// CalculateEUI64 returns errCalculation.
+func (failingCalculator) CalculateEUI64(string, string) (string, string, error) {
+	return "", "", errCalculation
+}
+
+// pio returns a Prefix Information option of the given prefix.
+func pio(prefix string, onLink, autonomous bool, valid, preferred uint32) PrefixInformation {
+	return PrefixInformation{
+		Prefix:            netip.MustParsePrefix(prefix),
+		OnLink:            onLink,
+		Autonomous:        autonomous,
+		ValidLifetime:     valid,
+		PreferredLifetime: preferred,
+	}
+}
+
+// TestSimulate tests that Simulate forms the link-local address and an address
+// in each prefix RFC 4862 lets a host autoconfigure, explaining the others.
+func TestSimulate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		info           PrefixInformation
+		wantStatus     SLAACStatus
+		wantAddress    string
+		wantDeprecated bool
+	}{
+		{
+			name:           "Autonomous /64",
+			info:           pio("2001:db8:1::/64", true, true, DefaultValidLifetime, DefaultPreferredLifetime),
+			wantStatus:     SLAACFormed,
+			wantAddress:    "2001:db8:1:0:214:22ff:fe01:2345",
+			wantDeprecated: false,
+		},
+		{
+			name:           "Off-link with infinite lifetimes",
+			info:           pio("2001:db8:2:3::/64", false, true, InfiniteLifetime, InfiniteLifetime),
+			wantStatus:     SLAACFormed,
+			wantAddress:    "2001:db8:2:3:214:22ff:fe01:2345",
+			wantDeprecated: false,
+		},
+		{
+			name:           "Zero preferred lifetime",
+			info:           pio("fd00:1::/64", true, true, DefaultValidLifetime, 0),
+			wantStatus:     SLAACFormed,
+			wantAddress:    "fd00:1::214:22ff:fe01:2345",
+			wantDeprecated: true,
+		},
+		{
+			name:           "Not autonomous",
+			info:           pio("2001:db8:1::/64", true, false, DefaultValidLifetime, DefaultPreferredLifetime),
+			wantStatus:     SLAACNotAutonomous,
+			wantAddress:    "",
+			wantDeprecated: false,
+		},
+		{
+			name:           "Link-local prefix",
+			info:           pio("fe80::/64", true, true, DefaultValidLifetime, DefaultPreferredLifetime),
+			wantStatus:     SLAACLinkLocal,
+			wantAddress:    "",
+			wantDeprecated: false,
+		},
+		{
+			name:           "Multicast prefix",
+			info:           pio("ff0e::/64", true, true, DefaultValidLifetime, DefaultPreferredLifetime),
+			wantStatus:     SLAACMulticast,
+			wantAddress:    "",
+			wantDeprecated: false,
+		},
+		{
+			name:           "Preferred exceeds valid",
+			info:           pio("2001:db8:1::/64", true, true, DefaultPreferredLifetime, DefaultValidLifetime),
+			wantStatus:     SLAACInvalidLifetimes,
+			wantAddress:    "",
+			wantDeprecated: false,
+		},
+		{
+			name:           "Zero valid lifetime",
+			info:           pio("2001:db8:1::/64", true, true, 0, 0),
+			wantStatus:     SLAACExpired,
+			wantAddress:    "",
+			wantDeprecated: false,
+		},
+		{
+			name:           "Not a /64",
+			info:           pio("2001:db8:1::/56", true, true, DefaultValidLifetime, DefaultPreferredLifetime),
+			wantStatus:     SLAACNot64,
+			wantAddress:    "",
+			wantDeprecated: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			simulation, err := Simulate(&DefaultCalculator{}, "00-14-22-01-23-45", []PrefixInformation{tt.info})
+			require.NoError(t, err)
+			assert.Equal(t, "0214:22ff:fe01:2345", simulation.InterfaceID)
+			assert.Equal(t, "fe80::214:22ff:fe01:2345", simulation.LinkLocal)
+			assert.Equal(t, []SimulatedAddress{{
+				PrefixInformation: tt.info,
+				Status:            tt.wantStatus,
+				Address:           tt.wantAddress,
+				Deprecated:        tt.wantDeprecated,
+			}}, simulation.Addresses)
+		})
+	}
+}
+
+// TestSimulateErrors tests that Simulate reports invalid MAC addresses and
+// failed calculations.
+func TestSimulateErrors(t *testing.T) {
+	t.Parallel()
+
+	prefixes := []PrefixInformation{pio("2001:db8::/64", true, true, DefaultValidLifetime, DefaultPreferredLifetime)}
+
+	_, err := Simulate(&DefaultCalculator{}, "invalid", prefixes)
+	require.ErrorIs(t, err, ErrParseMAC)
+
+	_, err = Simulate(failingCalculator{}, "00-14-22-01-23-45", prefixes)
+	require.ErrorIs(t, err, errCalculation)
+}
+
+// TestParsePrefixInformation tests that ParsePrefixInformation parses a
+// prefix per line, with optional flags and lifetimes, defaulting the preferred
+// lifetime to at most the valid lifetime.
+func TestParsePrefixInformation(t *testing.T) {
+	t.Parallel()
+
+	prefixes, err := ParsePrefixInformation(
+		"2001:db8:1::/64\n\n  2001:db8:2::1/64 a 3600 1800  \n2001:db8:3::/56 - infinite 0\nfd00::/64 AL INFINITE infinite\n" +
+			"2001:db8::/64 LA 3600\n",
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []PrefixInformation{
+		pio("2001:db8:1::/64", true, true, DefaultValidLifetime, DefaultPreferredLifetime),
+		pio("2001:db8:2::/64", false, true, 3600, 1800),
+		pio("2001:db8:3::/56", false, false, InfiniteLifetime, 0),
+		pio("fd00::/64", true, true, InfiniteLifetime, InfiniteLifetime),
+		pio("2001:db8::/64", true, true, 3600, 3600),
+	}, prefixes)
+}
+
+// TestPrefixInformationFlags tests that Flags spells the flags of an option as
+// ParsePrefixInformation parses them.
+func TestPrefixInformationFlags(t *testing.T) {
+	t.Parallel()
+
+	for _, flags := range []string{"LA", "L", "A", "-"} {
+		prefixes, err := ParsePrefixInformation("2001:db8::/64 " + flags)
+		require.NoError(t, err)
+		assert.Equal(t, flags, prefixes[0].Flags())
+	}
+}
+
+// TestParsePrefixInformationInvalid tests that ParsePrefixInformation reports
+// invalid lines with their number.
+func TestParsePrefixInformationInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		text     string
+		wantErr  error
+		wantLine int
+	}{
+		{name: "Blank", text: " \n\t\n", wantErr: ErrPrefixInfoRequired, wantLine: 0},
+		{name: "No length", text: "2001:db8::", wantErr: ErrPrefixInfoPrefix, wantLine: 1},
+		{name: "IPv4 prefix", text: "2001:db8::/64\n192.0.2.0/24", wantErr: ErrPrefixInfoPrefix, wantLine: 2},
+		{name: "IPv4-mapped prefix", text: "::ffff:192.0.2.0/120", wantErr: ErrPrefixInfoPrefix, wantLine: 1},
+		{name: "Unknown flag", text: "\n2001:db8::/64 LM", wantErr: ErrPrefixInfoFlags, wantLine: 2},
+		{name: "Repeated flag", text: "2001:db8::/64 AA", wantErr: ErrPrefixInfoFlags, wantLine: 1},
+		{name: "Negative lifetime", text: "2001:db8::/64 LA -1", wantErr: ErrPrefixInfoLifetime, wantLine: 1},
+		{name: "Lifetime too long", text: "2001:db8::/64 LA 1 4294967296", wantErr: ErrPrefixInfoLifetime, wantLine: 1},
+		{name: "Too many fields", text: "2001:db8::/64 LA 1 1 1", wantErr: ErrPrefixInfoFields, wantLine: 1},
+		{
+			name:     "Too many options",
+			text:     strings.Repeat("2001:db8::/64\n", MaxPrefixInformation+1),
+			wantErr:  ErrTooManyPrefixInfo,
+			wantLine: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			prefixes, err := ParsePrefixInformation(tt.text)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, prefixes)
+
+			var lineErr *LineError
+			if tt.wantLine == 0 {
+				assert.NotErrorAs(t, err, &lineErr)
+
+				return
+			}
+
+			require.ErrorAs(t, err, &lineErr)
+			assert.Equal(t, tt.wantLine, lineErr.Line)
+		})
+	}
+}
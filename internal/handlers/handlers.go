@@ -1,19 +1,46 @@
 // Package handlers provides HTTP request handlers for the EUI-64 calculator
 // application using the Fiber framework. It defines the Handler struct with
 // dependency injection for the EUI-64 calculator, and includes handlers for
-// rendering the home page, processing calculation requests with validation,
-// and rendering results or errors.
+// rendering the home page, processing calculation and subnet plan requests with
+// validation, streaming address matrices as CSV, generating ULA prefixes,
+// analyzing addresses, decoding DHCPv6 DUIDs, verifying observed addresses
+// against MAC addresses, importing MAC addresses from DHCP lease files and
+// neighbor tables, simulating the addresses hosts form from Router
+// Advertisements, validating form fields as the user types, and rendering
+// results or errors.
 package handlers
 
 import (
+	"bufio"
 	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/a-h/templ"
 	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/csrf"
 
+	"github.com/nicholas-fedor/eui64-calculator/internal/analyzer"
+	"github.com/nicholas-fedor/eui64-calculator/internal/classify"
+	"github.com/nicholas-fedor/eui64-calculator/internal/duid"
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+	"github.com/nicholas-fedor/eui64-calculator/internal/i18n"
+	"github.com/nicholas-fedor/eui64-calculator/internal/importer"
+	"github.com/nicholas-fedor/eui64-calculator/internal/macrange"
+	"github.com/nicholas-fedor/eui64-calculator/internal/matrix"
+	"github.com/nicholas-fedor/eui64-calculator/internal/ratelimit"
+	"github.com/nicholas-fedor/eui64-calculator/internal/subnet"
 	"github.com/nicholas-fedor/eui64-calculator/internal/ui"
+	"github.com/nicholas-fedor/eui64-calculator/internal/ula"
 	"github.com/nicholas-fedor/eui64-calculator/internal/validators"
+	"github.com/nicholas-fedor/eui64-calculator/internal/verify"
 )
 
 // Calculator defines the interface for EUI-64 calculation logic used by handlers.
@@ -21,51 +48,229 @@ type Calculator interface {
 	// CalculateEUI64 computes the EUI-64 interface ID and full IPv6 address
 	// from a MAC address and prefix.
 	CalculateEUI64(mac, prefix string) (string, string, error)
+
+	// CalculateLinkAddress computes the interface ID and full IPv6 address
+	// from a link-layer address of the given type and prefix.
+	CalculateLinkAddress(link eui64.LinkType, address, prefix string) (string, string, error)
 }
 
 // Handler manages HTTP request handling for the EUI-64 calculator application.
 type Handler struct {
-	calc Calculator // calc is the EUI-64 calculator implementation.
+	calc     Calculator // calc is the EUI-64 calculator implementation.
+	maxRange int        // maxRange is the maximum number of MAC addresses in a range.
+}
+
+// Option configures a Handler created by NewHandler.
+type Option func(*Handler)
+
+// errorResponse is the JSON body returned to API clients when a request fails.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// resultResponse is the JSON body returned to API clients for a calculation.
+type resultResponse struct {
+	InterfaceID string         `json:"interface_id"`
+	FullIP      string         `json:"ipv6_address"`
+	DUIDLL      string         `json:"duid_ll"`
+	DUIDLLT     string         `json:"duid_llt"`
+	Prefix      prefixResponse `json:"prefix"`
+}
+
+// rangeResponse is the JSON body returned to API clients for a calculation of a
+// range of MAC addresses.
+type rangeResponse struct {
+	Addresses []rangeAddress `json:"addresses"`
+	Prefix    prefixResponse `json:"prefix"`
+}
+
+// rangeAddress is a MAC address of a range and its addresses in a rangeResponse.
+type rangeAddress struct {
+	MAC         string `json:"mac"`
+	InterfaceID string `json:"interface_id"`
+	FullIP      string `json:"ipv6_address"`
+}
+
+// prefixResponse is the classification of the entered prefix in a resultResponse.
+type prefixResponse struct {
+	Type    classify.Type    `json:"type"`
+	Name    string           `json:"name"`
+	Range   string           `json:"range"`
+	SLAAC   bool             `json:"slaac"`
+	Warning classify.Warning `json:"warning,omitempty"`
+	Message string           `json:"message,omitempty"`
+}
+
+// importResponse is the JSON body returned to API clients for an import.
+type importResponse struct {
+	Format  importer.Format `json:"format"`
+	Entries []importEntry   `json:"entries"`
+	Prefix  prefixResponse  `json:"prefix"`
+}
+
+// importEntry is an imported MAC address and its addresses in an
+// importResponse, or the error explaining why they could not be calculated.
+type importEntry struct {
+	Line        int    `json:"line"`
+	MAC         string `json:"mac"`
+	Hostname    string `json:"hostname,omitempty"`
+	InterfaceID string `json:"interface_id,omitempty"`
+	FullIP      string `json:"ipv6_address,omitempty"`
+	Error       string `json:"error,omitempty"`
 }
 
+// simulationResponse is the JSON body returned to API clients for a Router
+// Advertisement simulation.
+type simulationResponse struct {
+	InterfaceID string              `json:"interface_id"`
+	LinkLocal   string              `json:"link_local_address"`
+	Addresses   []simulatedResponse `json:"addresses"`
+}
+
+// simulatedResponse is the outcome of a Prefix Information option in a
+// simulationResponse: the address formed, or the message explaining why none
+// is.
+type simulatedResponse struct {
+	Prefix            string            `json:"prefix"`
+	OnLink            bool              `json:"on_link"`
+	Autonomous        bool              `json:"autonomous"`
+	ValidLifetime     uint32            `json:"valid_lifetime"`
+	PreferredLifetime uint32            `json:"preferred_lifetime"`
+	Status            eui64.SLAACStatus `json:"status"`
+	Address           string            `json:"ipv6_address,omitempty"`
+	Deprecated        bool              `json:"deprecated,omitempty"`
+	Message           string            `json:"message,omitempty"`
+}
+
+// verifyResponse is the JSON body returned to API clients for a verification.
+type verifyResponse struct {
+	Status      verify.Status    `json:"status"`
+	Message     string           `json:"message"`
+	Expected    string           `json:"expected_address"`
+	InterfaceID string           `json:"interface_id"`
+	IIDType     analyzer.IIDType `json:"iid_type"`
+	ObservedMAC string           `json:"observed_mac,omitempty"`
+}
+
+// subnetIDBase is the base subnet IDs are displayed in, as in addresses.
+const subnetIDBase = 16
+
+// matrixFilename is the name the CSV file of an address matrix is downloaded as.
+const matrixFilename = "eui64-matrix.csv"
+
+// verifyFilename is the name the CSV file of a bulk verification is downloaded as.
+const verifyFilename = "eui64-verification.csv"
+
+// linkLocalPrefix is the prefix of the link-local address a host forms
+// regardless of Router Advertisements, as displayed.
+const linkLocalPrefix = "fe80::/64"
+
+// csvContentType is the media type of CSV request bodies sent by API clients.
+const csvContentType = "text/csv"
+
+// rangeFields maps the values of a MAC range to the ids of the calculator
+// form's fields providing them.
+var rangeFields = map[macrange.Input]string{
+	macrange.InputStart: ui.FieldMAC,
+	macrange.InputEnd:   ui.FieldMACEnd,
+	macrange.InputCount: ui.FieldMACCount,
+}
+
+// verifyFields maps the values of a verified pair to the ids of the address
+// verifier's form fields providing them.
+var verifyFields = map[verify.Input]string{
+	verify.InputMAC:     ui.FieldVerifyMAC,
+	verify.InputAddress: ui.FieldVerifyAddress,
+	verify.InputPrefix:  ui.FieldVerifyPrefix,
+}
+
+// Messages shown when a request fails, translated into the request's locale.
 const (
-	errInvalidMACAddress  = "Please enter a valid MAC address (e.g., 00-14-22-01-23-45)"
-	errInvalidIPv6Prefix  = "Please enter a valid IPv6 prefix (e.g., 2001:db8::)"
-	errCalculationFailure = "Failed to calculate EUI-64 address"
+	errCalculationFailure = i18n.KeyErrCalculation
+	errTooManyRequests    = i18n.KeyErrTooManyRequests
+	errInvalidCSRFToken   = i18n.KeyErrInvalidCSRFToken
 )
 
 // NewHandler creates a new Handler with the specified EUI-64 calculator.
-// It initializes the handler with the provided calculator for dependency injection.
-func NewHandler(calc Calculator) *Handler {
-	return &Handler{calc: calc}
+// It initializes the handler with the provided calculator for dependency injection,
+// configured by the given options.
+func NewHandler(calc Calculator, opts ...Option) *Handler {
+	handler := &Handler{calc: calc, maxRange: macrange.DefaultMaxCount}
+	for _, opt := range opts {
+		opt(handler)
+	}
+
+	return handler
+}
+
+// WithMaxRange sets the maximum number of MAC addresses in a range calculated
+// at once, macrange.DefaultMaxCount by default.
+func WithMaxRange(maxRange int) Option {
+	return func(h *Handler) {
+		h.maxRange = maxRange
+	}
 }
 
 // Calculate handles POST requests to compute an EUI-64 address from form data.
-// It validates the MAC address and IPv6 prefix from the request, computes
-// the EUI-64 interface ID and full IPv6 address, and renders the result.
-// Errors during validation or calculation are logged and displayed to the user.
+// It validates the MAC address, or the link-layer address of the selected link
+// type, and IPv6 prefix from the request, computes
+// the EUI-64 interface ID and full IPv6 address, classifies the prefix, warning
+// when EUI-64 SLAAC does not apply to it, builds the DHCPv6 DUIDs of Ethernet
+// MAC addresses with the hardware type and time entered, and renders the result, or returns it
+// as JSON to API clients preferring it. When an end MAC address or a count is
+// given, the MAC address starts a range, see calculateRange.
+// Errors during validation or calculation are logged and displayed to the user,
+// validation errors explaining which character or hextet is wrong and marking it
+// in the rendered input.
 func (h *Handler) Calculate(c fiber.Ctx) error {
 	mac := c.FormValue("mac")
 	prefix := c.FormValue("ip-start")
+	locale := i18n.FromContext(c.Context())
 	data := ui.ResultData{}
 
-	if err := validators.ValidateMAC(mac); err != nil {
-		data.Error = errInvalidMACAddress
+	link, err := eui64.ParseLinkType(c.FormValue(ui.FieldLinkType))
+	if err != nil {
+		data.Error = locale.Error(err)
+		data.ErrorField = ui.FieldLinkType
+
+		slog.DebugContext(c.Context(), "Link type validation failed", "error", err)
+
+		return h.renderCalculation(c, data, http.StatusBadRequest)
+	}
+
+	if end, count := c.FormValue(ui.FieldMACEnd), c.FormValue(ui.FieldMACCount); macrange.IsRange(end, count) {
+		if link != eui64.LinkEthernet {
+			data.Error = locale.Error(macrange.ErrNotEthernet)
+			data.ErrorField = ui.FieldLinkType
+
+			return h.renderCalculation(c, data, http.StatusBadRequest)
+		}
+
+		return h.calculateRange(c, mac, end, count, prefix)
+	}
+
+	if err := validators.ValidateLinkAddress(link, mac); err != nil {
+		data.Error = locale.Error(err)
+		data.ErrorField = ui.FieldMAC
+		data.ErrorHighlight = errorHighlight(err)
 
-		slog.WarnContext(
+		slog.DebugContext(
 			c.Context(),
 			"MAC validation failed",
 			"mac", mac,
+			"link_type", link,
 			"error", err,
 		)
 
-		return h.renderResult(c, data)
+		return h.renderCalculation(c, data, http.StatusBadRequest)
 	}
 
 	if err := validators.ValidateIPv6Prefix(prefix); err != nil {
-		data.Error = errInvalidIPv6Prefix
+		data.Error = locale.Error(err)
+		data.ErrorField = ui.FieldIPv6Prefix
+		data.ErrorHighlight = errorHighlight(err)
 
-		slog.WarnContext(
+		slog.DebugContext(
 			c.Context(),
 			"Prefix validation failed",
 			"prefix",
@@ -74,15 +279,29 @@ func (h *Handler) Calculate(c fiber.Ctx) error {
 			err,
 		)
 
-		return h.renderResult(c, data)
+		return h.renderCalculation(c, data, http.StatusBadRequest)
 	}
 
-	interfaceID, fullIP, err := h.calc.CalculateEUI64(mac, prefix)
+	if link == eui64.LinkEthernet {
+		var field string
+
+		data.DUIDLL, data.DUIDLLT, field, err = formDUIDs(c, mac)
+		if err != nil {
+			data.Error = locale.Error(err)
+			data.ErrorField = field
+
+			slog.DebugContext(c.Context(), "DUID validation failed", "field", field, "error", err)
+
+			return h.renderCalculation(c, data, http.StatusBadRequest)
+		}
+	}
+
+	interfaceID, fullIP, err := h.calc.CalculateLinkAddress(link, mac, prefix)
 	data.InterfaceID = interfaceID
 	data.FullIP = fullIP
 
 	if err != nil {
-		data.Error = errCalculationFailure
+		data.Error = locale.T(errCalculationFailure)
 
 		slog.ErrorContext(
 			c.Context(),
@@ -94,9 +313,120 @@ func (h *Handler) Calculate(c fiber.Ctx) error {
 			"error",
 			err,
 		)
+
+		return h.renderCalculation(c, data, http.StatusInternalServerError)
+	}
+
+	data.Prefix, err = classify.Prefix(prefix)
+	if err != nil {
+		slog.DebugContext(c.Context(), "Prefix classification failed", "prefix", prefix, "error", err)
+	}
+
+	return h.renderCalculation(c, data, http.StatusOK)
+}
+
+// formDUIDs returns the DUID-LL and DUID-LLT of an Ethernet MAC address built
+// with the hardware type and time entered in the form, Ethernet and the current
+// time if blank, and the id of the field at fault when they are invalid.
+func formDUIDs(c fiber.Ctx, mac string) (string, string, string, error) {
+	hardware, err := duid.ParseHardware(c.FormValue(ui.FieldDUIDHardware))
+	if err != nil {
+		return "", "", ui.FieldDUIDHardware, err
+	}
+
+	generated, err := duid.ParseTime(c.FormValue(ui.FieldDUIDTime), time.Now())
+	if err != nil {
+		return "", "", ui.FieldDUIDTime, err
+	}
+
+	ll, llt, err := duids(mac, hardware, generated)
+	if err != nil {
+		return "", "", ui.FieldDUIDTime, err
+	}
+
+	return ll, llt, "", nil
+}
+
+// duids returns the DUID-LL of an Ethernet MAC address and its DUID-LLT
+// generated at the given time, both with the given hardware type, formatted as
+// DHCPv6 servers write them.
+func duids(mac string, hardware uint16, generated time.Time) (string, string, error) {
+	ll, err := duid.LL(mac, hardware)
+	if err != nil {
+		return "", "", fmt.Errorf("building DUID-LL: %w", err)
+	}
+
+	llt, err := duid.LLT(mac, hardware, generated)
+	if err != nil {
+		return "", "", fmt.Errorf("building DUID-LLT: %w", err)
+	}
+
+	return duid.Format(ll), duid.Format(llt), nil
+}
+
+// calculateRange computes the EUI-64 addresses of a range of MAC addresses
+// starting at mac and ending at end, or holding count addresses, of at most the
+// handler's maximum, and renders them in order as a table, or returns them as
+// JSON to API clients preferring it. Errors are rendered like Calculate's,
+// marking the field at fault.
+func (h *Handler) calculateRange(c fiber.Ctx, mac, end, count, prefix string) error {
+	locale := i18n.FromContext(c.Context())
+	data := ui.ResultData{}
+
+	macs, err := macrange.Parse(mac, end, count, h.maxRange)
+	if err != nil {
+		var inputErr *macrange.InputError
+		if errors.As(err, &inputErr) {
+			data.ErrorField = rangeFields[inputErr.Input]
+		}
+
+		data.Error = locale.Error(err)
+		data.ErrorHighlight = errorHighlight(err)
+
+		slog.DebugContext(
+			c.Context(),
+			"MAC range validation failed",
+			"mac", mac,
+			"end", end,
+			"count", count,
+			"error", err,
+		)
+
+		return h.renderCalculation(c, data, http.StatusBadRequest)
+	}
+
+	if err := validators.ValidateIPv6Prefix(prefix); err != nil {
+		data.Error = locale.Error(err)
+		data.ErrorField = ui.FieldIPv6Prefix
+		data.ErrorHighlight = errorHighlight(err)
+
+		slog.DebugContext(c.Context(), "Prefix validation failed", "prefix", prefix, "error", err)
+
+		return h.renderCalculation(c, data, http.StatusBadRequest)
+	}
+
+	addresses, err := macrange.Calculate(h.calc, macs, prefix)
+	if err != nil {
+		data.Error = locale.T(errCalculationFailure)
+
+		slog.ErrorContext(
+			c.Context(),
+			"EUI-64 range calculation failed",
+			"mac", mac,
+			"count", macs.Count,
+			"prefix", prefix,
+			"error", err,
+		)
+
+		return h.renderCalculation(c, data, http.StatusInternalServerError)
+	}
+
+	classification, err := classify.Prefix(prefix)
+	if err != nil {
+		slog.DebugContext(c.Context(), "Prefix classification failed", "prefix", prefix, "error", err)
 	}
 
-	return h.renderResult(c, data)
+	return h.renderRange(c, ui.RangeData{Addresses: addresses, Prefix: classification})
 }
 
 // Home handles GET requests to the root path, rendering the home page.
@@ -108,7 +438,7 @@ func (h *Handler) Home(c fiber.Ctx) error {
 	var buf bytes.Buffer
 
 	err := ui.Home().Render(
-		c.Context(),
+		ui.WithCSRFToken(c.Context(), csrf.TokenFromContext(c)),
 		&buf,
 	)
 	if err != nil {
@@ -126,29 +456,1061 @@ func (h *Handler) Home(c fiber.Ctx) error {
 	return c.Send(buf.Bytes())
 }
 
-// renderResult renders the calculation result to the HTTP response.
-// It uses the provided ResultData to display either the computed EUI-64 address
-// or an error message, returning a 500 status if rendering fails.
+// Plan handles POST requests planning a host's EUI-64 addresses across the
+// subnets of a parent prefix from form data. It validates the MAC address, parses
+// the parent prefix and subnet IDs, computes the address in each subnet, and
+// renders the plan as a table. Errors are logged and displayed like Calculate's.
+func (h *Handler) Plan(c fiber.Ctx) error {
+	mac := c.FormValue(ui.FieldPlanMAC)
+
+	if err := validators.ValidateMAC(mac); err != nil {
+		return h.renderPlanError(c, ui.FieldPlanMAC, err)
+	}
+
+	parent, err := subnet.ParseParent(c.FormValue(ui.FieldPlanParent))
+	if err != nil {
+		return h.renderPlanError(c, ui.FieldPlanParent, err)
+	}
+
+	ids, err := subnet.ParseIDs(c.FormValue(ui.FieldPlanIDs), parent)
+	if err != nil {
+		return h.renderPlanError(c, ui.FieldPlanIDs, err)
+	}
+
+	data := ui.PlanData{}
+
+	plan, err := subnet.Compute(mac, parent, ids)
+	if err != nil {
+		data.Error = i18n.FromContext(c.Context()).T(errCalculationFailure)
+
+		slog.ErrorContext(
+			c.Context(),
+			"Subnet plan calculation failed",
+			"mac", mac,
+			"parent", parent,
+			"error", err,
+		)
+
+		return h.renderPlan(c, data)
+	}
+
+	data.InterfaceID = plan.InterfaceID
+	data.Subnets = planRows(plan)
+
+	return h.renderPlan(c, data)
+}
+
+// Matrix handles POST requests calculating the EUI-64 address of every MAC
+// address in every prefix from form data, both lists separated by commas or
+// whitespace. The matrix is streamed as a CSV attachment, one row per pair, with
+// invalid values and failed calculations explained in the row's error column
+// rather than failing the whole matrix, holding the request's rate-limit slot
+// until the stream ends. Empty lists and matrices larger than matrix.MaxCells
+// are rejected with a 400 status and a JSON error body.
 //
 //nolint:wrapcheck // Returning Fiber response directly
-func (h *Handler) renderResult(c fiber.Ctx, data ui.ResultData) error {
-	var buf bytes.Buffer
+func (h *Handler) Matrix(c fiber.Ctx) error {
+	macs := matrix.ParseList(c.FormValue(ui.FieldMatrixMACs))
+	prefixes := matrix.ParseList(c.FormValue(ui.FieldMatrixPrefixes))
+	locale := i18n.FromContext(c.Context())
 
-	err := ui.Result(data).Render(
+	if err := matrix.Check(macs, prefixes); err != nil {
+		slog.DebugContext(
+			c.Context(),
+			"Matrix validation failed",
+			"macs", len(macs),
+			"prefixes", len(prefixes),
+			"error", err,
+		)
+
+		return c.Status(http.StatusBadRequest).JSON(errorResponse{Error: locale.Error(err)})
+	}
+
+	ctx := c.Context()
+
+	c.Attachment(matrixFilename)
+	c.Set("Content-Type", "text/csv; charset=utf-8")
+
+	done := ratelimit.Hold(c)
+
+	return c.SendStreamWriter(func(w *bufio.Writer) {
+		defer done()
+
+		err := matrix.WriteCSV(w, matrix.Cells(h.calc, macs, prefixes), locale.Error)
+		if err != nil {
+			slog.ErrorContext(
+				ctx,
+				"Failed to stream address matrix",
+				"macs", len(macs),
+				"prefixes", len(prefixes),
+				"error", err,
+			)
+		}
+	})
+}
+
+// Verify handles POST requests checking whether the address observed for a MAC
+// address is its EUI-64 address from form data, in the expected prefix if one
+// is given. It renders the outcome, explaining a mismatch with the facts
+// supporting it, or returns it as JSON to API clients preferring it. Invalid
+// values are explained like Calculate's, marking the offending field.
+func (h *Handler) Verify(c fiber.Ctx) error {
+	pair := verify.Pair{
+		MAC:     c.FormValue(ui.FieldVerifyMAC),
+		Address: c.FormValue(ui.FieldVerifyAddress),
+		Prefix:  strings.TrimSpace(c.FormValue(ui.FieldVerifyPrefix)),
+	}
+	locale := i18n.FromContext(c.Context())
+
+	result := verify.Verify(h.calc, pair)
+	if result.Err == nil {
+		return h.renderVerification(c, result, ui.VerifyData{
+			Status:         result.Status,
+			Verdict:        locale.Verdict(result),
+			Facts:          locale.VerificationFacts(result),
+			Error:          "",
+			ErrorField:     "",
+			ErrorHighlight: nil,
+		}, http.StatusOK)
+	}
+
+	var inputErr *verify.InputError
+	if !errors.As(result.Err, &inputErr) {
+		slog.ErrorContext(
+			c.Context(),
+			"Verification failed",
+			"mac", pair.MAC,
+			"address", pair.Address,
+			"prefix", pair.Prefix,
+			"error", result.Err,
+		)
+
+		return h.renderVerification(c, result, ui.VerifyData{
+			Status:         "",
+			Verdict:        "",
+			Facts:          nil,
+			Error:          locale.T(errCalculationFailure),
+			ErrorField:     "",
+			ErrorHighlight: nil,
+		}, http.StatusInternalServerError)
+	}
+
+	slog.DebugContext(
 		c.Context(),
-		&buf,
+		"Verification input validation failed",
+		"input", inputErr.Input,
+		"error", inputErr.Err,
 	)
+
+	return h.renderVerification(c, result, ui.VerifyData{
+		Status:         "",
+		Verdict:        "",
+		Facts:          nil,
+		Error:          locale.Error(inputErr),
+		ErrorField:     verifyFields[inputErr.Input],
+		ErrorHighlight: errorHighlight(inputErr),
+	}, http.StatusBadRequest)
+}
+
+// VerifyCSV handles POST requests verifying pairs in bulk from CSV records of a
+// MAC address, an observed address, and an optional expected prefix, taken from
+// the form's CSV field or, for API clients, a text/csv request body. The
+// outcomes are streamed as a CSV attachment, one row per pair, with invalid
+// values explained in the row's error column rather than failing the whole
+// verification, holding the request's rate-limit slot until the stream ends.
+// Malformed CSV, and more than verify.MaxPairs pairs, are rejected with a 400
+// status and a JSON error body.
+//
+//nolint:wrapcheck // Returning Fiber response directly
+func (h *Handler) VerifyCSV(c fiber.Ctx) error {
+	input := c.FormValue(ui.FieldVerifyCSV)
+	if strings.HasPrefix(c.Get(fiber.HeaderContentType), csvContentType) {
+		input = string(c.Body())
+	}
+
+	locale := i18n.FromContext(c.Context())
+
+	pairs, err := verify.ParseCSV(strings.NewReader(input))
+	if err != nil {
+		slog.DebugContext(
+			c.Context(),
+			"Verification CSV parsing failed",
+			"error", err,
+		)
+
+		return c.Status(http.StatusBadRequest).JSON(errorResponse{Error: locale.Error(err)})
+	}
+
+	ctx := c.Context()
+
+	c.Attachment(verifyFilename)
+	c.Set("Content-Type", "text/csv; charset=utf-8")
+
+	done := ratelimit.Hold(c)
+
+	return c.SendStreamWriter(func(w *bufio.Writer) {
+		defer done()
+
+		err := verify.WriteCSV(w, verify.Results(h.calc, pairs), locale.Error)
+		if err != nil {
+			slog.ErrorContext(
+				ctx,
+				"Failed to stream verification",
+				"pairs", len(pairs),
+				"error", err,
+			)
+		}
+	})
+}
+
+// Import handles POST requests importing the MAC addresses of a DHCP lease file
+// or neighbor table uploaded in the importer form's file field, in the selected
+// format or the one detected from its content, and calculating their EUI-64
+// addresses in the entered prefix. It renders a table with a row per MAC
+// address, explaining invalid MAC addresses in their rows rather than failing
+// the whole import, or returns the rows as JSON to API clients. Files that
+// cannot be imported and invalid prefixes are explained like Plan's, with a
+// 400 status for API clients.
+func (h *Handler) Import(c fiber.Ctx) error {
+	data, err := readImport(c)
+	if err != nil {
+		return h.renderImportError(c, ui.FieldImportFile, err)
+	}
+
+	format, err := importer.ParseFormat(c.FormValue(ui.FieldImportFormat))
+	if err != nil {
+		return h.renderImportError(c, ui.FieldImportFormat, err)
+	}
+
+	entries, format, err := importer.Parse(data, format)
+	if err != nil {
+		field := ui.FieldImportFile
+		if errors.Is(err, importer.ErrInvalidFile) {
+			// The file may be fine in another format.
+			field = ui.FieldImportFormat
+		}
+
+		return h.renderImportError(c, field, err)
+	}
+
+	prefix := strings.TrimSpace(c.FormValue(ui.FieldImportPrefix))
+	if err := validators.ValidateIPv6Prefix(prefix); err != nil {
+		return h.renderImportError(c, ui.FieldImportPrefix, err)
+	}
+
+	locale := i18n.FromContext(c.Context())
+	results := importer.Calculate(h.calc, entries, prefix)
+	rows := make([]ui.ImportRow, 0, len(results))
+
+	for _, result := range results {
+		row := ui.ImportRow{
+			Line:        result.Line,
+			MAC:         result.MAC,
+			Hostname:    result.Hostname,
+			InterfaceID: result.InterfaceID,
+			FullIP:      result.FullIP,
+			Error:       "",
+		}
+		if result.Err != nil {
+			row.Error = locale.Error(result.Err)
+		}
+
+		rows = append(rows, row)
+	}
+
+	classification, err := classify.Prefix(prefix)
+	if err != nil {
+		slog.DebugContext(c.Context(), "Prefix classification failed", "prefix", prefix, "error", err)
+	}
+
+	return h.renderImport(c, format, ui.ImportData{
+		Format:         ui.FormatName(c.Context(), format),
+		Rows:           rows,
+		Prefix:         classification,
+		Error:          "",
+		ErrorField:     "",
+		ErrorHighlight: nil,
+	}, http.StatusOK)
+}
+
+// Simulate handles POST requests simulating stateless address
+// autoconfiguration of a host from form data: its MAC address and the Prefix
+// Information options of a Router Advertisement, one per line. It renders a
+// table of the host's link-local address and the outcome of each option, the
+// address formed in its prefix with its lifetimes or why none is, or returns
+// them as JSON to API clients preferring it. Errors are explained like
+// Import's.
+func (h *Handler) Simulate(c fiber.Ctx) error {
+	mac := c.FormValue(ui.FieldRAMAC)
+	if err := validators.ValidateMAC(mac); err != nil {
+		return h.renderRAError(c, ui.FieldRAMAC, err)
+	}
+
+	prefixes, err := eui64.ParsePrefixInformation(c.FormValue(ui.FieldRAPrefixes))
+	if err != nil {
+		return h.renderRAError(c, ui.FieldRAPrefixes, err)
+	}
+
+	locale := i18n.FromContext(c.Context())
+
+	simulation, err := eui64.Simulate(h.calc, mac, prefixes)
 	if err != nil {
 		slog.ErrorContext(
 			c.Context(),
-			"Failed to render result",
+			"Router Advertisement simulation failed",
+			"mac", mac,
 			"error", err,
 		)
 
-		return c.SendStatus(http.StatusInternalServerError)
+		return h.renderRA(c, simulation, ui.RAData{
+			InterfaceID:    "",
+			Rows:           nil,
+			Error:          locale.T(errCalculationFailure),
+			ErrorField:     "",
+			ErrorHighlight: nil,
+		}, http.StatusInternalServerError)
 	}
 
-	c.Set("Content-Type", "text/html; charset=utf-8")
+	return h.renderRA(c, simulation, ui.RAData{
+		InterfaceID:    simulation.InterfaceID,
+		Rows:           raRows(locale, simulation),
+		Error:          "",
+		ErrorField:     "",
+		ErrorHighlight: nil,
+	}, http.StatusOK)
+}
 
-	return c.Send(buf.Bytes())
+// readImport reads the file uploaded in the importer form's file field.
+func readImport(c fiber.Ctx) ([]byte, error) {
+	header, err := c.FormFile(ui.FieldImportFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", importer.ErrNoFile, err)
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return nil, fmt.Errorf("opening imported file: %w", err)
+	}
+	defer func() { _ = file.Close() }() // Only read, so closing cannot lose data.
+
+	return importer.Read(file)
+}
+
+// ULA handles POST requests generating an RFC 4193 Unique Local Address prefix
+// from form data. The Global ID is derived from the current time and the MAC
+// address entered in the calculator form, or random if the random method is
+// chosen. It renders the /48 prefix and its /64 subnet with the given ID, which
+// the client can enter into the IPv6 prefix field. Errors are logged and
+// displayed like Plan's.
+func (h *Handler) ULA(c fiber.Ctx) error {
+	id, err := ula.ParseSubnetID(c.FormValue(ui.FieldULASubnet))
+	if err != nil {
+		return h.renderULAError(c, ui.FieldULASubnet, err)
+	}
+
+	var prefix netip.Prefix
+
+	if c.FormValue(ui.FieldULAMethod) == ui.ULAMethodRandom {
+		prefix, err = ula.Random(rand.Reader)
+	} else {
+		mac := c.FormValue(ui.FieldMAC)
+		if err := validators.ValidateMAC(mac); err != nil {
+			// The MAC address field belongs to the calculator form, whose
+			// errors are rendered elsewhere, so it is not marked invalid.
+			return h.renderULAError(c, "", err)
+		}
+
+		prefix, err = ula.Derive(mac, time.Now())
+	}
+
+	data := ui.ULAData{}
+
+	var chosen netip.Prefix
+	if err == nil {
+		chosen, err = ula.Subnet(prefix, id)
+	}
+
+	if err != nil {
+		data.Error = i18n.FromContext(c.Context()).T(errCalculationFailure)
+
+		slog.ErrorContext(
+			c.Context(),
+			"ULA prefix generation failed",
+			"error", err,
+		)
+
+		return h.renderULA(c, data)
+	}
+
+	data.Prefix = prefix.String()
+	data.Subnet = chosen.String()
+	data.Value = subnet.Hextets(chosen)
+
+	return h.renderULA(c, data)
+}
+
+// ValidateMAC handles GET requests validating the MAC address field as the user
+// types, as an address of the link type named by the link-type query parameter,
+// Ethernet if absent, rendering the field's inline validation message, see
+// renderFieldMessage.
+func (h *Handler) ValidateMAC(c fiber.Ctx) error {
+	link, err := eui64.ParseLinkType(c.Query(ui.FieldLinkType))
+
+	return h.renderFieldMessage(c, ui.FieldMAC, func(mac string) error {
+		if err != nil {
+			return err
+		}
+
+		return validators.ValidateLinkAddress(link, mac)
+	})
+}
+
+// ValidateIPv6Prefix handles GET requests validating the IPv6 prefix field as
+// the user types, rendering the field's inline validation message, see
+// renderFieldMessage.
+func (h *Handler) ValidateIPv6Prefix(c fiber.Ctx) error {
+	return h.renderFieldMessage(c, ui.FieldIPv6Prefix, validators.ValidateIPv6Prefix)
+}
+
+// InvalidCSRFToken responds to requests rejected by the CSRF middleware with a
+// 403 status, rendering the error like TooManyRequests does. It satisfies
+// fiber.ErrorHandler so it can be used as the middleware's ErrorHandler.
+func (h *Handler) InvalidCSRFToken(c fiber.Ctx, err error) error {
+	slog.DebugContext(
+		c.Context(),
+		"CSRF validation failed",
+		"error", err,
+	)
+
+	return h.renderError(c, http.StatusForbidden, errInvalidCSRFToken)
+}
+
+// TooManyRequests responds to requests rejected by the rate limiter.
+// The 429 status and Retry-After header are expected to be set by the limiter.
+func (h *Handler) TooManyRequests(c fiber.Ctx) error {
+	return h.renderError(c, http.StatusTooManyRequests, errTooManyRequests)
+}
+
+// renderError responds with the given status and error message, translated
+// into the request's locale. HTMX requests
+// receive the error rendered through the result template so it appears in place
+// of a calculation; other clients receive a JSON error body.
+//
+//nolint:wrapcheck // Returning Fiber response directly
+func (h *Handler) renderError(c fiber.Ctx, status int, key i18n.Key) error {
+	message := i18n.FromContext(c.Context()).T(key)
+
+	c.Status(status)
+
+	if isHTMXRequest(c) {
+		return h.renderResult(c, ui.ResultData{
+			InterfaceID:    "",
+			FullIP:         "",
+			Prefix:         classify.Classification{},
+			DUIDLL:         "",
+			DUIDLLT:        "",
+			Error:          message,
+			ErrorField:     "",
+			ErrorHighlight: nil,
+		})
+	}
+
+	return c.JSON(errorResponse{Error: message})
+}
+
+// renderFieldMessage validates the value of the named form field, read from
+// the query string, and renders the explanation of why it is invalid in the
+// request's locale. The message is empty when the value is valid or blank, as
+// required fields are only enforced when the form is submitted.
+//
+//nolint:wrapcheck // Returning Fiber response directly
+func (h *Handler) renderFieldMessage(c fiber.Ctx, field string, validate func(string) error) error {
+	value := c.Query(field)
+	message := ""
+
+	if strings.TrimSpace(value) != "" {
+		if err := validate(value); err != nil {
+			message = i18n.FromContext(c.Context()).Error(err)
+
+			slog.DebugContext(
+				c.Context(),
+				"Field validation failed",
+				"field", field,
+				"value", value,
+				"error", err,
+			)
+		}
+	}
+
+	var buf bytes.Buffer
+
+	if err := ui.FieldMessage(message).Render(c.Context(), &buf); err != nil {
+		slog.ErrorContext(
+			c.Context(),
+			"Failed to render field message",
+			"error", err,
+		)
+
+		return c.SendStatus(http.StatusInternalServerError)
+	}
+
+	c.Set("Content-Type", "text/html; charset=utf-8")
+
+	return c.Send(buf.Bytes())
+}
+
+// renderResult renders the calculation result to the HTTP response.
+// It uses the provided ResultData to display either the computed EUI-64 address
+// or an error message, returning a 500 status if rendering fails.
+//
+//nolint:wrapcheck // Returning Fiber response directly
+func (h *Handler) renderResult(c fiber.Ctx, data ui.ResultData) error {
+	var buf bytes.Buffer
+
+	err := ui.Result(data).Render(
+		c.Context(),
+		&buf,
+	)
+	if err != nil {
+		slog.ErrorContext(
+			c.Context(),
+			"Failed to render result",
+			"error", err,
+		)
+
+		return c.SendStatus(http.StatusInternalServerError)
+	}
+
+	c.Set("Content-Type", "text/html; charset=utf-8")
+
+	return c.Send(buf.Bytes())
+}
+
+// renderCalculation responds with the outcome of a calculation. API clients
+// preferring JSON receive a resultResponse, or an error body with the given
+// status if the calculation failed; everyone else receives the rendered result.
+//
+//nolint:wrapcheck // Returning Fiber response directly
+func (h *Handler) renderCalculation(c fiber.Ctx, data ui.ResultData, status int) error {
+	if !wantsJSON(c) {
+		return h.renderResult(c, data)
+	}
+
+	if data.Error != "" {
+		return c.Status(status).JSON(errorResponse{Error: data.Error})
+	}
+
+	return c.Status(status).JSON(resultResponse{
+		InterfaceID: data.InterfaceID,
+		FullIP:      data.FullIP,
+		DUIDLL:      data.DUIDLL,
+		DUIDLLT:     data.DUIDLLT,
+		Prefix:      newPrefixResponse(i18n.FromContext(c.Context()), data.Prefix),
+	})
+}
+
+// renderRange responds with the addresses of a range of MAC addresses, as a
+// rangeResponse to API clients preferring JSON and rendered as a table for
+// everyone else, returning a 500 status if rendering fails.
+//
+//nolint:wrapcheck // Returning Fiber response directly
+func (h *Handler) renderRange(c fiber.Ctx, data ui.RangeData) error {
+	if wantsJSON(c) {
+		addresses := make([]rangeAddress, 0, len(data.Addresses))
+		for _, address := range data.Addresses {
+			addresses = append(addresses, rangeAddress{
+				MAC:         address.MAC,
+				InterfaceID: address.InterfaceID,
+				FullIP:      address.FullIP,
+			})
+		}
+
+		return c.JSON(rangeResponse{
+			Addresses: addresses,
+			Prefix:    newPrefixResponse(i18n.FromContext(c.Context()), data.Prefix),
+		})
+	}
+
+	var buf bytes.Buffer
+
+	err := ui.RangeResult(data).Render(
+		c.Context(),
+		&buf,
+	)
+	if err != nil {
+		slog.ErrorContext(
+			c.Context(),
+			"Failed to render range",
+			"error", err,
+		)
+
+		return c.SendStatus(http.StatusInternalServerError)
+	}
+
+	c.Set("Content-Type", "text/html; charset=utf-8")
+
+	return c.Send(buf.Bytes())
+}
+
+// newPrefixResponse returns the classification of a prefix for API clients,
+// with its name and warning translated into the locale.
+func newPrefixResponse(locale *i18n.Locale, prefix classify.Classification) prefixResponse {
+	return prefixResponse{
+		Type:    prefix.Type,
+		Name:    locale.PrefixType(prefix.Type),
+		Range:   prefix.Range.String(),
+		SLAAC:   prefix.SLAAC,
+		Warning: prefix.Warning,
+		Message: locale.PrefixWarning(prefix.Warning),
+	}
+}
+
+// renderVerification renders the outcome of a verification, or the error that
+// prevented it, to the HTTP response, or returns it as JSON with the given
+// status to API clients preferring it.
+//
+//nolint:wrapcheck // Returning Fiber response directly
+func (h *Handler) renderVerification(c fiber.Ctx, result verify.Result, data ui.VerifyData, status int) error {
+	if wantsJSON(c) {
+		if data.Error != "" {
+			return c.Status(status).JSON(errorResponse{Error: data.Error})
+		}
+
+		return c.Status(status).JSON(verifyResponse{
+			Status:      result.Status,
+			Message:     data.Verdict,
+			Expected:    result.Expected,
+			InterfaceID: result.InterfaceID,
+			IIDType:     result.IIDType,
+			ObservedMAC: result.ObservedMAC,
+		})
+	}
+
+	var buf bytes.Buffer
+
+	err := ui.VerifyResult(data).Render(
+		c.Context(),
+		&buf,
+	)
+	if err != nil {
+		slog.ErrorContext(
+			c.Context(),
+			"Failed to render verification",
+			"error", err,
+		)
+
+		return c.SendStatus(http.StatusInternalServerError)
+	}
+
+	c.Set("Content-Type", "text/html; charset=utf-8")
+
+	return c.Send(buf.Bytes())
+}
+
+// renderImportError renders the explanation of why the import failed in place
+// of its table, marking the importer field it refers to and the offending part
+// of the prefix.
+func (h *Handler) renderImportError(c fiber.Ctx, field string, err error) error {
+	slog.DebugContext(
+		c.Context(),
+		"Import failed",
+		"field", field,
+		"error", err,
+	)
+
+	return h.renderImport(c, "", ui.ImportData{
+		Format:         "",
+		Rows:           nil,
+		Prefix:         classify.Classification{},
+		Error:          i18n.FromContext(c.Context()).Error(err),
+		ErrorField:     field,
+		ErrorHighlight: errorHighlight(err),
+	}, http.StatusBadRequest)
+}
+
+// renderImport renders the imported MAC addresses, or the error that prevented
+// the import, to the HTTP response, or returns them as JSON with the given
+// status to API clients preferring it, returning a 500 status if rendering
+// fails.
+//
+//nolint:wrapcheck // Returning Fiber response directly
+func (h *Handler) renderImport(c fiber.Ctx, format importer.Format, data ui.ImportData, status int) error {
+	if wantsJSON(c) {
+		if data.Error != "" {
+			return c.Status(status).JSON(errorResponse{Error: data.Error})
+		}
+
+		entries := make([]importEntry, 0, len(data.Rows))
+		for _, row := range data.Rows {
+			entries = append(entries, importEntry(row))
+		}
+
+		return c.Status(status).JSON(importResponse{
+			Format:  format,
+			Entries: entries,
+			Prefix:  newPrefixResponse(i18n.FromContext(c.Context()), data.Prefix),
+		})
+	}
+
+	var buf bytes.Buffer
+
+	err := ui.ImportResult(data).Render(
+		c.Context(),
+		&buf,
+	)
+	if err != nil {
+		slog.ErrorContext(
+			c.Context(),
+			"Failed to render import",
+			"error", err,
+		)
+
+		return c.SendStatus(http.StatusInternalServerError)
+	}
+
+	c.Set("Content-Type", "text/html; charset=utf-8")
+
+	return c.Send(buf.Bytes())
+}
+
+// renderRAError renders the explanation of why the named Router Advertisement
+// simulator field is invalid in place of its table, marking the offending part
+// of the MAC address.
+func (h *Handler) renderRAError(c fiber.Ctx, field string, err error) error {
+	slog.DebugContext(
+		c.Context(),
+		"Router Advertisement simulation validation failed",
+		"field", field,
+		"error", err,
+	)
+
+	return h.renderRA(c, eui64.Simulation{}, ui.RAData{
+		InterfaceID:    "",
+		Rows:           nil,
+		Error:          i18n.FromContext(c.Context()).Error(err),
+		ErrorField:     field,
+		ErrorHighlight: errorHighlight(err),
+	}, http.StatusBadRequest)
+}
+
+// renderRA renders the addresses a host forms from a Router Advertisement, or
+// the error that prevented the simulation, to the HTTP response, or returns
+// the simulation as JSON with the given status to API clients preferring it,
+// returning a 500 status if rendering fails.
+//
+//nolint:wrapcheck // Returning Fiber response directly
+func (h *Handler) renderRA(c fiber.Ctx, simulation eui64.Simulation, data ui.RAData, status int) error {
+	if wantsJSON(c) {
+		if data.Error != "" {
+			return c.Status(status).JSON(errorResponse{Error: data.Error})
+		}
+
+		return c.Status(status).JSON(newSimulationResponse(i18n.FromContext(c.Context()), simulation))
+	}
+
+	var buf bytes.Buffer
+
+	err := ui.RAResult(data).Render(
+		c.Context(),
+		&buf,
+	)
+	if err != nil {
+		slog.ErrorContext(
+			c.Context(),
+			"Failed to render Router Advertisement simulation",
+			"error", err,
+		)
+
+		return c.SendStatus(http.StatusInternalServerError)
+	}
+
+	c.Set("Content-Type", "text/html; charset=utf-8")
+
+	return c.Send(buf.Bytes())
+}
+
+// renderPlanError renders the explanation of why the named subnet planner field
+// is invalid in place of a plan, marking the offending part of its value.
+func (h *Handler) renderPlanError(c fiber.Ctx, field string, err error) error {
+	slog.DebugContext(
+		c.Context(),
+		"Subnet plan validation failed",
+		"field", field,
+		"error", err,
+	)
+
+	return h.renderPlan(c, ui.PlanData{
+		InterfaceID:    "",
+		Subnets:        nil,
+		Error:          i18n.FromContext(c.Context()).Error(err),
+		ErrorField:     field,
+		ErrorHighlight: errorHighlight(err),
+	})
+}
+
+// renderPlan renders the subnet plan to the HTTP response, returning a 500
+// status if rendering fails.
+//
+//nolint:wrapcheck // Returning Fiber response directly
+func (h *Handler) renderPlan(c fiber.Ctx, data ui.PlanData) error {
+	var buf bytes.Buffer
+
+	err := ui.PlanResult(data).Render(
+		c.Context(),
+		&buf,
+	)
+	if err != nil {
+		slog.ErrorContext(
+			c.Context(),
+			"Failed to render subnet plan",
+			"error", err,
+		)
+
+		return c.SendStatus(http.StatusInternalServerError)
+	}
+
+	c.Set("Content-Type", "text/html; charset=utf-8")
+
+	return c.Send(buf.Bytes())
+}
+
+// renderULAError renders the explanation of why the ULA generator's input is
+// invalid in place of a prefix, marking the offending part of its value and, if
+// given, the field it refers to.
+func (h *Handler) renderULAError(c fiber.Ctx, field string, err error) error {
+	slog.DebugContext(
+		c.Context(),
+		"ULA prefix validation failed",
+		"field", field,
+		"error", err,
+	)
+
+	return h.renderULA(c, ui.ULAData{
+		Prefix:         "",
+		Subnet:         "",
+		Value:          "",
+		Error:          i18n.FromContext(c.Context()).Error(err),
+		ErrorField:     field,
+		ErrorHighlight: errorHighlight(err),
+	})
+}
+
+// renderULA renders the generated ULA prefix to the HTTP response, returning a
+// 500 status if rendering fails.
+//
+//nolint:wrapcheck // Returning Fiber response directly
+func (h *Handler) renderULA(c fiber.Ctx, data ui.ULAData) error {
+	var buf bytes.Buffer
+
+	err := ui.ULAResult(data).Render(
+		c.Context(),
+		&buf,
+	)
+	if err != nil {
+		slog.ErrorContext(
+			c.Context(),
+			"Failed to render ULA prefix",
+			"error", err,
+		)
+
+		return c.SendStatus(http.StatusInternalServerError)
+	}
+
+	c.Set("Content-Type", "text/html; charset=utf-8")
+
+	return c.Send(buf.Bytes())
+}
+
+// Analyze handles GET requests to the address analyzer, decoding the address in
+// the query string, if any. HTMX requests receive the analysis, or the error
+// explaining why the address could not be analyzed, in place of the previous
+// one; other requests receive the analyzer page with the analysis rendered in it.
+func (h *Handler) Analyze(c fiber.Ctx) error {
+	address := c.Query(ui.FieldAnalyzerAddress)
+	data := ui.AnalysisData{
+		Address: address,
+		Facts:   nil,
+		Error:   "",
+		DUID:    ui.DUIDData{DUID: "", Facts: nil, Error: ""},
+	}
+
+	if address != "" || isHTMXRequest(c) {
+		locale := i18n.FromContext(c.Context())
+
+		analysis, err := analyzer.Analyze(address)
+		if err != nil {
+			data.Error = locale.Error(err)
+
+			slog.DebugContext(
+				c.Context(),
+				"Address analysis failed",
+				"address", address,
+				"error", err,
+			)
+		} else {
+			data.Facts = locale.Facts(analysis)
+		}
+	}
+
+	if isHTMXRequest(c) {
+		return h.renderAnalyzer(c, ui.AnalysisResult(data))
+	}
+
+	return h.renderAnalyzer(c, ui.AnalyzerPage(data))
+}
+
+// DecodeDUID handles GET requests to the DUID decoder, decoding the DHCPv6 DUID
+// in the query string, if any. HTMX requests receive the decoded DUID, or the
+// error explaining why it could not be decoded, in place of the previous one;
+// other requests receive the analyzer page with the decoded DUID rendered in it.
+func (h *Handler) DecodeDUID(c fiber.Ctx) error {
+	value := c.Query(ui.FieldDUID)
+	data := ui.DUIDData{DUID: value, Facts: nil, Error: ""}
+
+	if value != "" || isHTMXRequest(c) {
+		locale := i18n.FromContext(c.Context())
+
+		decoded, err := duid.Parse(value)
+		if err != nil {
+			data.Error = locale.Error(err)
+
+			slog.DebugContext(
+				c.Context(),
+				"DUID decoding failed",
+				"duid", value,
+				"error", err,
+			)
+		} else {
+			data.Facts = locale.DUIDFacts(decoded)
+		}
+	}
+
+	if isHTMXRequest(c) {
+		return h.renderAnalyzer(c, ui.DUIDResult(data))
+	}
+
+	return h.renderAnalyzer(c, ui.AnalyzerPage(ui.AnalysisData{Address: "", Facts: nil, Error: "", DUID: data}))
+}
+
+// renderAnalyzer renders the address analyzer page or an analysis to the HTTP
+// response, returning a 500 status if rendering fails.
+//
+//nolint:wrapcheck // Returning Fiber response directly
+func (h *Handler) renderAnalyzer(c fiber.Ctx, component templ.Component) error {
+	var buf bytes.Buffer
+
+	err := component.Render(
+		c.Context(),
+		&buf,
+	)
+	if err != nil {
+		slog.ErrorContext(
+			c.Context(),
+			"Failed to render address analysis",
+			"error", err,
+		)
+
+		return c.SendStatus(http.StatusInternalServerError)
+	}
+
+	c.Set("Content-Type", "text/html; charset=utf-8")
+
+	return c.Send(buf.Bytes())
+}
+
+// planRows returns the subnets of a plan as displayed in its table.
+func planRows(plan subnet.Plan) []ui.PlanRow {
+	rows := make([]ui.PlanRow, 0, len(plan.Subnets))
+	for _, planned := range plan.Subnets {
+		rows = append(rows, ui.PlanRow{
+			ID:      strconv.FormatUint(planned.ID, subnetIDBase),
+			Prefix:  planned.Prefix.String(),
+			Address: planned.Address,
+		})
+	}
+
+	return rows
+}
+
+// raRows returns the addresses a host forms from a Router Advertisement as
+// displayed in the simulator's table: its link-local address, which never
+// expires, followed by the outcome of each Prefix Information option.
+func raRows(locale *i18n.Locale, simulation eui64.Simulation) []ui.RARow {
+	rows := make([]ui.RARow, 0, len(simulation.Addresses)+1)
+	rows = append(rows, ui.RARow{
+		Prefix:     linkLocalPrefix,
+		Flags:      locale.T(i18n.KeyRALinkLocal),
+		Valid:      locale.Lifetime(eui64.InfiniteLifetime),
+		Preferred:  locale.Lifetime(eui64.InfiniteLifetime),
+		Address:    simulation.LinkLocal,
+		Deprecated: false,
+		Status:     "",
+	})
+
+	for _, simulated := range simulation.Addresses {
+		rows = append(rows, ui.RARow{
+			Prefix:     simulated.Prefix.String(),
+			Flags:      simulated.Flags(),
+			Valid:      locale.Lifetime(simulated.ValidLifetime),
+			Preferred:  locale.Lifetime(simulated.PreferredLifetime),
+			Address:    simulated.Address,
+			Deprecated: simulated.Deprecated,
+			Status:     locale.SLAACStatus(simulated.Status),
+		})
+	}
+
+	return rows
+}
+
+// newSimulationResponse returns the JSON body of a Router Advertisement
+// simulation, explaining in the locale why options form no address.
+func newSimulationResponse(locale *i18n.Locale, simulation eui64.Simulation) simulationResponse {
+	response := simulationResponse{
+		InterfaceID: simulation.InterfaceID,
+		LinkLocal:   simulation.LinkLocal,
+		Addresses:   make([]simulatedResponse, 0, len(simulation.Addresses)),
+	}
+
+	for _, simulated := range simulation.Addresses {
+		response.Addresses = append(response.Addresses, simulatedResponse{
+			Prefix:            simulated.Prefix.String(),
+			OnLink:            simulated.OnLink,
+			Autonomous:        simulated.Autonomous,
+			ValidLifetime:     simulated.ValidLifetime,
+			PreferredLifetime: simulated.PreferredLifetime,
+			Status:            simulated.Status,
+			Address:           simulated.Address,
+			Deprecated:        simulated.Deprecated,
+			Message:           locale.SLAACStatus(simulated.Status),
+		})
+	}
+
+	return response
+}
+
+// errorHighlight returns the highlight marking the part of the input a
+// validation error refers to, or nil if the error does not locate one.
+func errorHighlight(err error) *ui.Highlight {
+	var validationErr *validators.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Value == "" {
+		return nil
+	}
+
+	return &ui.Highlight{
+		Input:  validationErr.Input,
+		Offset: validationErr.Offset,
+		Length: len(validationErr.Value),
+	}
+}
+
+// wantsJSON reports whether the request comes from an API client preferring
+// JSON to HTML, as stated by its Accept header. HTMX requests never do.
+func wantsJSON(c fiber.Ctx) bool {
+	return !isHTMXRequest(c) && c.Accepts(fiber.MIMETextHTML, fiber.MIMEApplicationJSON) == fiber.MIMEApplicationJSON
+}
+
+// isHTMXRequest reports whether the request was issued by HTMX, which sets the
+// HX-Request header on every request it makes.
+func isHTMXRequest(c fiber.Ctx) bool {
+	return c.Get("HX-Request") == "true"
 }
@@ -1,21 +1,38 @@
 package handlers
 
 import (
+	"bytes"
+	"encoding/binary"
+	"html"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"net/netip"
 	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/csrf"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/nicholas-fedor/eui64-calculator/internal/classify"
+	"github.com/nicholas-fedor/eui64-calculator/internal/duid"
 	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+	"github.com/nicholas-fedor/eui64-calculator/internal/i18n"
+	"github.com/nicholas-fedor/eui64-calculator/internal/locale"
+	"github.com/nicholas-fedor/eui64-calculator/internal/ui"
+	"github.com/nicholas-fedor/eui64-calculator/internal/validators"
 )
 
 // setupRouter creates a Fiber app for testing handler functions.
-// It configures the app with the default EUI-64 calculator, setting up routes for home and calculate endpoints.
+// It configures the app with the default EUI-64 calculator, setting up routes for home,
+// calculate, plan, matrix, ULA, analyzer, DUID decoder, verification, import, and Router
+// Advertisement simulation endpoints.
 func setupRouter(t *testing.T) *fiber.App {
 	t.Helper()
 
@@ -23,6 +40,15 @@ func setupRouter(t *testing.T) *fiber.App {
 	handler := NewHandler(&eui64.DefaultCalculator{})
 	app.Get("/", handler.Home)
 	app.Post("/calculate", handler.Calculate)
+	app.Post("/plan", handler.Plan)
+	app.Post("/matrix", handler.Matrix)
+	app.Post("/ula", handler.ULA)
+	app.Get("/analyze", handler.Analyze)
+	app.Get("/duid", handler.DecodeDUID)
+	app.Post("/verify", handler.Verify)
+	app.Post("/verify/csv", handler.VerifyCSV)
+	app.Post("/import", handler.Import)
+	app.Post("/simulate", handler.Simulate)
 
 	return app
 }
@@ -94,6 +120,24 @@ func TestCalculateHandlerValid(t *testing.T) {
 			wantStatus: http.StatusOK,
 			wantBody:   "2001:db8::214:22ff:fe01:2345",
 		},
+		{
+			name: "Link-local prefix is classified",
+			formData: url.Values{
+				"mac":      {"00-14-22-01-23-45"},
+				"ip-start": {"fe80::"},
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `data-prefix-type="link_local"`,
+		},
+		{
+			name: "Multicast prefix is warned about",
+			formData: url.Values{
+				"mac":      {"00-14-22-01-23-45"},
+				"ip-start": {"ff02::"},
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   html.EscapeString(i18n.English.PrefixWarning(classify.WarningMulticast)),
+		},
 	}
 
 	for _, tt := range tests {
@@ -125,7 +169,9 @@ func TestCalculateHandlerValid(t *testing.T) {
 
 // TestCalculateHandlerInvalid tests the Calculate handler with invalid form inputs.
 // It verifies that the handler returns a 200 status with appropriate error messages
-// for malformed MAC addresses and IPv6 prefixes, ensuring proper validation feedback.
+// for malformed MAC addresses and IPv6 prefixes, ensuring proper validation feedback
+// that marks the offending part of the input when the error locates it,
+// and that each error names the offending field for assistive technologies.
 func TestCalculateHandlerInvalid(t *testing.T) {
 	t.Parallel()
 
@@ -134,6 +180,8 @@ func TestCalculateHandlerInvalid(t *testing.T) {
 		formData   url.Values
 		wantStatus int
 		wantBody   string
+		wantField  string
+		wantMark   string
 	}{
 		{
 			name: "Invalid MAC format",
@@ -142,7 +190,10 @@ func TestCalculateHandlerInvalid(t *testing.T) {
 				"ip-start": {"2001:0db8:85a3:0000"},
 			},
 			wantStatus: http.StatusOK,
-			wantBody:   "Please enter a valid MAC address (e.g., 00-14-22-01-23-45)",
+			wantBody: `The MAC address contains "i" at position 1, which is not a hexadecimal digit ` +
+				`or separator (e.g., 00-14-22-01-23-45)`,
+			wantField: ui.FieldMAC,
+			wantMark:  "i",
 		},
 		{
 			name: "MAC too short",
@@ -151,7 +202,9 @@ func TestCalculateHandlerInvalid(t *testing.T) {
 				"ip-start": {"2001:0db8:85a3:0000"},
 			},
 			wantStatus: http.StatusOK,
-			wantBody:   "Please enter a valid MAC address (e.g., 00-14-22-01-23-45)",
+			wantBody:   i18n.English.T(i18n.KeyErrMACMalformed),
+			wantField:  ui.FieldMAC,
+			wantMark:   "",
 		},
 		{
 			name: "Invalid prefix - too many hextets",
@@ -160,7 +213,9 @@ func TestCalculateHandlerInvalid(t *testing.T) {
 				"ip-start": {"2001:0db8:85a3:0000:0000"},
 			},
 			wantStatus: http.StatusOK,
-			wantBody:   "Please enter a valid IPv6 prefix (e.g., 2001:db8::)",
+			wantBody:   i18n.English.T(i18n.KeyErrPrefixTooLong),
+			wantField:  ui.FieldIPv6Prefix,
+			wantMark:   ":0000",
 		},
 		{
 			name: "Invalid prefix - empty hextet",
@@ -169,7 +224,9 @@ func TestCalculateHandlerInvalid(t *testing.T) {
 				"ip-start": {"2001::85a3"},
 			},
 			wantStatus: http.StatusOK,
-			wantBody:   "Please enter a valid IPv6 prefix (e.g., 2001:db8::)",
+			wantBody:   `Hextet 2 of the IPv6 prefix is empty, "::" at position 5 may only end the prefix (e.g., 2001:db8::)`,
+			wantField:  ui.FieldIPv6Prefix,
+			wantMark:   "::",
 		},
 		{
 			name: "Invalid prefix - invalid hextet",
@@ -178,7 +235,10 @@ func TestCalculateHandlerInvalid(t *testing.T) {
 				"ip-start": {"2001:invalid:85a3"},
 			},
 			wantStatus: http.StatusOK,
-			wantBody:   "Please enter a valid IPv6 prefix (e.g., 2001:db8::)",
+			wantBody: `The IPv6 prefix contains "i" at position 6, in hextet 2, which is not a ` +
+				`hexadecimal digit (e.g., 2001:db8::)`,
+			wantField: ui.FieldIPv6Prefix,
+			wantMark:  "i",
 		},
 	}
 
@@ -204,7 +264,1704 @@ func TestCalculateHandlerInvalid(t *testing.T) {
 			require.NoError(t, err)
 
 			assert.Equal(t, tt.wantStatus, resp.StatusCode)
-			assert.Contains(t, string(body), tt.wantBody)
+			assert.Contains(t, string(body), html.EscapeString(tt.wantBody))
+			assert.Contains(t, string(body), `data-error-field="`+tt.wantField+`"`)
+
+			if tt.wantMark == "" {
+				assert.NotContains(t, string(body), "<mark>", "Error should not mark the input")
+			} else {
+				assert.Contains(t, string(body), "<mark>"+tt.wantMark+"</mark>", "Error should mark the offending input")
+			}
+		})
+	}
+}
+
+// TestCalculateHandlerLocale tests that the Calculate handler renders errors in
+// the locale negotiated by the i18n middleware, falling back to English.
+func TestCalculateHandlerLocale(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		wantBody       string
+	}{
+		{
+			name:           "German",
+			acceptLanguage: "de-DE,de;q=0.9",
+			wantBody:       i18n.German.Error(validators.ValidateMAC("invalid-mac")),
+		},
+		{
+			name:           "French",
+			acceptLanguage: "fr-CA",
+			wantBody:       i18n.French.Error(validators.ValidateMAC("invalid-mac")),
+		},
+		{
+			name:           "Unsupported language",
+			acceptLanguage: "ja",
+			wantBody:       i18n.English.Error(validators.ValidateMAC("invalid-mac")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app := fiber.New()
+			app.Use(locale.New(locale.DefaultConfig()))
+			app.Post("/calculate", NewHandler(&eui64.DefaultCalculator{}).Calculate)
+
+			form := url.Values{"mac": {"invalid-mac"}, "ip-start": {"2001:db8::"}}
+			req, _ := http.NewRequestWithContext(
+				t.Context(),
+				http.MethodPost,
+				"http://localhost/calculate",
+				strings.NewReader(form.Encode()),
+			)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.Header.Set("Accept-Language", tt.acceptLanguage)
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(body), html.EscapeString(tt.wantBody))
+		})
+	}
+}
+
+// generatedLLT replaces the DUID-LLT of 00-14-22-01-23-45 in responses, as its
+// time is that of the request.
+const generatedLLT = "DUID-LLT"
+
+// lltPattern matches the DUID-LLT of 00-14-22-01-23-45 generated at any time.
+var lltPattern = regexp.MustCompile(`00:01:00:01(:[0-9a-f]{2}){4}:00:14:22:01:23:45`)
+
+// TestCalculateHandlerJSON tests the Calculate handler with API clients
+// preferring JSON, verifying that results include the classification of the
+// prefix in the request's locale and that errors are returned with a 400 status.
+func TestCalculateHandlerJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		formData   url.Values
+		accept     string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "Global unicast prefix",
+			formData:   url.Values{"mac": {"00-14-22-01-23-45"}, "ip-start": {"2a01:4f8:1:2"}},
+			accept:     fiber.MIMEApplicationJSON,
+			wantStatus: http.StatusOK,
+			wantBody: `{"interface_id":"0214:22ff:fe01:2345","ipv6_address":"2a01:4f8:1:2:214:22ff:fe01:2345",` +
+				`"duid_ll":"00:03:00:01:00:14:22:01:23:45","duid_llt":"` + generatedLLT + `",` +
+				`"prefix":{"type":"gua","name":"Global unicast (GUA)","range":"2000::/3","slaac":true}}`,
+		},
+		{
+			name:       "Documentation prefix",
+			formData:   url.Values{"mac": {"00-14-22-01-23-45"}, "ip-start": {"2001:db8::"}},
+			accept:     "application/json, text/html;q=0.5",
+			wantStatus: http.StatusOK,
+			wantBody: `{"interface_id":"0214:22ff:fe01:2345","ipv6_address":"2001:db8::214:22ff:fe01:2345",` +
+				`"duid_ll":"00:03:00:01:00:14:22:01:23:45","duid_llt":"` + generatedLLT + `",` +
+				`"prefix":{"type":"documentation","name":"Documentation","range":"2001:db8::/32","slaac":false,` +
+				`"warning":"documentation","message":` + strconv.Quote(i18n.English.PrefixWarning(classify.WarningDocumentation)) + `}}`,
+		},
+		{
+			name: "IEEE 802.15.4 short address",
+			formData: url.Values{
+				"mac": {"0x1a2b"}, "ip-start": {"2a01:4f8:1:2"}, "link-type": {"ieee802154-short"},
+			},
+			accept:     fiber.MIMEApplicationJSON,
+			wantStatus: http.StatusOK,
+			wantBody: `{"interface_id":"0000:00ff:fe00:1a2b","ipv6_address":"2a01:4f8:1:2:0:ff:fe00:1a2b",` +
+				`"duid_ll":"","duid_llt":"",` +
+				`"prefix":{"type":"gua","name":"Global unicast (GUA)","range":"2000::/3","slaac":true}}`,
+		},
+		{
+			name: "Bluetooth LE address",
+			formData: url.Values{
+				"mac": {"c2:14:22:01:23:45"}, "ip-start": {"2a01:4f8:1:2"}, "link-type": {"ble"},
+			},
+			accept:     fiber.MIMEApplicationJSON,
+			wantStatus: http.StatusOK,
+			wantBody: `{"interface_id":"c014:22ff:fe01:2345","ipv6_address":"2a01:4f8:1:2:c014:22ff:fe01:2345",` +
+				`"duid_ll":"","duid_llt":"",` +
+				`"prefix":{"type":"gua","name":"Global unicast (GUA)","range":"2000::/3","slaac":true}}`,
+		},
+		{
+			name: "Interface ID token",
+			formData: url.Values{
+				"mac": {"::1:2"}, "ip-start": {"2a01:4f8:1:2"}, "link-type": {"token"},
+			},
+			accept:     fiber.MIMEApplicationJSON,
+			wantStatus: http.StatusOK,
+			wantBody: `{"interface_id":"0000:0000:0001:0002","ipv6_address":"2a01:4f8:1:2::1:2",` +
+				`"duid_ll":"","duid_llt":"",` +
+				`"prefix":{"type":"gua","name":"Global unicast (GUA)","range":"2000::/3","slaac":true}}`,
+		},
+		{
+			name: "Invalid extended address",
+			formData: url.Values{
+				"mac": {"00:12:4b:00:01:02:03:0z"}, "ip-start": {"2a01:4f8:1:2"}, "link-type": {"ieee802154-extended"},
+			},
+			accept:     fiber.MIMEApplicationJSON,
+			wantStatus: http.StatusBadRequest,
+			wantBody: `{"error":` + strconv.Quote(i18n.English.Error(
+				validators.ValidateExtendedAddress("00:12:4b:00:01:02:03:0z"),
+			)) + `}`,
+		},
+		{
+			name: "Unknown link type",
+			formData: url.Values{
+				"mac": {"00-14-22-01-23-45"}, "ip-start": {"2a01:4f8:1:2"}, "link-type": {"token-ring"},
+			},
+			accept:     fiber.MIMEApplicationJSON,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":` + strconv.Quote(i18n.English.T(i18n.KeyErrLinkTypeUnknown)) + `}`,
+		},
+		{
+			name: "Range of Bluetooth LE addresses",
+			formData: url.Values{
+				"mac": {"c2:14:22:01:23:45"}, "mac-count": {"4"}, "ip-start": {"2a01:4f8:1:2"}, "link-type": {"ble"},
+			},
+			accept:     fiber.MIMEApplicationJSON,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":` + strconv.Quote(i18n.English.T(i18n.KeyErrLinkTypeRange)) + `}`,
+		},
+		{
+			name:       "Invalid prefix",
+			formData:   url.Values{"mac": {"00-14-22-01-23-45"}, "ip-start": {"2001:db8:85a3:g000"}},
+			accept:     fiber.MIMEApplicationJSON,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":` + strconv.Quote(i18n.English.Error(validators.ValidateIPv6Prefix("2001:db8:85a3:g000"))) + `}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			app := setupRouter(t)
+
+			req, _ := http.NewRequestWithContext(
+				t.Context(),
+				http.MethodPost,
+				"http://localhost/calculate",
+				strings.NewReader(tt.formData.Encode()),
+			)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.Header.Set("Accept", tt.accept)
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			assert.JSONEq(t, tt.wantBody, lltPattern.ReplaceAllString(string(body), generatedLLT))
+		})
+	}
+}
+
+// linkCalculator is a Calculator recording the link types of the addresses it
+// calculates, so tests can verify that handlers route them through it.
+type linkCalculator struct {
+	eui64.DefaultCalculator
+
+	links []eui64.LinkType
+}
+
+// CalculateLinkAddress records the link type and delegates to the default
+// calculator.
+func (l *linkCalculator) CalculateLinkAddress(link eui64.LinkType, address, prefix string) (string, string, error) {
+	l.links = append(l.links, link)
+
+	return l.DefaultCalculator.CalculateLinkAddress(link, address, prefix)
+}
+
+// TestCalculateHandlerCalculator tests that the Calculate handler calculates
+// the addresses of every link type with the handler's calculator.
+func TestCalculateHandlerCalculator(t *testing.T) {
+	t.Parallel()
+
+	addresses := map[eui64.LinkType]string{
+		eui64.LinkEthernet:           "00-14-22-01-23-45",
+		eui64.LinkIEEE802154Short:    "0x1a2b",
+		eui64.LinkIEEE802154Extended: "00:12:4b:00:01:02:03:04",
+		eui64.LinkBLE:                "c2:14:22:01:23:45",
+		eui64.LinkToken:              "::1:2",
+	}
+
+	for _, link := range eui64.LinkTypes {
+		t.Run(string(link), func(t *testing.T) {
+			t.Parallel()
+
+			calc := &linkCalculator{DefaultCalculator: eui64.DefaultCalculator{}, links: nil}
+			app := fiber.New()
+			app.Post("/calculate", NewHandler(calc).Calculate)
+
+			form := url.Values{"mac": {addresses[link]}, "ip-start": {"2001:db8::"}, ui.FieldLinkType: {string(link)}}
+			req, _ := http.NewRequestWithContext(
+				t.Context(),
+				http.MethodPost,
+				"http://localhost/calculate",
+				strings.NewReader(form.Encode()),
+			)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, []eui64.LinkType{link}, calc.links)
+		})
+	}
+}
+
+// TestDUIDs tests that duids builds the DUID-LL and DUID-LLT of a MAC address
+// and reports MAC addresses they cannot be built from.
+func TestDUIDs(t *testing.T) {
+	t.Parallel()
+
+	generated := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	ll, llt, err := duids("00-14-22-01-23-45", duid.HardwareEthernet, generated)
+	require.NoError(t, err)
+	assert.Equal(t, "00:03:00:01:00:14:22:01:23:45", ll)
+	assert.Equal(t, "00:01:00:01:25:9e:9d:80:00:14:22:01:23:45", llt)
+
+	ll, llt, err = duids("00-14-22-01-23-45", 6, generated)
+	require.NoError(t, err)
+	assert.Equal(t, "00:03:00:06:00:14:22:01:23:45", ll, "Hardware type")
+	assert.Equal(t, "00:01:00:06:25:9e:9d:80:00:14:22:01:23:45", llt, "Hardware type")
+
+	_, _, err = duids("invalid", duid.HardwareEthernet, time.Now())
+	require.ErrorIs(t, err, eui64.ErrParseMAC)
+
+	_, _, err = duids("00-14-22-01-23-45", duid.HardwareEthernet, duid.Epoch.Add(-time.Second))
+	require.ErrorIs(t, err, duid.ErrTime)
+}
+
+// TestCalculateHandlerDUIDs tests that the Calculate handler builds the DUIDs
+// of Ethernet MAC addresses with the hardware type and time entered, and that
+// invalid ones are explained by an error naming their field.
+func TestCalculateHandlerDUIDs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		hardware    string
+		time        string
+		wantContain []string
+	}{
+		{
+			name:        "Hardware type and time",
+			hardware:    "6",
+			time:        "2020-01-01T00:00:00Z",
+			wantContain: []string{"00:03:00:06:00:14:22:01:23:45", "00:01:00:06:25:9e:9d:80:00:14:22:01:23:45"},
+		},
+		{
+			name:        "Seconds since 2000",
+			hardware:    "",
+			time:        "631152000",
+			wantContain: []string{"00:03:00:01:00:14:22:01:23:45", "00:01:00:01:25:9e:9d:80:00:14:22:01:23:45"},
+		},
+		{
+			name:     "Invalid hardware type",
+			hardware: "ethernet",
+			time:     "",
+			wantContain: []string{
+				`data-error-field="` + ui.FieldDUIDHardware + `"`,
+				html.EscapeString(i18n.English.T(i18n.KeyErrDUIDHardware)),
+			},
+		},
+		{
+			name:     "Invalid time",
+			hardware: "",
+			time:     "yesterday",
+			wantContain: []string{
+				`data-error-field="` + ui.FieldDUIDTime + `"`,
+				html.EscapeString(i18n.English.T(i18n.KeyErrDUIDTimeSyntax)),
+			},
+		},
+		{
+			name:     "Time before 2000",
+			hardware: "",
+			time:     "1999-12-31T23:59:59Z",
+			wantContain: []string{
+				`data-error-field="` + ui.FieldDUIDTime + `"`,
+				html.EscapeString(i18n.English.T(i18n.KeyErrDUIDTime)),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			app := setupRouter(t)
+
+			form := url.Values{
+				"mac":                {"00-14-22-01-23-45"},
+				"ip-start":           {"2001:db8::"},
+				ui.FieldDUIDHardware: {tt.hardware},
+				ui.FieldDUIDTime:     {tt.time},
+			}
+			req, _ := http.NewRequestWithContext(
+				t.Context(),
+				http.MethodPost,
+				"http://localhost/calculate",
+				strings.NewReader(form.Encode()),
+			)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			for _, want := range tt.wantContain {
+				assert.Contains(t, string(body), want)
+			}
+		})
+	}
+}
+
+// TestCalculateHandlerRange tests the Calculate handler with MAC ranges given by
+// an end address, a count, or a block length, verifying that their addresses are
+// returned in order as a table or JSON, that invalid ranges are explained by an
+// error naming their field, and that the handler's maximum applies.
+func TestCalculateHandlerRange(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		formData    url.Values
+		accept      string
+		wantStatus  int
+		wantContain []string
+	}{
+		{
+			name: "End address",
+			formData: url.Values{
+				"mac":              {"00:14:22:01:23:fe"},
+				ui.FieldMACEnd:     {"00:14:22:01:24:01"},
+				ui.FieldIPv6Prefix: {"2001:db8::"},
+			},
+			accept:     "",
+			wantStatus: http.StatusOK,
+			wantContain: []string{
+				`class="range-table"`,
+				"<code>00-14-22-01-23-ff</code>",
+				"<code>2001:db8::214:22ff:fe01:2400</code>",
+				"<code>00-14-22-01-24-01</code>",
+				`data-prefix-type="documentation"`,
+			},
+		},
+		{
+			name: "JSON count",
+			formData: url.Values{
+				"mac":              {"00-14-22-01-23-45"},
+				ui.FieldMACCount:   {"2"},
+				ui.FieldIPv6Prefix: {"2001:db8::"},
+			},
+			accept:     fiber.MIMEApplicationJSON,
+			wantStatus: http.StatusOK,
+			wantContain: []string{
+				`{"addresses":[{"mac":"00-14-22-01-23-45","interface_id":"0214:22ff:fe01:2345","ipv6_address":"2001:db8::214:22ff:fe01:2345"},` +
+					`{"mac":"00-14-22-01-23-46","interface_id":"0214:22ff:fe01:2346","ipv6_address":"2001:db8::214:22ff:fe01:2346"}]`,
+				`"type":"documentation"`,
+			},
+		},
+		{
+			name: "End before start",
+			formData: url.Values{
+				"mac":              {"00-14-22-01-23-45"},
+				ui.FieldMACEnd:     {"00-14-22-01-23-00"},
+				ui.FieldIPv6Prefix: {"2001:db8::"},
+			},
+			accept:      "",
+			wantStatus:  http.StatusOK,
+			wantContain: []string{`data-error-field="mac-end"`, i18n.English.T(i18n.KeyErrRangeEndBeforeStart)},
+		},
+		{
+			name: "Invalid start",
+			formData: url.Values{
+				"mac":              {"00-14-22-01-23-4g"},
+				ui.FieldMACCount:   {"2"},
+				ui.FieldIPv6Prefix: {"2001:db8::"},
+			},
+			accept:      "",
+			wantStatus:  http.StatusOK,
+			wantContain: []string{`data-error-field="mac"`, "<mark>g</mark>"},
+		},
+		{
+			name: "Invalid prefix",
+			formData: url.Values{
+				"mac":              {"00-14-22-01-23-45"},
+				ui.FieldMACCount:   {"2"},
+				ui.FieldIPv6Prefix: {"2001:db8:85a3:g000"},
+			},
+			accept:      "",
+			wantStatus:  http.StatusOK,
+			wantContain: []string{`data-error-field="ip-start"`},
+		},
+		{
+			name: "JSON block above maximum",
+			formData: url.Values{
+				"mac":              {"00-14-22-01-23-45"},
+				ui.FieldMACEnd:     {"/40"},
+				ui.FieldIPv6Prefix: {"2001:db8::"},
+			},
+			accept:      fiber.MIMEApplicationJSON,
+			wantStatus:  http.StatusBadRequest,
+			wantContain: []string{`{"error":` + strconv.Quote(i18n.English.T(i18n.KeyErrRangeTooLarge, 16)) + `}`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app := fiber.New()
+			app.Post("/calculate", NewHandler(&eui64.DefaultCalculator{}, WithMaxRange(16)).Calculate)
+
+			req, _ := http.NewRequestWithContext(
+				t.Context(),
+				http.MethodPost,
+				"http://localhost/calculate",
+				strings.NewReader(tt.formData.Encode()),
+			)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+
+			for _, want := range tt.wantContain {
+				assert.Contains(t, string(body), want)
+			}
+		})
+	}
+}
+
+// TestPlanHandler tests the Plan handler with valid and invalid form inputs.
+// It verifies that a valid plan renders a table row per subnet with the host's
+// address in it, and that each invalid field is explained by an error naming it.
+func TestPlanHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		formData  url.Values
+		wantBody  []string
+		wantField string
+	}{
+		{
+			name: "Range of subnets of a /56",
+			formData: url.Values{
+				ui.FieldPlanMAC:    {"00-14-22-01-23-45"},
+				ui.FieldPlanParent: {"2001:db8:1:ab00::/56"},
+				ui.FieldPlanIDs:    {"9-a, ff"},
+			},
+			wantBody: []string{
+				`<code class="plan-interface-id">0214:22ff:fe01:2345</code>`,
+				"<td>9</td><td><code>2001:db8:1:ab09::/64</code></td><td><code>2001:db8:1:ab09:214:22ff:fe01:2345</code></td>",
+				"<td>a</td><td><code>2001:db8:1:ab0a::/64</code></td>",
+				"<td>ff</td><td><code>2001:db8:1:abff::/64</code></td>",
+			},
+			wantField: "",
+		},
+		{
+			name: "Invalid MAC",
+			formData: url.Values{
+				ui.FieldPlanMAC:    {"00-14-22-01-23-4g"},
+				ui.FieldPlanParent: {"2001:db8:1::/48"},
+				ui.FieldPlanIDs:    {"1"},
+			},
+			wantBody:  []string{"<mark>g</mark>"},
+			wantField: ui.FieldPlanMAC,
+		},
+		{
+			name: "Parent prefix too long",
+			formData: url.Values{
+				ui.FieldPlanMAC:    {"00-14-22-01-23-45"},
+				ui.FieldPlanParent: {"2001:db8:1:2::/64"},
+				ui.FieldPlanIDs:    {"1"},
+			},
+			wantBody:  []string{html.EscapeString(i18n.English.T(i18n.KeyErrParentTooLong))},
+			wantField: ui.FieldPlanParent,
+		},
+		{
+			name: "Subnet ID out of range",
+			formData: url.Values{
+				ui.FieldPlanMAC:    {"00-14-22-01-23-45"},
+				ui.FieldPlanParent: {"2001:db8:1:ab00::/56"},
+				ui.FieldPlanIDs:    {"1, 100"},
+			},
+			wantBody:  []string{html.EscapeString(i18n.English.T(i18n.KeyErrSubnetIDOutOfRange))},
+			wantField: ui.FieldPlanIDs,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			app := setupRouter(t)
+
+			req, _ := http.NewRequestWithContext(
+				t.Context(),
+				http.MethodPost,
+				"http://localhost/plan",
+				strings.NewReader(tt.formData.Encode()),
+			)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+			for _, want := range tt.wantBody {
+				assert.Contains(t, string(body), want)
+			}
+
+			if tt.wantField == "" {
+				assert.NotContains(t, string(body), "data-error-field", "Plan should not report an error")
+			} else {
+				assert.Contains(t, string(body), `id="`+ui.PlanErrorMessageID+`"`)
+				assert.Contains(t, string(body), `data-error-field="`+tt.wantField+`"`)
+			}
+		})
+	}
+}
+
+// TestMatrixHandler tests the Matrix handler's response to POST requests. It
+// verifies that every MAC address and prefix pair is streamed as a CSV
+// attachment with invalid values explained in the request's locale, and that
+// empty lists are rejected with a 400 status and a JSON error.
+func TestMatrixHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		formData    url.Values
+		language    string
+		wantStatus  int
+		wantRows    []string
+		wantMessage string
+	}{
+		{
+			name: "Every combination",
+			formData: url.Values{
+				ui.FieldMatrixMACs:     {"00-14-22-01-23-45\r\n00-14-22-01-23-46"},
+				ui.FieldMatrixPrefixes: {"fd00:1:2:3, 2001:db8::"},
+			},
+			language:   "en",
+			wantStatus: http.StatusOK,
+			wantRows: []string{
+				"mac,prefix,interface_id,ipv6_address,error",
+				"00-14-22-01-23-45,fd00:1:2:3,0214:22ff:fe01:2345,fd00:1:2:3:214:22ff:fe01:2345,",
+				"00-14-22-01-23-45,2001:db8::,0214:22ff:fe01:2345,2001:db8::214:22ff:fe01:2345,",
+				"00-14-22-01-23-46,fd00:1:2:3,0214:22ff:fe01:2346,fd00:1:2:3:214:22ff:fe01:2346,",
+				"00-14-22-01-23-46,2001:db8::,0214:22ff:fe01:2346,2001:db8::214:22ff:fe01:2346,",
+			},
+			wantMessage: "",
+		},
+		{
+			name: "Per-cell errors in the request's locale",
+			formData: url.Values{
+				ui.FieldMatrixMACs:     {"00-14-22-01-23-45"},
+				ui.FieldMatrixPrefixes: {"2001:db8::\n2001:db8:85a3:g000"},
+			},
+			language:   "de",
+			wantStatus: http.StatusOK,
+			wantRows: []string{
+				"mac,prefix,interface_id,ipv6_address,error",
+				"00-14-22-01-23-45,2001:db8::,0214:22ff:fe01:2345,2001:db8::214:22ff:fe01:2345,",
+				`00-14-22-01-23-45,2001:db8:85a3:g000,,,"Das IPv6-Präfix enthält an Position 15, in Hextet 4, ""g"", das keine hexadezimale Ziffer ist (z. B. 2001:db8::)"`,
+			},
+			wantMessage: "",
+		},
+		{
+			name: "No MAC addresses",
+			formData: url.Values{
+				ui.FieldMatrixMACs:     {" , "},
+				ui.FieldMatrixPrefixes: {"2001:db8::"},
+			},
+			language:    "en",
+			wantStatus:  http.StatusBadRequest,
+			wantRows:    nil,
+			wantMessage: i18n.English.T(i18n.KeyErrMatrixNoMACs),
+		},
+		{
+			name: "No prefixes",
+			formData: url.Values{
+				ui.FieldMatrixMACs: {"00-14-22-01-23-45"},
+			},
+			language:    "en",
+			wantStatus:  http.StatusBadRequest,
+			wantRows:    nil,
+			wantMessage: i18n.English.T(i18n.KeyErrMatrixNoPrefixes),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app := fiber.New()
+			app.Use(locale.New(locale.DefaultConfig()))
+			app.Post("/matrix", NewHandler(&eui64.DefaultCalculator{}).Matrix)
+
+			req, _ := http.NewRequestWithContext(
+				t.Context(),
+				http.MethodPost,
+				"http://localhost/matrix",
+				strings.NewReader(tt.formData.Encode()),
+			)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.Header.Set("Accept-Language", tt.language)
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+
+			if tt.wantRows == nil {
+				assert.JSONEq(t, `{"error":`+strconv.Quote(tt.wantMessage)+`}`, string(body))
+
+				return
+			}
+
+			assert.Equal(t, "text/csv; charset=utf-8", resp.Header.Get("Content-Type"))
+			assert.Contains(t, resp.Header.Get("Content-Disposition"), "eui64-matrix.csv")
+			assert.Equal(t, strings.Join(tt.wantRows, "\n")+"\n", string(body))
+		})
+	}
+}
+
+// TestULAHandler tests the ULA handler's response to POST requests. It verifies
+// that derived and random prefixes are /48s of fd00::/8 rendered with the chosen
+// /64 subnet and its value for the IPv6 prefix field, and that an invalid MAC
+// address or subnet ID is explained in place of a prefix.
+func TestULAHandler(t *testing.T) {
+	t.Parallel()
+
+	ulaPattern := regexp.MustCompile(
+		`<code class="ula-prefix">([0-9a-f:/]+)</code>.*` +
+			`<code class="ula-subnet">([0-9a-f:/]+)</code>.*data-use-prefix="([0-9a-f:]+)"`,
+	)
+
+	tests := []struct {
+		name       string
+		formData   url.Values
+		wantSubnet uint16
+		wantError  string
+		wantField  string
+	}{
+		{
+			name: "Derived from the MAC address",
+			formData: url.Values{
+				ui.FieldMAC:       {"00-14-22-01-23-45"},
+				ui.FieldULAMethod: {ui.ULAMethodDerived},
+				ui.FieldULASubnet: {"1"},
+			},
+			wantSubnet: 1,
+			wantError:  "",
+			wantField:  "",
+		},
+		{
+			name: "Random with the first subnet",
+			formData: url.Values{
+				ui.FieldMAC:       {""},
+				ui.FieldULAMethod: {ui.ULAMethodRandom},
+				ui.FieldULASubnet: {""},
+			},
+			wantSubnet: 0,
+			wantError:  "",
+			wantField:  "",
+		},
+		{
+			name: "Derived without a MAC address",
+			formData: url.Values{
+				ui.FieldULAMethod: {ui.ULAMethodDerived},
+				ui.FieldULASubnet: {"1"},
+			},
+			wantSubnet: 0,
+			wantError:  i18n.English.T(i18n.KeyErrMACRequired),
+			wantField:  "",
+		},
+		{
+			name: "Invalid subnet ID",
+			formData: url.Values{
+				ui.FieldMAC:       {"00-14-22-01-23-45"},
+				ui.FieldULAMethod: {ui.ULAMethodDerived},
+				ui.FieldULASubnet: {"1g"},
+			},
+			wantSubnet: 0,
+			wantError:  i18n.English.T(i18n.KeyErrULASubnetID),
+			wantField:  ui.FieldULASubnet,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			app := setupRouter(t)
+
+			req, _ := http.NewRequestWithContext(
+				t.Context(),
+				http.MethodPost,
+				"http://localhost/ula",
+				strings.NewReader(tt.formData.Encode()),
+			)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+			if tt.wantError != "" {
+				assert.Contains(t, string(body), html.EscapeString(tt.wantError))
+				assert.Contains(t, string(body), `id="`+ui.ULAErrorMessageID+`"`)
+
+				if tt.wantField == "" {
+					assert.NotContains(t, string(body), "data-error-field")
+				} else {
+					assert.Contains(t, string(body), `data-error-field="`+tt.wantField+`"`)
+				}
+
+				return
+			}
+
+			match := ulaPattern.FindStringSubmatch(string(body))
+			require.NotNil(t, match, "ULA prefix not found in %s", body)
+
+			prefix := netip.MustParsePrefix(match[1])
+			assert.Equal(t, 48, prefix.Bits(), "Prefix should be a /48")
+			assert.Equal(t, byte(0xfd), prefix.Addr().As16()[0], "Prefix should be in fd00::/8")
+
+			chosen := netip.MustParsePrefix(match[2])
+			assert.Equal(t, 64, chosen.Bits(), "Subnet should be a /64")
+			assert.True(t, prefix.Contains(chosen.Addr()), "Subnet should be in the prefix")
+			assert.Equal(t, tt.wantSubnet, binary.BigEndian.Uint16(chosen.Addr().AsSlice()[6:8]), "Incorrect subnet ID")
+
+			_, address, err := eui64.CalculateEUI64("00-14-22-01-23-45", match[3])
+			require.NoError(t, err, "Prefix field value should be a valid prefix")
+			assert.True(t, chosen.Contains(netip.MustParseAddr(address)), "Prefix field value should be the subnet")
+		})
+	}
+}
+
+// TestAnalyzeHandler tests the Analyze handler, verifying that the analyzer page
+// renders with or without an address, that HTMX requests receive the analysis
+// alone, and that invalid addresses are explained by an error naming the field.
+func TestAnalyzeHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		address     string
+		htmx        bool
+		wantPage    bool
+		wantContain []string
+	}{
+		{
+			name:        "Page without address",
+			address:     "",
+			htmx:        false,
+			wantPage:    true,
+			wantContain: []string{`data-analyzer-form`},
+		},
+		{
+			name:        "Page with address",
+			address:     "fe80::214:22ff:fe01:2345",
+			htmx:        false,
+			wantPage:    true,
+			wantContain: []string{`value="fe80::214:22ff:fe01:2345"`, "<code>00-14-22-01-23-45</code>"},
+		},
+		{
+			name:        "HTMX analysis",
+			address:     "2001:0:4136:e378:8000:63bf:3fff:fdd2",
+			htmx:        true,
+			wantPage:    false,
+			wantContain: []string{"<code>65.54.227.120</code>", "<code>40000</code>"},
+		},
+		{
+			name:        "HTMX invalid address",
+			address:     "192.0.2.1",
+			htmx:        true,
+			wantPage:    false,
+			wantContain: []string{`id="analyzer-error"`, `data-error-field="address"`, html.EscapeString(i18n.English.T(i18n.KeyErrAnalyzerNotIPv6))},
+		},
+		{
+			name:        "HTMX blank address",
+			address:     "",
+			htmx:        true,
+			wantPage:    false,
+			wantContain: []string{html.EscapeString(i18n.English.T(i18n.KeyErrAnalyzerRequired))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			app := setupRouter(t)
+
+			req, _ := http.NewRequestWithContext(
+				t.Context(),
+				http.MethodGet,
+				"http://localhost/analyze?"+url.Values{"address": {tt.address}}.Encode(),
+				nil,
+			)
+			if tt.htmx {
+				req.Header.Set("HX-Request", "true")
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, tt.wantPage, strings.Contains(string(body), "<!doctype html>"), "Full page rendered")
+
+			for _, want := range tt.wantContain {
+				assert.Contains(t, string(body), want)
+			}
+		})
+	}
+}
+
+// TestDecodeDUIDHandler tests the DecodeDUID handler, verifying that the
+// analyzer page renders with the decoded DUID, that HTMX requests receive the
+// decoded DUID alone, and that invalid DUIDs are explained by an error naming
+// the field.
+func TestDecodeDUIDHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		duid        string
+		htmx        bool
+		wantPage    bool
+		wantContain []string
+	}{
+		{
+			name:        "Page with DUID-LL",
+			duid:        "00:03:00:01:00:14:22:01:23:45",
+			htmx:        false,
+			wantPage:    true,
+			wantContain: []string{`data-analyzer-form`, `value="00:03:00:01:00:14:22:01:23:45"`, "<code>00-14-22-01-23-45</code>"},
+		},
+		{
+			name:        "HTMX DUID-LLT",
+			duid:        "00-01-00-01-25-9a-2c-00-00-14-22-01-23-45",
+			htmx:        true,
+			wantPage:    false,
+			wantContain: []string{"<code>" + i18n.English.T(i18n.KeyHardwareEthernet, 1) + "</code>", "<code>00-14-22-01-23-45</code>"},
+		},
+		{
+			name:        "HTMX unknown type",
+			duid:        "00:09:00:01",
+			htmx:        true,
+			wantPage:    false,
+			wantContain: []string{`id="duid-error"`, `data-error-field="duid"`, html.EscapeString(i18n.English.T(i18n.KeyErrDUIDUnknownType))},
+		},
+		{
+			name:        "HTMX blank DUID",
+			duid:        "",
+			htmx:        true,
+			wantPage:    false,
+			wantContain: []string{html.EscapeString(i18n.English.T(i18n.KeyErrDUIDSyntax))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			app := setupRouter(t)
+
+			req, _ := http.NewRequestWithContext(
+				t.Context(),
+				http.MethodGet,
+				"http://localhost/duid?"+url.Values{"duid": {tt.duid}}.Encode(),
+				nil,
+			)
+			if tt.htmx {
+				req.Header.Set("HX-Request", "true")
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, tt.wantPage, strings.Contains(string(body), "<!doctype html>"), "Full page rendered")
+
+			for _, want := range tt.wantContain {
+				assert.Contains(t, string(body), want)
+			}
+		})
+	}
+}
+
+// TestVerifyHandler tests the Verify handler, verifying that matches and each
+// kind of mismatch are explained, that API clients receive the outcome as JSON,
+// and that invalid values are explained by an error naming their field.
+func TestVerifyHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		formData    url.Values
+		accept      string
+		wantStatus  int
+		wantContain []string
+	}{
+		{
+			name: "Match",
+			formData: url.Values{
+				ui.FieldVerifyMAC:     {"00-14-22-01-23-45"},
+				ui.FieldVerifyAddress: {"2001:db8:1:2:214:22ff:fe01:2345"},
+			},
+			accept:      "",
+			wantStatus:  http.StatusOK,
+			wantContain: []string{`data-verify-status="match"`, html.EscapeString(i18n.English.T(i18n.KeyVerifyMatch))},
+		},
+		{
+			name: "Prefix mismatch",
+			formData: url.Values{
+				ui.FieldVerifyMAC:     {"00-14-22-01-23-45"},
+				ui.FieldVerifyAddress: {"2001:db8:1:2:214:22ff:fe01:2345"},
+				ui.FieldVerifyPrefix:  {" 2001:db8:1:3 "},
+			},
+			accept:      "",
+			wantStatus:  http.StatusOK,
+			wantContain: []string{`data-verify-status="prefix_mismatch"`, "<code>2001:db8:1:3:214:22ff:fe01:2345</code>"},
+		},
+		{
+			name: "Privacy address",
+			formData: url.Values{
+				ui.FieldVerifyMAC:     {"00-14-22-01-23-45"},
+				ui.FieldVerifyAddress: {"2001:db8:1:2:a1b2:c3d4:e5f6:789"},
+			},
+			accept:      "",
+			wantStatus:  http.StatusOK,
+			wantContain: []string{`data-verify-status="not_eui64"`},
+		},
+		{
+			name: "Invalid prefix",
+			formData: url.Values{
+				ui.FieldVerifyMAC:     {"00-14-22-01-23-45"},
+				ui.FieldVerifyAddress: {"2001:db8:1:2:214:22ff:fe01:2345"},
+				ui.FieldVerifyPrefix:  {"2001:db8:85a3:g000"},
+			},
+			accept:      "",
+			wantStatus:  http.StatusOK,
+			wantContain: []string{`id="verify-error"`, `data-error-field="verify-prefix"`, "<mark>g</mark>"},
+		},
+		{
+			name: "Multicast address",
+			formData: url.Values{
+				ui.FieldVerifyMAC:     {"00-14-22-01-23-45"},
+				ui.FieldVerifyAddress: {"ff02::1"},
+			},
+			accept:      "",
+			wantStatus:  http.StatusOK,
+			wantContain: []string{`data-error-field="verify-address"`, html.EscapeString(i18n.English.T(i18n.KeyErrVerifyNoInterfaceID))},
+		},
+		{
+			name: "JSON interface ID mismatch",
+			formData: url.Values{
+				ui.FieldVerifyMAC:     {"00-14-22-01-23-45"},
+				ui.FieldVerifyAddress: {"fe80::214:22ff:fe01:2346"},
+			},
+			accept:     fiber.MIMEApplicationJSON,
+			wantStatus: http.StatusOK,
+			wantContain: []string{
+				`"status":"iid_mismatch"`,
+				`"expected_address":"fe80::214:22ff:fe01:2345"`,
+				`"observed_mac":"00-14-22-01-23-46"`,
+			},
+		},
+		{
+			name: "JSON invalid MAC",
+			formData: url.Values{
+				ui.FieldVerifyMAC:     {""},
+				ui.FieldVerifyAddress: {"fe80::214:22ff:fe01:2345"},
+			},
+			accept:      fiber.MIMEApplicationJSON,
+			wantStatus:  http.StatusBadRequest,
+			wantContain: []string{`{"error":` + strconv.Quote(i18n.English.T(i18n.KeyErrMACRequired)) + `}`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			app := setupRouter(t)
+
+			req, _ := http.NewRequestWithContext(
+				t.Context(),
+				http.MethodPost,
+				"http://localhost/verify",
+				strings.NewReader(tt.formData.Encode()),
+			)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+
+			for _, want := range tt.wantContain {
+				assert.Contains(t, string(body), want)
+			}
+		})
+	}
+}
+
+// TestVerifyCSVHandler tests the VerifyCSV handler, verifying that pairs from the
+// form's CSV field or a text/csv request body are streamed back as CSV with the
+// outcome of each, and that malformed CSV is rejected with a JSON error.
+func TestVerifyCSVHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		wantStatus  int
+		wantRows    []string
+		wantMessage string
+	}{
+		{
+			name:        "Form field",
+			contentType: "application/x-www-form-urlencoded",
+			body: url.Values{ui.FieldVerifyCSV: {
+				"mac,address,prefix\n00-14-22-01-23-45,fe80::214:22ff:fe01:2345\n00-14-22-01-23-45,2001:db8::53,2001:db8::",
+			}}.Encode(),
+			wantStatus: http.StatusOK,
+			wantRows: []string{
+				"mac,address,prefix,status,expected_address,interface_id,iid_type,observed_mac,error",
+				"00-14-22-01-23-45,fe80::214:22ff:fe01:2345,,match,fe80::214:22ff:fe01:2345,0214:22ff:fe01:2345,eui64,00-14-22-01-23-45,",
+				"00-14-22-01-23-45,2001:db8::53,2001:db8::,not_eui64,2001:db8::214:22ff:fe01:2345,0000:0000:0000:0053,low_byte,,",
+			},
+			wantMessage: "",
+		},
+		{
+			name:        "CSV body",
+			contentType: "text/csv; charset=utf-8",
+			body:        "00-14-22,fe80::1\n",
+			wantStatus:  http.StatusOK,
+			wantRows: []string{
+				"mac,address,prefix,status,expected_address,interface_id,iid_type,observed_mac,error",
+				"00-14-22,fe80::1,,,,,,," + strconv.Quote(i18n.English.Error(validators.ValidateMAC("00-14-22"))),
+			},
+			wantMessage: "",
+		},
+		{
+			name:        "Missing address",
+			contentType: "text/csv",
+			body:        "00-14-22-01-23-45\n",
+			wantStatus:  http.StatusBadRequest,
+			wantRows:    nil,
+			wantMessage: i18n.English.T(i18n.KeyErrVerifyCSVFields),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			app := setupRouter(t)
+
+			req, _ := http.NewRequestWithContext(
+				t.Context(),
+				http.MethodPost,
+				"http://localhost/verify/csv",
+				strings.NewReader(tt.body),
+			)
+			req.Header.Set("Content-Type", tt.contentType)
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+
+			if tt.wantRows == nil {
+				assert.JSONEq(t, `{"error":`+strconv.Quote(tt.wantMessage)+`}`, string(body))
+
+				return
+			}
+
+			assert.Contains(t, resp.Header.Get("Content-Disposition"), "eui64-verification.csv")
+			assert.Equal(t, strings.Join(tt.wantRows, "\n")+"\n", string(body))
+		})
+	}
+}
+
+// TestValidationHandlers tests the live field validation handlers. It verifies
+// that invalid values are explained in the request's locale, escaped as HTML,
+// and that valid and blank values render an empty message.
+func TestValidationHandlers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		path           string
+		value          string
+		acceptLanguage string
+		want           string
+	}{
+		{
+			name:           "Valid MAC address",
+			path:           "/validate/mac?mac=",
+			value:          "00:14:22:01:23:45",
+			acceptLanguage: "",
+			want:           "",
+		},
+		{
+			name:           "Dotted MAC address",
+			path:           "/validate/mac?mac=",
+			value:          "0014.2201.2345",
+			acceptLanguage: "",
+			want:           "",
+		},
+		{
+			name:           "Malformed MAC address",
+			path:           "/validate/mac?mac=",
+			value:          "00:14:22:01:23:zz",
+			acceptLanguage: "",
+			want: `The MAC address contains "z" at position 16, which is not a hexadecimal digit ` +
+				`or separator (e.g., 00-14-22-01-23-45)`,
+		},
+		{
+			name:           "MAC address too long",
+			path:           "/validate/mac?mac=",
+			value:          "00:14:22:01:23:45:67",
+			acceptLanguage: "de",
+			want:           i18n.German.T(i18n.KeyErrMACTooLong),
+		},
+		{
+			name:           "Short address",
+			path:           "/validate/mac?link-type=ieee802154-short&mac=",
+			value:          "0x1a2b",
+			acceptLanguage: "",
+			want:           "",
+		},
+		{
+			name:           "MAC address as extended address",
+			path:           "/validate/mac?link-type=ieee802154-extended&mac=",
+			value:          "00:14:22:01:23:45",
+			acceptLanguage: "es",
+			want:           i18n.Spanish.T(i18n.KeyErrExtendedAddressMalformed),
+		},
+		{
+			name:           "Interface ID token of five hextets",
+			path:           "/validate/mac?link-type=token&mac=",
+			value:          "1:2:3:4:5",
+			acceptLanguage: "fr",
+			want:           i18n.French.T(i18n.KeyErrTokenMalformed),
+		},
+		{
+			name:           "Unknown link type",
+			path:           "/validate/mac?link-type=token-ring&mac=",
+			value:          "00:14:22:01:23:45",
+			acceptLanguage: "",
+			want:           i18n.English.T(i18n.KeyErrLinkTypeUnknown),
+		},
+		{
+			name:           "Blank MAC address",
+			path:           "/validate/mac?mac=",
+			value:          "  ",
+			acceptLanguage: "",
+			want:           "",
+		},
+		{
+			name:           "Prefix with trailing ::",
+			path:           "/validate/ip-start?ip-start=",
+			value:          "2001:db8:85a3:0::",
+			acceptLanguage: "",
+			want:           "",
+		},
+		{
+			name:           "Prefix with empty hextet",
+			path:           "/validate/ip-start?ip-start=",
+			value:          "2001::85a3:0",
+			acceptLanguage: "",
+			want:           i18n.English.Error(validators.ValidateIPv6Prefix("2001::85a3:0")),
+		},
+		{
+			name:           "Prefix with invalid character",
+			path:           "/validate/ip-start?ip-start=",
+			value:          "2001:db8:<g>",
+			acceptLanguage: "fr",
+			want:           i18n.French.Error(validators.ValidateIPv6Prefix("2001:db8:<g>")),
+		},
+		{
+			name:           "Missing prefix",
+			path:           "/validate/ip-start?ip-start=",
+			value:          "",
+			acceptLanguage: "",
+			want:           "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := NewHandler(&eui64.DefaultCalculator{})
+			app := fiber.New()
+			app.Use(locale.New(locale.DefaultConfig()))
+			app.Get("/validate/mac", handler.ValidateMAC)
+			app.Get("/validate/ip-start", handler.ValidateIPv6Prefix)
+
+			req, _ := http.NewRequestWithContext(
+				t.Context(),
+				http.MethodGet,
+				"http://localhost"+tt.path+url.QueryEscape(tt.value),
+				nil,
+			)
+			req.Header.Set("HX-Request", "true")
+			req.Header.Set("Accept-Language", tt.acceptLanguage)
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, "text/html; charset=utf-8", resp.Header.Get("Content-Type"))
+			assert.Equal(t, html.EscapeString(tt.want), string(body))
+		})
+	}
+}
+
+// TestTooManyRequests tests the TooManyRequests handler's content negotiation.
+// It verifies that HTMX requests receive the error rendered as an HTML result
+// fragment and other clients receive a JSON error body, both with a 429 status.
+func TestTooManyRequests(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		htmx            bool
+		wantContentType string
+		wantBody        string
+	}{
+		{
+			name:            "HTMX request renders result fragment",
+			htmx:            true,
+			wantContentType: "text/html; charset=utf-8",
+			wantBody:        `<p class="error-message" id="result-error" role="alert">` + i18n.English.T(errTooManyRequests) + `</p>`,
+		},
+		{
+			name:            "API request returns JSON",
+			htmx:            false,
+			wantContentType: "application/json; charset=utf-8",
+			wantBody:        `{"error":"` + i18n.English.T(errTooManyRequests) + `"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app := fiber.New()
+			handler := NewHandler(&eui64.DefaultCalculator{})
+			app.Post("/calculate", handler.TooManyRequests)
+
+			req, _ := http.NewRequestWithContext(
+				t.Context(),
+				http.MethodPost,
+				"http://localhost/calculate",
+				http.NoBody,
+			)
+			if tt.htmx {
+				req.Header.Set("HX-Request", "true")
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+			assert.Equal(t, tt.wantContentType, resp.Header.Get("Content-Type"))
+			assert.Equal(t, tt.wantBody, string(body))
+		})
+	}
+}
+
+// TestInvalidCSRFToken tests the InvalidCSRFToken error handler.
+// It verifies that rejected requests receive a 403 status with the error rendered
+// as an HTML result fragment for HTMX and as JSON for other clients.
+func TestInvalidCSRFToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		htmx     bool
+		wantBody string
+	}{
+		{
+			name:     "HTMX request renders result fragment",
+			htmx:     true,
+			wantBody: `<p class="error-message" id="result-error" role="alert">` + i18n.English.T(errInvalidCSRFToken) + `</p>`,
+		},
+		{
+			name:     "API request returns JSON",
+			htmx:     false,
+			wantBody: `{"error":"` + i18n.English.T(errInvalidCSRFToken) + `"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app := fiber.New()
+			handler := NewHandler(&eui64.DefaultCalculator{})
+			app.Post("/calculate", func(c fiber.Ctx) error {
+				return handler.InvalidCSRFToken(c, csrf.ErrTokenNotFound)
+			})
+
+			req, _ := http.NewRequestWithContext(
+				t.Context(),
+				http.MethodPost,
+				"http://localhost/calculate",
+				http.NoBody,
+			)
+			if tt.htmx {
+				req.Header.Set("HX-Request", "true")
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+			assert.Equal(t, tt.wantBody, string(body))
+		})
+	}
+}
+
+// TestImportHandler tests the Import handler's response to POST requests with
+// uploaded lease files and neighbor tables, including files that cannot be
+// imported and invalid prefixes.
+func TestImportHandler(t *testing.T) {
+	t.Parallel()
+
+	const leases = "1700000000 00:14:22:01:23:45 192.0.2.10 printer *\n" +
+		"1700000100 00:14:22:01:23:46 192.0.2.11 * *\n"
+
+	tests := []struct {
+		name        string
+		file        string
+		format      string
+		prefix      string
+		accept      string
+		wantStatus  int
+		wantContain []string
+	}{
+		{
+			name:       "Detected dnsmasq leases",
+			file:       leases,
+			format:     "auto",
+			prefix:     "2001:db8::",
+			accept:     "",
+			wantStatus: http.StatusOK,
+			wantContain: []string{
+				html.EscapeString(i18n.English.T(i18n.KeyImportCaption, 2, "dnsmasq leases")),
+				"<td>printer</td>",
+				"<code>2001:db8::214:22ff:fe01:2345</code>",
+				"<code>2001:db8::214:22ff:fe01:2346</code>",
+			},
+		},
+		{
+			name:       "Invalid MAC address explained in its row",
+			file:       "fe80::1 dev eth0 lladdr 00:14:22:01:23:45:67:89 STALE\n",
+			format:     "ip-neigh",
+			prefix:     "2001:db8::",
+			accept:     "",
+			wantStatus: http.StatusOK,
+			wantContain: []string{
+				`class="import-row-error"`,
+				html.EscapeString(i18n.English.T(i18n.KeyErrMACTooLong)),
+			},
+		},
+		{
+			name:        "No file",
+			file:        "",
+			format:      "auto",
+			prefix:      "2001:db8::",
+			accept:      "",
+			wantStatus:  http.StatusOK,
+			wantContain: []string{`id="import-error"`, `data-error-field="import-file"`},
+		},
+		{
+			name:        "Undetected format",
+			file:        "hello world\n",
+			format:      "",
+			prefix:      "2001:db8::",
+			accept:      "",
+			wantStatus:  http.StatusOK,
+			wantContain: []string{html.EscapeString(i18n.English.T(i18n.KeyErrImportUndetected))},
+		},
+		{
+			name:        "Invalid prefix",
+			file:        leases,
+			format:      "dnsmasq",
+			prefix:      "2001:db8:85a3:g000",
+			accept:      "",
+			wantStatus:  http.StatusOK,
+			wantContain: []string{`data-error-field="import-prefix"`, "<mark>g</mark>"},
+		},
+		{
+			name:       "JSON",
+			file:       `[{"dst":"fe80::1","dev":"eth0","lladdr":"00:14:22:01:23:45","state":["STALE"]}]`,
+			format:     "auto",
+			prefix:     "fe80::",
+			accept:     fiber.MIMEApplicationJSON,
+			wantStatus: http.StatusOK,
+			wantContain: []string{
+				`"format":"ip-neigh-json"`,
+				`{"line":1,"mac":"00-14-22-01-23-45","interface_id":"0214:22ff:fe01:2345","ipv6_address":"fe80::214:22ff:fe01:2345"}`,
+				`"type":"link_local"`,
+			},
+		},
+		{
+			name:        "JSON invalid file",
+			file:        `[{"lladdr":`,
+			format:      "ip-neigh-json",
+			prefix:      "fe80::",
+			accept:      fiber.MIMEApplicationJSON,
+			wantStatus:  http.StatusBadRequest,
+			wantContain: []string{`{"error":` + strconv.Quote(i18n.English.T(i18n.KeyErrImportInvalid)) + `}`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			app := setupRouter(t)
+
+			var form bytes.Buffer
+
+			writer := multipart.NewWriter(&form)
+			if tt.file != "" {
+				part, err := writer.CreateFormFile(ui.FieldImportFile, "leases")
+				require.NoError(t, err)
+				_, err = part.Write([]byte(tt.file))
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, writer.WriteField(ui.FieldImportFormat, tt.format))
+			require.NoError(t, writer.WriteField(ui.FieldImportPrefix, tt.prefix))
+			require.NoError(t, writer.Close())
+
+			req, _ := http.NewRequestWithContext(
+				t.Context(),
+				http.MethodPost,
+				"http://localhost/import",
+				&form,
+			)
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+
+			for _, want := range tt.wantContain {
+				assert.Contains(t, string(body), want)
+			}
+		})
+	}
+}
+
+// TestSimulateHandler tests the Simulate handler's response to POST requests,
+// verifying the link-local address, the address formed in each autonomous /64,
+// the explanations of options forming none, and invalid input.
+func TestSimulateHandler(t *testing.T) {
+	t.Parallel()
+
+	const prefixes = "2001:db8:1::/64 LA 3600 0\n2001:db8:2::/56\n2001:db8:3::/64 L infinite infinite\n"
+
+	tests := []struct {
+		name        string
+		mac         string
+		prefixes    string
+		accept      string
+		wantStatus  int
+		wantContain []string
+	}{
+		{
+			name:       "Table",
+			mac:        "00-14-22-01-23-45",
+			prefixes:   prefixes,
+			accept:     "",
+			wantStatus: http.StatusOK,
+			wantContain: []string{
+				`<code class="ra-interface-id">0214:22ff:fe01:2345</code>`,
+				"<td><code>fe80::/64</code></td><td>link-local</td><td>infinite</td><td>infinite</td>" +
+					"<td><code>fe80::214:22ff:fe01:2345</code> </td>",
+				"<td><code>2001:db8:1::/64</code></td><td>LA</td><td>3600 s</td><td>0 s</td>" +
+					`<td><code>2001:db8:1:0:214:22ff:fe01:2345</code> <span class="ra-deprecated">deprecated</span></td>`,
+				`<td><code>2001:db8:2::/56</code></td><td>LA</td><td>2592000 s</td><td>604800 s</td><td class="ra-row-status">` +
+					html.EscapeString(i18n.English.T(i18n.KeyRAStatusNot64)),
+				`<td>L</td><td>infinite</td><td>infinite</td><td class="ra-row-status">` +
+					html.EscapeString(i18n.English.T(i18n.KeyRAStatusNotAutonomous)),
+			},
+		},
+		{
+			name:        "Invalid MAC",
+			mac:         "00-14-22-01-23-4g",
+			prefixes:    prefixes,
+			accept:      "",
+			wantStatus:  http.StatusOK,
+			wantContain: []string{`id="ra-error"`, `data-error-field="ra-mac"`, "<mark>g</mark>"},
+		},
+		{
+			name:        "Invalid line",
+			mac:         "00-14-22-01-23-45",
+			prefixes:    "2001:db8:1::/64\n2001:db8:2::/64 LX\n",
+			accept:      "",
+			wantStatus:  http.StatusOK,
+			wantContain: []string{`data-error-field="ra-prefixes"`, html.EscapeString(i18n.English.T(i18n.KeyErrRALine, 2, i18n.English.T(i18n.KeyErrRAFlags)))},
+		},
+		{
+			name:       "JSON",
+			mac:        "00-14-22-01-23-45",
+			prefixes:   prefixes,
+			accept:     fiber.MIMEApplicationJSON,
+			wantStatus: http.StatusOK,
+			wantContain: []string{
+				`"interface_id":"0214:22ff:fe01:2345","link_local_address":"fe80::214:22ff:fe01:2345"`,
+				`{"prefix":"2001:db8:1::/64","on_link":true,"autonomous":true,"valid_lifetime":3600,"preferred_lifetime":0,` +
+					`"status":"formed","ipv6_address":"2001:db8:1:0:214:22ff:fe01:2345","deprecated":true}`,
+				`"valid_lifetime":4294967295,"preferred_lifetime":4294967295,"status":"not_autonomous","message":`,
+			},
+		},
+		{
+			name:        "JSON without prefixes",
+			mac:         "00-14-22-01-23-45",
+			prefixes:    "\n",
+			accept:      fiber.MIMEApplicationJSON,
+			wantStatus:  http.StatusBadRequest,
+			wantContain: []string{`{"error":` + strconv.Quote(i18n.English.T(i18n.KeyErrRARequired)) + `}`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			app := setupRouter(t)
+
+			form := url.Values{ui.FieldRAMAC: {tt.mac}, ui.FieldRAPrefixes: {tt.prefixes}}
+			req, _ := http.NewRequestWithContext(
+				t.Context(),
+				http.MethodPost,
+				"http://localhost/simulate",
+				strings.NewReader(form.Encode()),
+			)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+
+			for _, want := range tt.wantContain {
+				assert.Contains(t, string(body), want)
+			}
 		})
 	}
 }
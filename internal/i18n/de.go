@@ -0,0 +1,314 @@
+package i18n
+
+// german is the German catalog.
+var german = Messages{
+	KeyAppTitle:       "EUI-64-Rechner",
+	KeyThemeLabel:     "Farbschema",
+	KeyThemeSystem:    "System",
+	KeyThemeLight:     "Hell",
+	KeyThemeDark:      "Dunkel",
+	KeyLanguageLabel:  "Sprache",
+	KeyLanguageSubmit: "Übernehmen",
+
+	KeyAppDescription: "Geben Sie eine MAC-Adresse und ein IPv6-Präfix ein, um die EUI-64-Adresse zu berechnen.",
+	KeyMACLabel:       "MAC-Adresse",
+	KeyMACTitle: "Die MAC-Adresse muss das Format xx-xx-xx-xx-xx-xx oder xx:xx:xx:xx:xx:xx haben " +
+		"(z. B. 00-14-22-01-23-45 oder 00:14:22:01:23:45)",
+	KeyMACHint:     "Sechs Paare hexadezimaler Ziffern, getrennt durch Bindestriche oder Doppelpunkte.",
+	KeyCopyMAC:     "MAC-Adresse kopieren",
+	KeyPrefixLabel: "Anfang der IPv6-Adresse",
+	KeyPrefixTitle: "Das IPv6-Präfix darf höchstens 4 Hextets umfassen (z. B. 2001:db8::)",
+	KeyPrefixHint:  "Bis zu vier Gruppen hexadezimaler Ziffern, getrennt durch Doppelpunkte.",
+	KeyCopyPrefix:  "IPv6-Präfix kopieren",
+	KeyCalculate:   "Berechnen",
+	KeyClear:       "Leeren",
+	KeyCopy:        "Kopieren",
+	KeyCopied:      "In die Zwischenablage kopiert",
+
+	KeyLinkTypeLabel:    "Verbindungstyp",
+	KeyLinkTypeHint:     "Die Art der Link-Layer-Adresse, die im Feld MAC-Adresse eingegeben wird, oder ein unverändert verwendetes Interface-ID-Token wie bei ip token.",
+	KeyLinkTypeEthernet: "Ethernet (48-Bit-MAC-Adresse)",
+	KeyLinkTypeShort:    "IEEE 802.15.4 / Thread, Kurzadresse (16 Bit)",
+	KeyLinkTypeExtended: "IEEE 802.15.4 / Thread, erweiterte Adresse (64 Bit)",
+	KeyLinkTypeBLE:      "Bluetooth-LE-Geräteadresse (48 Bit)",
+	KeyLinkTypeToken:    "Interface-ID-Token (z. B. ::1:2)",
+
+	KeyShortcutsTitle:      "Tastenkürzel",
+	KeyShortcutFocusMAC:    "Zum Feld MAC-Adresse springen",
+	KeyShortcutFocusPrefix: "Zum Feld IPv6-Präfix springen",
+	KeyShortcutCopyResult:  "Die berechnete IPv6-Adresse kopieren",
+	KeyShortcutClearForm:   "Formular und Ergebnis leeren",
+
+	KeyInterfaceIDLabel: "Ende der IPv6-Adresse",
+	KeyCopyInterfaceID:  "Interface-ID kopieren",
+	KeyFullIPLabel:      "IPv6-Adresse",
+	KeyCopyFullIP:       "IPv6-Adresse kopieren",
+	KeyDUIDLLLabel:      "DUID-LL",
+	KeyDUIDLLTLabel:     "DUID-LLT",
+
+	KeyPrefixTypeLabel:             "Präfixtyp",
+	KeyPrefixTypeGUA:               "Global Unicast (GUA)",
+	KeyPrefixTypeULA:               "Unique Local (ULA)",
+	KeyPrefixTypeLinkLocal:         "Link-Local",
+	KeyPrefixTypeDocumentation:     "Dokumentation",
+	KeyPrefixTypeMulticast:         "Multicast",
+	KeyPrefixType6to4:              "6to4",
+	KeyPrefixTypeTeredo:            "Teredo",
+	KeyPrefixTypeReserved:          "Reserviert",
+	KeyPrefixWarningMulticast:      "Multicast-Präfixe adressieren Gruppen, keine Schnittstellen; Hosts können darin keine EUI-64-Adressen bilden.",
+	KeyPrefixWarningLinkLocalBits:  "Link-Local-Adressen verwenden fe80::/64; für SLAAC müssen die Bits nach fe80 null sein.",
+	KeyPrefixWarningULAUnassigned:  "fc00::/8 ist reserviert; lokal vergebene ULA-Präfixe beginnen mit fd.",
+	KeyPrefixWarningDocumentation:  "Dieses Präfix ist für Dokumentation und Beispiele reserviert und wird in echten Netzen nicht verwendet.",
+	KeyPrefixWarningTeredo:         "Teredo-Adressen enthalten IPv4-Adressen anstelle einer EUI-64-Schnittstellenkennung.",
+	KeyPrefixWarningDeprecated6to4: "6to4 ist veraltet (RFC 7526); verwenden Sie besser ein natives globales Präfix.",
+	KeyPrefixWarningReserved:       "Dieses Präfix ist nicht für Unicast-Adressen vergeben; Hosts können darin keine EUI-64-Adressen bilden.",
+
+	KeyPlanTitle:         "Subnetzplan",
+	KeyPlanDescription:   "Geben Sie eine MAC-Adresse, ein übergeordnetes Präfix wie ein /48 oder /56 und Subnetz-IDs ein, um die EUI-64-Adresse in jedem /64-Subnetz zu berechnen.",
+	KeyPlanParentLabel:   "Übergeordnetes Präfix",
+	KeyPlanParentTitle:   "Das übergeordnete Präfix muss ein IPv6-Präfix kürzer als /64 in CIDR-Notation sein (z. B. 2001:db8:1::/48)",
+	KeyPlanParentHint:    "Ein IPv6-Präfix in CIDR-Notation kürzer als /64, etwa ein /48 oder /56.",
+	KeyPlanIDsLabel:      "Subnetz-IDs",
+	KeyPlanIDsTitle:      "Subnetz-IDs müssen hexadezimale Zahlen oder Bereiche sein, getrennt durch Kommas (z. B. 1, a-f)",
+	KeyPlanIDsHint:       "Hexadezimale Subnetz-IDs, wie sie in der Adresse stehen, oder Bereiche wie 10-1f, getrennt durch Kommas.",
+	KeyPlanSubmit:        "Subnetze planen",
+	KeyPlanCaption:       "EUI-64-Adressen der Interface-ID",
+	KeyPlanIDHeader:      "Subnetz-ID",
+	KeyPlanPrefixHeader:  "Subnetz",
+	KeyPlanAddressHeader: "IPv6-Adresse",
+
+	KeyMatrixTitle:         "Adressmatrix",
+	KeyMatrixDescription:   "Geben Sie mehrere MAC-Adressen und IPv6-Präfixe ein, um die EUI-64-Adresse jeder MAC-Adresse in jedem Präfix als CSV-Datei herunterzuladen.",
+	KeyMatrixMACsLabel:     "MAC-Adressen",
+	KeyMatrixMACsHint:      "MAC-Adressen, getrennt durch Kommas oder in eigenen Zeilen.",
+	KeyMatrixPrefixesLabel: "IPv6-Präfixe",
+	KeyMatrixPrefixesHint:  "IPv6-Präfixe mit bis zu 4 Hextets, getrennt durch Kommas oder in eigenen Zeilen.",
+	KeyMatrixSubmit:        "CSV herunterladen",
+
+	KeyULATitle:         "ULA-Präfix erzeugen",
+	KeyULADescription:   "Erzeugen Sie ein eindeutiges lokales /48-Präfix nach RFC 4193 und wählen Sie eines seiner /64-Subnetze als IPv6-Präfix.",
+	KeyULAMethodLabel:   "Globale ID",
+	KeyULAMethodDerived: "Abgeleitet aus der Uhrzeit und der oben eingegebenen MAC-Adresse",
+	KeyULAMethodRandom:  "Zufällig",
+	KeyULASubnetLabel:   "Subnetz-ID",
+	KeyULASubnetHint:    "Eine hexadezimale Subnetz-ID von 0 bis ffff, 0 wenn leer.",
+	KeyULASubmit:        "Erzeugen",
+	KeyULAPrefixLabel:   "ULA-Präfix",
+	KeyULASubnetPrefix:  "Subnetz",
+	KeyULAUse:           "Als IPv6-Präfix verwenden",
+
+	KeyAnalyzerTitle:            "IPv6-Adressanalyse",
+	KeyAnalyzerDescription:      "Geben Sie eine beliebige IPv6-Adresse ein, um Gültigkeitsbereich, Präfixtyp, Schnittstellenkennung und eingebettete IPv4-Adressen zu entschlüsseln.",
+	KeyAnalyzerAddressLabel:     "IPv6-Adresse",
+	KeyAnalyzerAddressHint:      "Eine beliebige IPv6-Adresse, optional mit Zone (z. B. 2001:db8::214:22ff:fe01:2345 oder fe80::1%eth0)",
+	KeyAnalyzerSubmit:           "Analysieren",
+	KeyAnalyzerLink:             "IPv6-Adresse analysieren",
+	KeyAnalyzerBack:             "Zurück zum EUI-64-Rechner",
+	KeyDUIDDecoderTitle:         "DHCPv6-DUID entschlüsseln",
+	KeyDUIDDecoderDescription:   "Geben Sie eine DHCPv6-DUID ein, etwa aus den Reservierungen oder Leases eines DHCPv6-Servers, um ihren Typ und ihre Bestandteile zu entschlüsseln und die MAC-Adresse von Link-Layer-DUIDs zu ermitteln.",
+	KeyDUIDLabel:                "DUID",
+	KeyDUIDHint:                 "Hexadezimale Bytes, optional durch Doppelpunkte getrennt (z. B. 00:03:00:01:00:14:22:01:23:45)",
+	KeyDUIDSubmit:               "Entschlüsseln",
+	KeyFactAddress:              "Adresse",
+	KeyFactExpanded:             "Ausgeschrieben",
+	KeyFactScope:                "Gültigkeitsbereich",
+	KeyFactInterfaceID:          "Schnittstellenkennung",
+	KeyFactIIDType:              "Art der Schnittstellenkennung",
+	KeyFactMAC:                  "MAC-Adresse",
+	KeyFactIPv46to4:             "6to4-IPv4-Adresse",
+	KeyFactIPv4ISATAP:           "ISATAP-IPv4-Adresse",
+	KeyFactIPv4Mapped:           "IPv4-gemappte Adresse",
+	KeyFactIPv4NAT64:            "NAT64-IPv4-Adresse (RFC 6052)",
+	KeyFactNAT64Local:           "Lokales NAT64-Präfix (RFC 8215)",
+	KeyFactTeredoServer:         "Teredo-Server",
+	KeyFactTeredoClient:         "Teredo-Client",
+	KeyFactTeredoPort:           "Port des Teredo-Clients",
+	KeyFactTeredoNAT:            "Teredo-NAT",
+	KeyFactDUIDType:             "DUID-Typ",
+	KeyFactHardwareType:         "Hardwaretyp",
+	KeyHardwareEthernet:         "%d (Ethernet)",
+	KeyFactDUIDTime:             "Erzeugt",
+	KeyFactLinkLayerAddress:     "Link-Layer-Adresse",
+	KeyFactEnterpriseNumber:     "Enterprise-Nummer",
+	KeyFactDUIDIdentifier:       "Kennung",
+	KeyFactUUID:                 "UUID",
+	KeyTeredoCone:               "Cone-NAT",
+	KeyTeredoRestricted:         "Eingeschränktes oder symmetrisches NAT",
+	KeyScopeNone:                "Keiner (unspezifizierte Adresse)",
+	KeyScopeInterfaceLocal:      "Interface-Local",
+	KeyScopeLinkLocal:           "Link-Local",
+	KeyScopeRealmLocal:          "Realm-Local",
+	KeyScopeAdminLocal:          "Admin-Local",
+	KeyScopeSiteLocal:           "Site-Local",
+	KeyScopeOrganizationLocal:   "Organization-Local",
+	KeyScopeGlobal:              "Global",
+	KeyScopeReserved:            "Reserviert",
+	KeyIIDEUI64:                 "EUI-64, aus einer MAC-Adresse abgeleitet",
+	KeyIIDShortAddress:          "6LoWPAN, aus einer IEEE-802.15.4-Kurzadresse abgeleitet",
+	KeyIIDISATAP:                "ISATAP, mit eingebetteter IPv4-Adresse",
+	KeyIIDTeredo:                "Teredo, mit der NAT-Zuordnung des Clients",
+	KeyIIDLowByte:               "Niedrige Bits, vermutlich manuell vergeben",
+	KeyIIDRandom:                "Vermutlich zufällig, etwa eine Privacy- oder stabile undurchsichtige Kennung",
+	KeyVerifyTitle:              "Adressen überprüfen",
+	KeyVerifyDescription:        "Prüfen Sie, ob eine in einer Switch- oder Router-Tabelle beobachtete Adresse die EUI-64-Adresse einer MAC-Adresse ist, um festzustellen, welche Hosts EUI-64-SLAAC verwenden.",
+	KeyVerifyMACLabel:           "MAC-Adresse",
+	KeyVerifyMACHint:            "Die MAC-Adresse des Hosts (z. B. 00-14-22-01-23-45).",
+	KeyVerifyAddressLabel:       "Beobachtete IPv6-Adresse",
+	KeyVerifyAddressHint:        "Die vollständige IPv6-Adresse, die für die MAC-Adresse beobachtet wurde (z. B. 2001:db8::214:22ff:fe01:2345).",
+	KeyVerifyPrefixLabel:        "Erwartetes Präfix (optional)",
+	KeyVerifyPrefixHint:         "Bis zu 4 Hextets; leer lassen, um die Adresse in jedem Präfix zu akzeptieren.",
+	KeyVerifySubmit:             "Überprüfen",
+	KeyVerifyCSVLabel:           "Paare als CSV",
+	KeyVerifyCSVHint:            "Eine MAC-Adresse und beobachtete IPv6-Adresse pro Zeile, optional gefolgt vom erwarteten Präfix, durch Kommas getrennt.",
+	KeyVerifyCSVSubmit:          "Prüfergebnis als CSV herunterladen",
+	KeyVerifyMatch:              "Übereinstimmung: Die Adresse ist die EUI-64-Adresse der MAC-Adresse.",
+	KeyVerifyNotEUI64:           "Keine Übereinstimmung: Die Adresse verwendet keine EUI-64-Schnittstellen-ID (%s).",
+	KeyVerifyIIDMismatch:        "Keine Übereinstimmung: Die Schnittstellen-ID ist die EUI-64-Schnittstellen-ID einer anderen MAC-Adresse, %s.",
+	KeyVerifyPrefixMismatch:     "Keine Übereinstimmung: Die Schnittstellen-ID passt zur MAC-Adresse, aber die Adresse liegt nicht im erwarteten Präfix.",
+	KeyFactExpectedAddress:      "Erwartete Adresse",
+	KeyFactObservedMAC:          "MAC-Adresse in der Adresse",
+	KeyRangeSummary:             "MAC-Bereich",
+	KeyRangeEndLabel:            "End-MAC-Adresse oder Block",
+	KeyRangeEndHint:             "Die letzte MAC-Adresse eines Bereichs, der bei der obigen MAC-Adresse beginnt, oder eine Blocklänge wie /40 für alle Adressen ihres Blocks.",
+	KeyRangeCountLabel:          "Anzahl",
+	KeyRangeCountHint:           "Die Anzahl aufeinanderfolgender MAC-Adressen ab der obigen MAC-Adresse, anstelle einer End-MAC-Adresse.",
+	KeyRangeCaption:             "EUI-64-Adressen von %d MAC-Adressen",
+	KeyRangeMACHeader:           "MAC-Adresse",
+	KeyRangeInterfaceIDHeader:   "Schnittstellen-ID",
+	KeyRangeAddressHeader:       "IPv6-Adresse",
+	KeyDUIDOptionsSummary:       "DUID-Optionen",
+	KeyDUIDHardwareLabel:        "DUID-Hardwaretyp",
+	KeyDUIDHardwareHint:         "Der IANA-Hardwaretyp der DUIDs als Dezimalzahl; 1 (Ethernet), wenn leer.",
+	KeyDUIDTimeLabel:            "DUID-LLT-Zeit",
+	KeyDUIDTimeHint:             "Wann die DUID-LLT erzeugt wurde, als Datum und Uhrzeit nach RFC 3339 oder Sekunden seit 2000; der Zeitpunkt der Berechnung, wenn leer.",
+	KeyImportTitle:              "MAC-Adressen importieren",
+	KeyImportDescription:        "Berechnen Sie die EUI-64-Adressen der Hosts in einer DHCP-Lease-Datei oder Nachbartabelle.",
+	KeyImportFileLabel:          "Datei",
+	KeyImportFileHint:           "Eine Lease-Datei von dnsmasq oder ISC dhcpd, eine CSV-Lease-Datei von Kea, die Ausgabe von ip -6 neigh oder ip -j neigh oder die Ausgabe von show mac address-table auf Cisco-Geräten.",
+	KeyImportFormatLabel:        "Format",
+	KeyImportFormatAuto:         "Automatisch erkennen",
+	KeyImportPrefixLabel:        "IPv6-Präfix",
+	KeyImportPrefixHint:         "Das Präfix, in dem die Adressen berechnet werden, mit bis zu vier Hextets.",
+	KeyImportSubmit:             "Importieren",
+	KeyImportCaption:            "EUI-64-Adressen von %d MAC-Adressen, importiert aus %s",
+	KeyImportLineHeader:         "Zeile",
+	KeyImportHostnameHeader:     "Hostname",
+	KeyRATitle:                  "Router-Advertisement-Simulator",
+	KeyRADescription:            "Geben Sie eine MAC-Adresse und die Prefix-Information-Optionen eines Router Advertisements ein, um jede Adresse zu sehen, die der Host per SLAAC bildet.",
+	KeyRAPrefixesLabel:          "Prefix-Information-Optionen",
+	KeyRAPrefixesHint:           "Ein Präfix pro Zeile in CIDR-Notation, optional gefolgt von seinen Flags (L, A, LA oder -), der gültigen und der bevorzugten Lebensdauer in Sekunden oder infinite.",
+	KeyRASubmit:                 "Simulieren",
+	KeyRACaption:                "Gebildete Adressen der Interface-ID",
+	KeyRAPrefixHeader:           "Präfix",
+	KeyRAFlagsHeader:            "Flags",
+	KeyRAValidHeader:            "Gültige Lebensdauer",
+	KeyRAPreferredHeader:        "Bevorzugte Lebensdauer",
+	KeyRAAddressHeader:          "IPv6-Adresse",
+	KeyRALinkLocal:              "link-lokal",
+	KeyRADeprecated:             "veraltet",
+	KeyRALifetimeInfinite:       "unbegrenzt",
+	KeyRALifetimeSeconds:        "%d s",
+	KeyRAStatusNotAutonomous:    "Nicht gebildet: Das A-Flag ist nicht gesetzt, daher wird das Präfix nicht für SLAAC verwendet.",
+	KeyRAStatusLinkLocal:        "Nicht gebildet: Hosts ignorieren link-lokale Präfixe in Router Advertisements.",
+	KeyRAStatusMulticast:        "Nicht gebildet: Multicast-Präfixe bezeichnen Gruppen von Schnittstellen, keine einzelnen Schnittstellen.",
+	KeyRAStatusInvalidLifetimes: "Nicht gebildet: Die bevorzugte Lebensdauer übersteigt die gültige Lebensdauer.",
+	KeyRAStatusExpired:          "Nicht gebildet: Die gültige Lebensdauer ist null.",
+	KeyRAStatusNot64:            "Nicht gebildet: SLAAC mit einer 64-Bit-EUI-64-Interface-ID erfordert ein /64-Präfix.",
+
+	KeyErrCalculation:        "Die EUI-64-Adresse konnte nicht berechnet werden",
+	KeyErrTooManyRequests:    "Zu viele Anfragen, bitte warten Sie einen Moment und versuchen Sie es erneut",
+	KeyErrInvalidCSRFToken:   "Ihre Sitzung ist abgelaufen, bitte laden Sie die Seite neu und versuchen Sie es erneut",
+	KeyErrOfflineUnavailable: "Der Server ist nicht erreichbar und die Offline-Berechnung ist nicht verfügbar",
+	KeyErrClientUnavailable:  "Der Rechner konnte nicht geladen werden, bitte laden Sie die Seite neu",
+
+	KeyErrMACRequired:          "Eine MAC-Adresse ist erforderlich (z. B. 00-14-22-01-23-45)",
+	KeyErrMACTooLong:           "Die MAC-Adresse ist länger als 17 Zeichen (z. B. 00-14-22-01-23-45)",
+	KeyErrMACMalformed:         "Die MAC-Adresse muss aus sechs hexadezimalen Paaren bestehen, getrennt durch Bindestriche oder Doppelpunkte (z. B. 00-14-22-01-23-45)",
+	KeyErrMACInvalidChar:       "Die MAC-Adresse enthält ein Zeichen, das weder eine hexadezimale Ziffer noch ein Trennzeichen ist (z. B. 00-14-22-01-23-45)",
+	KeyErrMACInvalidCharAt:     "Die MAC-Adresse enthält an Position %[2]d %[1]q, das weder eine hexadezimale Ziffer noch ein Trennzeichen ist (z. B. 00-14-22-01-23-45)",
+	KeyErrMACLength:            "Die MAC-Adresse muss 6 Byte lang sein (z. B. 00-14-22-01-23-45)",
+	KeyErrPrefixRequired:       "Ein IPv6-Präfix ist erforderlich (z. B. 2001:db8::)",
+	KeyErrPrefixTooLong:        "Das IPv6-Präfix ist länger als 19 Zeichen (z. B. 2001:db8:85a3:0)",
+	KeyErrPrefixTooManyParts:   "Das IPv6-Präfix hat mehr als 4 Hextets, geben Sie nur die ersten 64 Bit ein (z. B. 2001:db8:85a3:0)",
+	KeyErrPrefixEmptyHextet:    "Das IPv6-Präfix enthält ein leeres Hextet zwischen zwei Doppelpunkten, \"::\" darf nur am Ende stehen (z. B. 2001:db8::)",
+	KeyErrPrefixEmptyHextetAt:  "Hextet %[3]d des IPv6-Präfixes ist leer, \"::\" an Position %[2]d darf nur am Ende stehen (z. B. 2001:db8::)",
+	KeyErrPrefixInvalidChar:    "Das IPv6-Präfix enthält ein Zeichen, das keine hexadezimale Ziffer ist (z. B. 2001:db8::)",
+	KeyErrPrefixInvalidCharAt:  "Das IPv6-Präfix enthält an Position %[2]d, in Hextet %[3]d, %[1]q, das keine hexadezimale Ziffer ist (z. B. 2001:db8::)",
+	KeyErrPrefixHextetLength:   "Ein Hextet des IPv6-Präfixes ist länger als 4 Ziffern (z. B. 2001:db8::)",
+	KeyErrPrefixHextetLengthAt: "Hextet %[3]d des IPv6-Präfixes, %[1]q an Position %[2]d, ist länger als 4 Ziffern (z. B. 2001:db8::)",
+	KeyErrPrefixInvalidHextet:  "Ein Hextet des IPv6-Präfixes ist keine hexadezimale Zahl (z. B. 2001:db8::)",
+	KeyErrParentRequired:       "Ein übergeordnetes Präfix ist erforderlich (z. B. 2001:db8:1::/48)",
+	KeyErrParentInvalid:        "Das übergeordnete Präfix muss ein IPv6-Präfix in CIDR-Notation sein (z. B. 2001:db8:1::/48)",
+	KeyErrParentTooLong:        "Das übergeordnete Präfix muss kürzer als /64 sein, damit Platz für Subnetz-IDs bleibt (z. B. 2001:db8:1::/48)",
+	KeyErrSubnetIDsRequired:    "Mindestens eine Subnetz-ID ist erforderlich (z. B. 1, a-f)",
+	KeyErrSubnetIDInvalid:      "Subnetz-IDs müssen hexadezimale Zahlen oder Bereiche davon sein (z. B. 1, a-f)",
+	KeyErrSubnetIDRange:        "Ein Bereich von Subnetz-IDs darf nicht vor seinem Anfang enden (z. B. a-f)",
+	KeyErrSubnetIDOutOfRange:   "Eine Subnetz-ID passt nicht zwischen das übergeordnete Präfix und /64, die größte eines /56 ist ff (z. B. 1, a-f)",
+	KeyErrTooManySubnets:       "Die Subnetz-IDs umfassen mehr als 256 Subnetze (z. B. 0-ff)",
+	KeyErrMatrixNoMACs:         "Mindestens eine MAC-Adresse ist erforderlich (z. B. 00-14-22-01-23-45)",
+	KeyErrMatrixNoPrefixes:     "Mindestens ein IPv6-Präfix ist erforderlich (z. B. 2001:db8::, fd00::)",
+	KeyErrMatrixTooLarge:       "Die Matrix hat mehr als 1048576 Kombinationen aus MAC-Adressen und Präfixen, teilen Sie sie in kleinere auf",
+	KeyErrULASubnetID:          "Die Subnetz-ID muss eine hexadezimale Zahl von 0 bis ffff sein (z. B. 1)",
+	KeyErrAnalyzerRequired:     "Geben Sie eine zu analysierende IPv6-Adresse ein (z. B. 2001:db8::214:22ff:fe01:2345)",
+	KeyErrAnalyzerInvalid:      "Der Wert ist keine gültige IPv6-Adresse (z. B. 2001:db8::214:22ff:fe01:2345)",
+	KeyErrAnalyzerNotIPv6:      "Der Wert ist eine IPv4-Adresse; geben Sie eine IPv6-Adresse ein (z. B. 2001:db8::214:22ff:fe01:2345)",
+	KeyErrVerifyNoInterfaceID:  "Die Adresse hat keine Schnittstellen-ID, wie Multicast- und IPv4-gemappte Adressen; geben Sie eine Unicast-Adresse ein (z. B. 2001:db8::214:22ff:fe01:2345)",
+	KeyErrVerifyCSVInvalid:     "Die CSV ist fehlerhaft; geben Sie ein Paar pro Zeile ein (z. B. 00-14-22-01-23-45,2001:db8::214:22ff:fe01:2345)",
+	KeyErrVerifyCSVFields:      "Jede CSV-Zeile muss eine MAC-Adresse, eine IPv6-Adresse und optional ein Präfix enthalten (z. B. 00-14-22-01-23-45,2001:db8::214:22ff:fe01:2345,2001:db8::)",
+	KeyErrVerifyNoPairs:        "Mindestens ein Paar aus MAC-Adresse und IPv6-Adresse ist erforderlich (z. B. 00-14-22-01-23-45,2001:db8::214:22ff:fe01:2345)",
+	KeyErrVerifyTooManyPairs:   "Die CSV enthält mehr als 65536 Paare, teilen Sie sie in kleinere auf",
+	KeyErrRangeEndAndCount:     "Geben Sie entweder eine End-MAC-Adresse oder eine Anzahl ein, nicht beides (z. B. 50)",
+	KeyErrRangeEndBeforeStart:  "Die End-MAC-Adresse liegt vor der Startadresse; geben Sie eine spätere ein (z. B. 00-14-22-01-23-ff)",
+	KeyErrRangeCount:           "Die Anzahl muss eine positive ganze Zahl sein (z. B. 50)",
+	KeyErrRangeBeyondLast:      "Der Bereich reicht über ff-ff-ff-ff-ff-ff hinaus; geben Sie eine kleinere Anzahl ein (z. B. 50)",
+	KeyErrRangeBlock:           "Die Blocklänge muss zwischen /24 und /48 liegen (z. B. /40)",
+	KeyErrRangeTooLarge:        "Der Bereich hat mehr als %d MAC-Adressen, teilen Sie ihn in kleinere auf",
+	KeyErrImportFile:           "Wählen Sie eine Datei zum Importieren aus (z. B. /var/lib/misc/dnsmasq.leases)",
+	KeyErrImportFormat:         "Das Format wird nicht unterstützt; wählen Sie eines aus der Liste (z. B. dnsmasq-Leases)",
+	KeyErrImportUndetected:     "Das Format der Datei konnte nicht erkannt werden; wählen Sie es aus der Liste (z. B. ISC dhcpd.leases)",
+	KeyErrImportInvalid:        "Die Datei konnte im gewählten Format nicht gelesen werden; prüfen Sie das Format (z. B. ist die Ausgabe von ip -j neigh JSON)",
+	KeyErrImportNoMACs:         "Die Datei enthält keine MAC-Adressen; importieren Sie eine Lease-Datei oder Nachbartabelle (z. B. die Ausgabe von ip -6 neigh)",
+	KeyErrImportTooLarge:       "Die Datei ist größer als 1 MiB, teilen Sie sie in kleinere auf",
+	KeyErrImportTooManyMACs:    "Die Datei hat mehr als 4096 MAC-Adressen, teilen Sie sie in kleinere auf",
+	KeyErrRARequired:           "Mindestens eine Prefix-Information-Option ist erforderlich (z. B. 2001:db8:1::/64 LA 2592000 604800)",
+	KeyErrRAPrefix:             "Das Präfix muss ein IPv6-Präfix in CIDR-Notation sein (z. B. 2001:db8:1::/64)",
+	KeyErrRAFlags:              "Die Flags müssen L, A, LA oder - für keines sein (z. B. 2001:db8:1::/64 LA)",
+	KeyErrRALifetime:           "Lebensdauern müssen Sekunden bis 4294967295 oder infinite sein (z. B. 2001:db8:1::/64 LA infinite 604800)",
+	KeyErrRAFields:             "Eine Zeile enthält höchstens ein Präfix, seine Flags und zwei Lebensdauern (z. B. 2001:db8:1::/64 LA 2592000 604800)",
+	KeyErrRATooMany:            "Es gibt mehr als 64 Prefix-Information-Optionen, teilen Sie sie in kleinere Gruppen auf",
+	KeyErrRALine:               "Zeile %d: %s",
+	KeyErrDUIDTime:             "DUID-LLT-Zeiten müssen zwischen 2000 und 2136 liegen; prüfen Sie die DUID-LLT-Zeit oder die Uhr",
+	KeyErrDUIDSyntax:           "Die DUID muss aus hexadezimalen Bytes bestehen, optional durch Doppelpunkte getrennt (z. B. 00:03:00:01:00:14:22:01:23:45)",
+	KeyErrDUIDLength:           "Die DUID ist für ihren Typ zu kurz oder zu lang (z. B. 00:03:00:01:00:14:22:01:23:45)",
+	KeyErrDUIDTooLong:          "Die DUID ist länger als 130 Bytes (z. B. 00:03:00:01:00:14:22:01:23:45)",
+	KeyErrDUIDUnknownType:      "Der DUID-Typ muss 1 (LLT), 2 (EN), 3 (LL) oder 4 (UUID) sein (z. B. 00:03:00:01:00:14:22:01:23:45)",
+	KeyErrDUIDHardware:         "Der DUID-Hardwaretyp muss eine Dezimalzahl von 0 bis 65535 sein (z. B. 1 für Ethernet)",
+	KeyErrDUIDTimeSyntax:       "Die DUID-LLT-Zeit muss ein Datum mit Uhrzeit nach RFC 3339 oder Sekunden seit 2000 sein (z. B. 2024-05-01T12:00:00Z)",
+
+	KeyErrLinkTypeUnknown:              "Der Verbindungstyp muss Ethernet, IEEE 802.15.4, Bluetooth LE oder ein Interface-ID-Token sein",
+	KeyErrLinkTypeRange:                "Adressbereiche können nur aus Ethernet-MAC-Adressen berechnet werden, wählen Sie den Verbindungstyp Ethernet",
+	KeyErrShortAddressRequired:         "Eine IEEE-802.15.4-Kurzadresse ist erforderlich (z. B. 0x1a2b)",
+	KeyErrShortAddressTooLong:          "Die IEEE-802.15.4-Kurzadresse ist länger als 4 hexadezimale Ziffern (z. B. 0x1a2b)",
+	KeyErrShortAddressMalformed:        "Die IEEE-802.15.4-Kurzadresse muss aus einer bis vier hexadezimalen Ziffern bestehen, optional mit vorangestelltem 0x (z. B. 0x1a2b)",
+	KeyErrShortAddressInvalidChar:      "Die IEEE-802.15.4-Kurzadresse enthält ein Zeichen, das keine hexadezimale Ziffer ist (z. B. 0x1a2b)",
+	KeyErrShortAddressInvalidCharAt:    "Die IEEE-802.15.4-Kurzadresse enthält an Position %[2]d %[1]q, das keine hexadezimale Ziffer ist (z. B. 0x1a2b)",
+	KeyErrExtendedAddressRequired:      "Eine erweiterte IEEE-802.15.4-Adresse ist erforderlich (z. B. 00:12:4b:00:01:02:03:04)",
+	KeyErrExtendedAddressTooLong:       "Die erweiterte IEEE-802.15.4-Adresse ist länger als 23 Zeichen (z. B. 00:12:4b:00:01:02:03:04)",
+	KeyErrExtendedAddressMalformed:     "Die erweiterte IEEE-802.15.4-Adresse muss aus acht hexadezimalen Paaren bestehen, getrennt durch Bindestriche, Doppelpunkte oder gar nicht (z. B. 00:12:4b:00:01:02:03:04)",
+	KeyErrExtendedAddressInvalidChar:   "Die erweiterte IEEE-802.15.4-Adresse enthält ein Zeichen, das weder eine hexadezimale Ziffer noch ein Trennzeichen ist (z. B. 00:12:4b:00:01:02:03:04)",
+	KeyErrExtendedAddressInvalidCharAt: "Die erweiterte IEEE-802.15.4-Adresse enthält an Position %[2]d %[1]q, das weder eine hexadezimale Ziffer noch ein Trennzeichen ist (z. B. 00:12:4b:00:01:02:03:04)",
+	KeyErrBLEAddressRequired:           "Eine Bluetooth-Geräteadresse ist erforderlich (z. B. c2:14:22:01:23:45)",
+	KeyErrBLEAddressTooLong:            "Die Bluetooth-Geräteadresse ist länger als 17 Zeichen (z. B. c2:14:22:01:23:45)",
+	KeyErrBLEAddressMalformed:          "Die Bluetooth-Geräteadresse muss aus sechs hexadezimalen Paaren bestehen, getrennt durch Bindestriche oder Doppelpunkte (z. B. c2:14:22:01:23:45)",
+	KeyErrBLEAddressInvalidChar:        "Die Bluetooth-Geräteadresse enthält ein Zeichen, das weder eine hexadezimale Ziffer noch ein Trennzeichen ist (z. B. c2:14:22:01:23:45)",
+	KeyErrBLEAddressInvalidCharAt:      "Die Bluetooth-Geräteadresse enthält an Position %[2]d %[1]q, das weder eine hexadezimale Ziffer noch ein Trennzeichen ist (z. B. c2:14:22:01:23:45)",
+
+	KeyErrTokenRequired:       "Ein Interface-ID-Token ist erforderlich (z. B. ::1:2)",
+	KeyErrTokenTooLong:        "Das Interface-ID-Token ist länger als 19 Zeichen (z. B. ::1:2)",
+	KeyErrTokenMalformed:      "Das Interface-ID-Token muss aus bis zu 4 durch Doppelpunkte getrennten Hextets bestehen, mit höchstens einem \"::\", das mindestens ein Hextet ersetzt (z. B. ::1:2)",
+	KeyErrTokenInvalidChar:    "Das Interface-ID-Token enthält ein Zeichen, das weder eine hexadezimale Ziffer noch ein Doppelpunkt ist (z. B. ::1:2)",
+	KeyErrTokenInvalidCharAt:  "Das Interface-ID-Token enthält an Position %[2]d %[1]q, das weder eine hexadezimale Ziffer noch ein Doppelpunkt ist (z. B. ::1:2)",
+	KeyErrTokenHextetLength:   "Ein Hextet des Interface-ID-Tokens ist länger als 4 Ziffern (z. B. ::1:2)",
+	KeyErrTokenHextetLengthAt: "Das Hextet %[1]q des Interface-ID-Tokens an Position %[2]d ist länger als 4 Ziffern (z. B. ::1:2)",
+	KeyErrTokenZero:           "Das Interface-ID-Token darf nicht null sein, da es die Subnet-Router-Anycast-Adresse des Präfixes bildet (z. B. ::1:2)",
+}
@@ -0,0 +1,315 @@
+package i18n
+
+// english is the catalog of the default locale. Every key must have a message
+// here; other catalogs fall back to it for keys they do not translate.
+var english = Messages{
+	KeyAppTitle:       "EUI-64 Calculator",
+	KeyThemeLabel:     "Theme",
+	KeyThemeSystem:    "System",
+	KeyThemeLight:     "Light",
+	KeyThemeDark:      "Dark",
+	KeyLanguageLabel:  "Language",
+	KeyLanguageSubmit: "Apply",
+
+	KeyAppDescription: "Enter a MAC address and IPv6 prefix to calculate the EUI-64 address.",
+	KeyMACLabel:       "MAC Address",
+	KeyMACTitle: "MAC address must be in format xx-xx-xx-xx-xx-xx or xx:xx:xx:xx:xx:xx " +
+		"(e.g., 00-14-22-01-23-45 or 00:14:22:01:23:45)",
+	KeyMACHint:     "Six pairs of hexadecimal digits separated by hyphens or colons.",
+	KeyCopyMAC:     "Copy MAC Address",
+	KeyPrefixLabel: "Start of IPv6 Address",
+	KeyPrefixTitle: "IPv6 prefix must be up to 4 hextets (e.g., 2001:db8::)",
+	KeyPrefixHint:  "Up to four groups of hexadecimal digits separated by colons.",
+	KeyCopyPrefix:  "Copy IPv6 Prefix",
+	KeyCalculate:   "Calculate",
+	KeyClear:       "Clear",
+	KeyCopy:        "Copy",
+	KeyCopied:      "Copied to clipboard",
+
+	KeyLinkTypeLabel:    "Link Type",
+	KeyLinkTypeHint:     "The kind of link-layer address entered in the MAC address field, or an interface ID token used as is, as with ip token.",
+	KeyLinkTypeEthernet: "Ethernet (48-bit MAC address)",
+	KeyLinkTypeShort:    "IEEE 802.15.4 / Thread short address (16-bit)",
+	KeyLinkTypeExtended: "IEEE 802.15.4 / Thread extended address (64-bit)",
+	KeyLinkTypeBLE:      "Bluetooth LE device address (48-bit)",
+	KeyLinkTypeToken:    "Interface ID token (e.g., ::1:2)",
+
+	KeyShortcutsTitle:      "Keyboard shortcuts",
+	KeyShortcutFocusMAC:    "Focus the MAC address field",
+	KeyShortcutFocusPrefix: "Focus the IPv6 prefix field",
+	KeyShortcutCopyResult:  "Copy the calculated IPv6 address",
+	KeyShortcutClearForm:   "Clear the form and result",
+
+	KeyInterfaceIDLabel: "End of IPv6 Address",
+	KeyCopyInterfaceID:  "Copy Interface ID",
+	KeyFullIPLabel:      "IPv6 Address",
+	KeyCopyFullIP:       "Copy IPv6 Address",
+	KeyDUIDLLLabel:      "DUID-LL",
+	KeyDUIDLLTLabel:     "DUID-LLT",
+
+	KeyPrefixTypeLabel:             "Prefix Type",
+	KeyPrefixTypeGUA:               "Global unicast (GUA)",
+	KeyPrefixTypeULA:               "Unique local (ULA)",
+	KeyPrefixTypeLinkLocal:         "Link-local",
+	KeyPrefixTypeDocumentation:     "Documentation",
+	KeyPrefixTypeMulticast:         "Multicast",
+	KeyPrefixType6to4:              "6to4",
+	KeyPrefixTypeTeredo:            "Teredo",
+	KeyPrefixTypeReserved:          "Reserved",
+	KeyPrefixWarningMulticast:      "Multicast prefixes address groups, not interfaces; hosts cannot form EUI-64 addresses in them.",
+	KeyPrefixWarningLinkLocalBits:  "Link-local addresses use fe80::/64; the bits after fe80 must be zero for SLAAC.",
+	KeyPrefixWarningULAUnassigned:  "fc00::/8 is reserved; locally assigned ULA prefixes start with fd.",
+	KeyPrefixWarningDocumentation:  "This prefix is reserved for documentation and examples and is not used on real networks.",
+	KeyPrefixWarningTeredo:         "Teredo addresses encode IPv4 addresses in place of an EUI-64 interface identifier.",
+	KeyPrefixWarningDeprecated6to4: "6to4 is deprecated (RFC 7526); prefer a native global prefix.",
+	KeyPrefixWarningReserved:       "This prefix is not assigned for unicast addresses; hosts cannot form EUI-64 addresses in it.",
+
+	KeyPlanTitle:         "Subnet Plan",
+	KeyPlanDescription:   "Enter a MAC address, a parent prefix such as a /48 or /56, and subnet IDs to calculate the EUI-64 address in each /64 subnet.",
+	KeyPlanParentLabel:   "Parent Prefix",
+	KeyPlanParentTitle:   "Parent prefix must be an IPv6 prefix shorter than /64 in CIDR notation (e.g., 2001:db8:1::/48)",
+	KeyPlanParentHint:    "An IPv6 prefix in CIDR notation shorter than /64, such as a /48 or /56.",
+	KeyPlanIDsLabel:      "Subnet IDs",
+	KeyPlanIDsTitle:      "Subnet IDs must be hexadecimal numbers or ranges separated by commas (e.g., 1, a-f)",
+	KeyPlanIDsHint:       "Hexadecimal subnet IDs as written in the address, or ranges such as 10-1f, separated by commas.",
+	KeyPlanSubmit:        "Plan Subnets",
+	KeyPlanCaption:       "EUI-64 addresses of interface ID",
+	KeyPlanIDHeader:      "Subnet ID",
+	KeyPlanPrefixHeader:  "Subnet",
+	KeyPlanAddressHeader: "IPv6 Address",
+
+	KeyMatrixTitle:         "Address Matrix",
+	KeyMatrixDescription:   "Enter several MAC addresses and IPv6 prefixes to download the EUI-64 address of every MAC address in every prefix as a CSV file.",
+	KeyMatrixMACsLabel:     "MAC Addresses",
+	KeyMatrixMACsHint:      "MAC addresses separated by commas or on separate lines.",
+	KeyMatrixPrefixesLabel: "IPv6 Prefixes",
+	KeyMatrixPrefixesHint:  "IPv6 prefixes of up to 4 hextets separated by commas or on separate lines.",
+	KeyMatrixSubmit:        "Download CSV",
+
+	KeyULATitle:         "Generate a ULA Prefix",
+	KeyULADescription:   "Generate a unique local /48 prefix as described in RFC 4193 and choose one of its /64 subnets as the IPv6 prefix.",
+	KeyULAMethodLabel:   "Global ID",
+	KeyULAMethodDerived: "Derived from the time and the MAC address entered above",
+	KeyULAMethodRandom:  "Random",
+	KeyULASubnetLabel:   "Subnet ID",
+	KeyULASubnetHint:    "A hexadecimal subnet ID from 0 to ffff, 0 if left blank.",
+	KeyULASubmit:        "Generate",
+	KeyULAPrefixLabel:   "ULA Prefix",
+	KeyULASubnetPrefix:  "Subnet",
+	KeyULAUse:           "Use as IPv6 Prefix",
+
+	KeyAnalyzerTitle:            "IPv6 Address Analyzer",
+	KeyAnalyzerDescription:      "Enter any IPv6 address to decode its scope, prefix type, interface identifier, and embedded IPv4 addresses.",
+	KeyAnalyzerAddressLabel:     "IPv6 Address",
+	KeyAnalyzerAddressHint:      "Any IPv6 address, optionally with a zone (e.g., 2001:db8::214:22ff:fe01:2345 or fe80::1%eth0)",
+	KeyAnalyzerSubmit:           "Analyze",
+	KeyAnalyzerLink:             "Analyze an IPv6 address",
+	KeyAnalyzerBack:             "Back to the EUI-64 calculator",
+	KeyDUIDDecoderTitle:         "Decode a DHCPv6 DUID",
+	KeyDUIDDecoderDescription:   "Enter a DHCPv6 DUID, such as one from a DHCPv6 server's reservations or leases, to decode its type and components, recovering the MAC address of link-layer DUIDs.",
+	KeyDUIDLabel:                "DUID",
+	KeyDUIDHint:                 "Hexadecimal bytes, optionally separated by colons (e.g., 00:03:00:01:00:14:22:01:23:45)",
+	KeyDUIDSubmit:               "Decode",
+	KeyFactAddress:              "Address",
+	KeyFactExpanded:             "Expanded",
+	KeyFactScope:                "Scope",
+	KeyFactInterfaceID:          "Interface ID",
+	KeyFactIIDType:              "Interface ID Type",
+	KeyFactMAC:                  "MAC Address",
+	KeyFactIPv46to4:             "6to4 IPv4 Address",
+	KeyFactIPv4ISATAP:           "ISATAP IPv4 Address",
+	KeyFactIPv4Mapped:           "IPv4-Mapped Address",
+	KeyFactIPv4NAT64:            "NAT64 IPv4 Address (RFC 6052)",
+	KeyFactNAT64Local:           "Local-Use NAT64 Prefix (RFC 8215)",
+	KeyFactTeredoServer:         "Teredo Server",
+	KeyFactTeredoClient:         "Teredo Client",
+	KeyFactTeredoPort:           "Teredo Client Port",
+	KeyFactTeredoNAT:            "Teredo NAT",
+	KeyFactDUIDType:             "DUID Type",
+	KeyFactHardwareType:         "Hardware Type",
+	KeyHardwareEthernet:         "%d (Ethernet)",
+	KeyFactDUIDTime:             "Generated",
+	KeyFactLinkLayerAddress:     "Link-Layer Address",
+	KeyFactEnterpriseNumber:     "Enterprise Number",
+	KeyFactDUIDIdentifier:       "Identifier",
+	KeyFactUUID:                 "UUID",
+	KeyTeredoCone:               "Cone NAT",
+	KeyTeredoRestricted:         "Restricted or symmetric NAT",
+	KeyScopeNone:                "None (unspecified address)",
+	KeyScopeInterfaceLocal:      "Interface-local",
+	KeyScopeLinkLocal:           "Link-local",
+	KeyScopeRealmLocal:          "Realm-local",
+	KeyScopeAdminLocal:          "Admin-local",
+	KeyScopeSiteLocal:           "Site-local",
+	KeyScopeOrganizationLocal:   "Organization-local",
+	KeyScopeGlobal:              "Global",
+	KeyScopeReserved:            "Reserved",
+	KeyIIDEUI64:                 "EUI-64, derived from a MAC address",
+	KeyIIDShortAddress:          "6LoWPAN, derived from an IEEE 802.15.4 short address",
+	KeyIIDISATAP:                "ISATAP, embedding an IPv4 address",
+	KeyIIDTeredo:                "Teredo, encoding the client's NAT mapping",
+	KeyIIDLowByte:               "Low-byte, likely assigned manually",
+	KeyIIDRandom:                "Likely random, such as a privacy or stable opaque identifier",
+	KeyVerifyTitle:              "Verify Addresses",
+	KeyVerifyDescription:        "Check whether an address observed in a switch or router table is the EUI-64 address of a MAC address, to confirm which hosts use EUI-64 SLAAC.",
+	KeyVerifyMACLabel:           "MAC Address",
+	KeyVerifyMACHint:            "The MAC address of the host (e.g., 00-14-22-01-23-45).",
+	KeyVerifyAddressLabel:       "Observed IPv6 Address",
+	KeyVerifyAddressHint:        "The full IPv6 address observed for the MAC address (e.g., 2001:db8::214:22ff:fe01:2345).",
+	KeyVerifyPrefixLabel:        "Expected Prefix (optional)",
+	KeyVerifyPrefixHint:         "Up to 4 hextets; leave blank to accept the address in any prefix.",
+	KeyVerifySubmit:             "Verify",
+	KeyVerifyCSVLabel:           "Pairs as CSV",
+	KeyVerifyCSVHint:            "One MAC address and observed IPv6 address per line, optionally followed by the expected prefix, separated by commas.",
+	KeyVerifyCSVSubmit:          "Download Verification CSV",
+	KeyVerifyMatch:              "Match: the address is the EUI-64 address of the MAC address.",
+	KeyVerifyNotEUI64:           "Mismatch: the address does not use an EUI-64 interface ID (%s).",
+	KeyVerifyIIDMismatch:        "Mismatch: the interface ID is the EUI-64 interface ID of another MAC address, %s.",
+	KeyVerifyPrefixMismatch:     "Mismatch: the interface ID matches the MAC address, but the address is not in the expected prefix.",
+	KeyFactExpectedAddress:      "Expected Address",
+	KeyFactObservedMAC:          "MAC Address in the Address",
+	KeyRangeSummary:             "MAC Range",
+	KeyRangeEndLabel:            "End MAC Address or Block",
+	KeyRangeEndHint:             "The last MAC address of a range starting at the MAC address above, or a block length such as /40 for every address of its block.",
+	KeyRangeCountLabel:          "Count",
+	KeyRangeCountHint:           "The number of sequential MAC addresses starting at the MAC address above, instead of an end address.",
+	KeyRangeCaption:             "EUI-64 addresses of %d MAC addresses",
+	KeyRangeMACHeader:           "MAC Address",
+	KeyRangeInterfaceIDHeader:   "Interface ID",
+	KeyRangeAddressHeader:       "IPv6 Address",
+	KeyDUIDOptionsSummary:       "DUID Options",
+	KeyDUIDHardwareLabel:        "DUID Hardware Type",
+	KeyDUIDHardwareHint:         "The IANA hardware type of the DUIDs, as a decimal number; 1 (Ethernet) if blank.",
+	KeyDUIDTimeLabel:            "DUID-LLT Time",
+	KeyDUIDTimeHint:             "When the DUID-LLT was generated, as an RFC 3339 date and time or seconds since 2000; the time of the calculation if blank.",
+	KeyImportTitle:              "Import MAC Addresses",
+	KeyImportDescription:        "Calculate the EUI-64 addresses of the hosts in a DHCP lease file or neighbor table.",
+	KeyImportFileLabel:          "File",
+	KeyImportFileHint:           "A dnsmasq or ISC dhcpd leases file, a Kea CSV lease file, ip -6 neigh or ip -j neigh output, or Cisco show mac address-table output.",
+	KeyImportFormatLabel:        "Format",
+	KeyImportFormatAuto:         "Detect automatically",
+	KeyImportPrefixLabel:        "IPv6 Prefix",
+	KeyImportPrefixHint:         "The prefix to calculate the addresses in, up to four hextets.",
+	KeyImportSubmit:             "Import",
+	KeyImportCaption:            "EUI-64 addresses of %d MAC addresses imported from %s",
+	KeyImportLineHeader:         "Line",
+	KeyImportHostnameHeader:     "Hostname",
+	KeyRATitle:                  "Router Advertisement Simulator",
+	KeyRADescription:            "Enter a MAC address and the Prefix Information options of a Router Advertisement to see every address the host forms with SLAAC.",
+	KeyRAPrefixesLabel:          "Prefix Information Options",
+	KeyRAPrefixesHint:           "One prefix per line in CIDR notation, optionally followed by its flags (L, A, LA, or -), valid lifetime, and preferred lifetime in seconds or infinite.",
+	KeyRASubmit:                 "Simulate",
+	KeyRACaption:                "Addresses formed with interface ID",
+	KeyRAPrefixHeader:           "Prefix",
+	KeyRAFlagsHeader:            "Flags",
+	KeyRAValidHeader:            "Valid Lifetime",
+	KeyRAPreferredHeader:        "Preferred Lifetime",
+	KeyRAAddressHeader:          "IPv6 Address",
+	KeyRALinkLocal:              "link-local",
+	KeyRADeprecated:             "deprecated",
+	KeyRALifetimeInfinite:       "infinite",
+	KeyRALifetimeSeconds:        "%d s",
+	KeyRAStatusNotAutonomous:    "Not formed: the A flag is clear, so the prefix is not used for SLAAC.",
+	KeyRAStatusLinkLocal:        "Not formed: hosts ignore link-local prefixes in Router Advertisements.",
+	KeyRAStatusMulticast:        "Not formed: multicast prefixes identify groups of interfaces, not single interfaces.",
+	KeyRAStatusInvalidLifetimes: "Not formed: the preferred lifetime exceeds the valid lifetime.",
+	KeyRAStatusExpired:          "Not formed: the valid lifetime is zero.",
+	KeyRAStatusNot64:            "Not formed: SLAAC with a 64-bit EUI-64 interface ID requires a /64 prefix.",
+
+	KeyErrCalculation:        "Failed to calculate EUI-64 address",
+	KeyErrTooManyRequests:    "Too many requests, please wait a moment and try again",
+	KeyErrInvalidCSRFToken:   "Your session has expired, please reload the page and try again",
+	KeyErrOfflineUnavailable: "The server is unreachable and offline calculation is unavailable",
+	KeyErrClientUnavailable:  "The calculator could not be loaded, please reload the page",
+
+	KeyErrMACRequired:          "A MAC address is required (e.g., 00-14-22-01-23-45)",
+	KeyErrMACTooLong:           "The MAC address is longer than 17 characters (e.g., 00-14-22-01-23-45)",
+	KeyErrMACMalformed:         "The MAC address must be six pairs of hexadecimal digits separated by hyphens or colons (e.g., 00-14-22-01-23-45)",
+	KeyErrMACInvalidChar:       "The MAC address contains a character that is not a hexadecimal digit or separator (e.g., 00-14-22-01-23-45)",
+	KeyErrMACInvalidCharAt:     "The MAC address contains %[1]q at position %[2]d, which is not a hexadecimal digit or separator (e.g., 00-14-22-01-23-45)",
+	KeyErrMACLength:            "The MAC address must be 6 bytes long (e.g., 00-14-22-01-23-45)",
+	KeyErrPrefixRequired:       "An IPv6 prefix is required (e.g., 2001:db8::)",
+	KeyErrPrefixTooLong:        "The IPv6 prefix is longer than 19 characters (e.g., 2001:db8:85a3:0)",
+	KeyErrPrefixTooManyParts:   "The IPv6 prefix has more than 4 hextets, enter only the first 64 bits (e.g., 2001:db8:85a3:0)",
+	KeyErrPrefixEmptyHextet:    "The IPv6 prefix has an empty hextet between two colons, \"::\" may only end the prefix (e.g., 2001:db8::)",
+	KeyErrPrefixEmptyHextetAt:  "Hextet %[3]d of the IPv6 prefix is empty, \"::\" at position %[2]d may only end the prefix (e.g., 2001:db8::)",
+	KeyErrPrefixInvalidChar:    "The IPv6 prefix contains a character that is not a hexadecimal digit (e.g., 2001:db8::)",
+	KeyErrPrefixInvalidCharAt:  "The IPv6 prefix contains %[1]q at position %[2]d, in hextet %[3]d, which is not a hexadecimal digit (e.g., 2001:db8::)",
+	KeyErrPrefixHextetLength:   "A hextet of the IPv6 prefix is longer than 4 digits (e.g., 2001:db8::)",
+	KeyErrPrefixHextetLengthAt: "Hextet %[3]d of the IPv6 prefix, %[1]q at position %[2]d, is longer than 4 digits (e.g., 2001:db8::)",
+	KeyErrPrefixInvalidHextet:  "A hextet of the IPv6 prefix is not a hexadecimal number (e.g., 2001:db8::)",
+	KeyErrParentRequired:       "A parent prefix is required (e.g., 2001:db8:1::/48)",
+	KeyErrParentInvalid:        "The parent prefix must be an IPv6 prefix in CIDR notation (e.g., 2001:db8:1::/48)",
+	KeyErrParentTooLong:        "The parent prefix must be shorter than /64 to leave room for subnet IDs (e.g., 2001:db8:1::/48)",
+	KeyErrSubnetIDsRequired:    "At least one subnet ID is required (e.g., 1, a-f)",
+	KeyErrSubnetIDInvalid:      "Subnet IDs must be hexadecimal numbers or ranges of them (e.g., 1, a-f)",
+	KeyErrSubnetIDRange:        "A range of subnet IDs must not end before it starts (e.g., a-f)",
+	KeyErrSubnetIDOutOfRange:   "A subnet ID does not fit between the parent prefix and /64, the largest of a /56 is ff (e.g., 1, a-f)",
+	KeyErrTooManySubnets:       "The subnet IDs cover more than 256 subnets (e.g., 0-ff)",
+	KeyErrMatrixNoMACs:         "At least one MAC address is required (e.g., 00-14-22-01-23-45)",
+	KeyErrMatrixNoPrefixes:     "At least one IPv6 prefix is required (e.g., 2001:db8::, fd00::)",
+	KeyErrMatrixTooLarge:       "The matrix has more than 1048576 combinations of MAC addresses and prefixes, split it into smaller ones",
+	KeyErrULASubnetID:          "The subnet ID must be a hexadecimal number from 0 to ffff (e.g., 1)",
+	KeyErrAnalyzerRequired:     "Enter an IPv6 address to analyze (e.g., 2001:db8::214:22ff:fe01:2345)",
+	KeyErrAnalyzerInvalid:      "The value is not a valid IPv6 address (e.g., 2001:db8::214:22ff:fe01:2345)",
+	KeyErrAnalyzerNotIPv6:      "The value is an IPv4 address; enter an IPv6 address (e.g., 2001:db8::214:22ff:fe01:2345)",
+	KeyErrVerifyNoInterfaceID:  "The address has no interface ID, as multicast and IPv4-mapped addresses; enter a unicast address (e.g., 2001:db8::214:22ff:fe01:2345)",
+	KeyErrVerifyCSVInvalid:     "The CSV is malformed; enter one pair per line (e.g., 00-14-22-01-23-45,2001:db8::214:22ff:fe01:2345)",
+	KeyErrVerifyCSVFields:      "Each CSV line must have a MAC address, an IPv6 address and optionally a prefix (e.g., 00-14-22-01-23-45,2001:db8::214:22ff:fe01:2345,2001:db8::)",
+	KeyErrVerifyNoPairs:        "At least one pair of a MAC address and an IPv6 address is required (e.g., 00-14-22-01-23-45,2001:db8::214:22ff:fe01:2345)",
+	KeyErrVerifyTooManyPairs:   "The CSV has more than 65536 pairs, split it into smaller ones",
+	KeyErrRangeEndAndCount:     "Enter either an end MAC address or a count, not both (e.g., 50)",
+	KeyErrRangeEndBeforeStart:  "The end MAC address is before the start address; enter a later one (e.g., 00-14-22-01-23-ff)",
+	KeyErrRangeCount:           "The count must be a positive whole number (e.g., 50)",
+	KeyErrRangeBeyondLast:      "The range extends beyond ff-ff-ff-ff-ff-ff; enter a smaller count (e.g., 50)",
+	KeyErrRangeBlock:           "The block length must be between /24 and /48 (e.g., /40)",
+	KeyErrRangeTooLarge:        "The range has more than %d MAC addresses, split it into smaller ones",
+	KeyErrImportFile:           "Select a file to import (e.g., /var/lib/misc/dnsmasq.leases)",
+	KeyErrImportFormat:         "The format is not supported; select one from the list (e.g., dnsmasq leases)",
+	KeyErrImportUndetected:     "The format of the file could not be detected; select it from the list (e.g., ISC dhcpd.leases)",
+	KeyErrImportInvalid:        "The file could not be read in the selected format; check the format (e.g., ip -j neigh output is JSON)",
+	KeyErrImportNoMACs:         "The file has no MAC addresses; import a lease file or neighbor table (e.g., ip -6 neigh output)",
+	KeyErrImportTooLarge:       "The file is larger than 1 MiB, split it into smaller ones",
+	KeyErrImportTooManyMACs:    "The file has more than 4096 MAC addresses, split it into smaller ones",
+	KeyErrRARequired:           "At least one Prefix Information option is required (e.g., 2001:db8:1::/64 LA 2592000 604800)",
+	KeyErrRAPrefix:             "The prefix must be an IPv6 prefix in CIDR notation (e.g., 2001:db8:1::/64)",
+	KeyErrRAFlags:              "The flags must be L, A, LA, or - for neither (e.g., 2001:db8:1::/64 LA)",
+	KeyErrRALifetime:           "Lifetimes must be seconds up to 4294967295 or infinite (e.g., 2001:db8:1::/64 LA infinite 604800)",
+	KeyErrRAFields:             "A line has at most a prefix, its flags, and two lifetimes (e.g., 2001:db8:1::/64 LA 2592000 604800)",
+	KeyErrRATooMany:            "There are more than 64 Prefix Information options, split them into smaller sets",
+	KeyErrRALine:               "Line %d: %s",
+	KeyErrDUIDTime:             "DUID-LLT times must be between 2000 and 2136; check the DUID-LLT time or the clock",
+	KeyErrDUIDSyntax:           "The DUID must be hexadecimal bytes, optionally separated by colons (e.g., 00:03:00:01:00:14:22:01:23:45)",
+	KeyErrDUIDLength:           "The DUID is too short or too long for its type (e.g., 00:03:00:01:00:14:22:01:23:45)",
+	KeyErrDUIDTooLong:          "The DUID exceeds 130 bytes (e.g., 00:03:00:01:00:14:22:01:23:45)",
+	KeyErrDUIDUnknownType:      "The DUID type must be 1 (LLT), 2 (EN), 3 (LL), or 4 (UUID) (e.g., 00:03:00:01:00:14:22:01:23:45)",
+	KeyErrDUIDHardware:         "The DUID hardware type must be a decimal number from 0 to 65535 (e.g., 1 for Ethernet)",
+	KeyErrDUIDTimeSyntax:       "The DUID-LLT time must be an RFC 3339 date and time or seconds since 2000 (e.g., 2024-05-01T12:00:00Z)",
+
+	KeyErrLinkTypeUnknown:              "The link type must be Ethernet, IEEE 802.15.4, Bluetooth LE, or an interface ID token",
+	KeyErrLinkTypeRange:                "Address ranges can only be calculated from Ethernet MAC addresses, choose the Ethernet link type",
+	KeyErrShortAddressRequired:         "An IEEE 802.15.4 short address is required (e.g., 0x1a2b)",
+	KeyErrShortAddressTooLong:          "The IEEE 802.15.4 short address is longer than 4 hexadecimal digits (e.g., 0x1a2b)",
+	KeyErrShortAddressMalformed:        "The IEEE 802.15.4 short address must be one to four hexadecimal digits, optionally prefixed with 0x (e.g., 0x1a2b)",
+	KeyErrShortAddressInvalidChar:      "The IEEE 802.15.4 short address contains a character that is not a hexadecimal digit (e.g., 0x1a2b)",
+	KeyErrShortAddressInvalidCharAt:    "The IEEE 802.15.4 short address contains %[1]q at position %[2]d, which is not a hexadecimal digit (e.g., 0x1a2b)",
+	KeyErrExtendedAddressRequired:      "An IEEE 802.15.4 extended address is required (e.g., 00:12:4b:00:01:02:03:04)",
+	KeyErrExtendedAddressTooLong:       "The IEEE 802.15.4 extended address is longer than 23 characters (e.g., 00:12:4b:00:01:02:03:04)",
+	KeyErrExtendedAddressMalformed:     "The IEEE 802.15.4 extended address must be eight pairs of hexadecimal digits, separated by hyphens or colons or not at all (e.g., 00:12:4b:00:01:02:03:04)",
+	KeyErrExtendedAddressInvalidChar:   "The IEEE 802.15.4 extended address contains a character that is not a hexadecimal digit or separator (e.g., 00:12:4b:00:01:02:03:04)",
+	KeyErrExtendedAddressInvalidCharAt: "The IEEE 802.15.4 extended address contains %[1]q at position %[2]d, which is not a hexadecimal digit or separator (e.g., 00:12:4b:00:01:02:03:04)",
+	KeyErrBLEAddressRequired:           "A Bluetooth device address is required (e.g., c2:14:22:01:23:45)",
+	KeyErrBLEAddressTooLong:            "The Bluetooth device address is longer than 17 characters (e.g., c2:14:22:01:23:45)",
+	KeyErrBLEAddressMalformed:          "The Bluetooth device address must be six pairs of hexadecimal digits separated by hyphens or colons (e.g., c2:14:22:01:23:45)",
+	KeyErrBLEAddressInvalidChar:        "The Bluetooth device address contains a character that is not a hexadecimal digit or separator (e.g., c2:14:22:01:23:45)",
+	KeyErrBLEAddressInvalidCharAt:      "The Bluetooth device address contains %[1]q at position %[2]d, which is not a hexadecimal digit or separator (e.g., c2:14:22:01:23:45)",
+
+	KeyErrTokenRequired:       "An interface ID token is required (e.g., ::1:2)",
+	KeyErrTokenTooLong:        "The interface ID token is longer than 19 characters (e.g., ::1:2)",
+	KeyErrTokenMalformed:      "The interface ID token must be up to 4 hextets separated by colons, with at most one \"::\" standing for at least one hextet (e.g., ::1:2)",
+	KeyErrTokenInvalidChar:    "The interface ID token contains a character that is not a hexadecimal digit or colon (e.g., ::1:2)",
+	KeyErrTokenInvalidCharAt:  "The interface ID token contains %[1]q at position %[2]d, which is not a hexadecimal digit or colon (e.g., ::1:2)",
+	KeyErrTokenHextetLength:   "A hextet of the interface ID token is longer than 4 digits (e.g., ::1:2)",
+	KeyErrTokenHextetLengthAt: "The hextet %[1]q of the interface ID token at position %[2]d is longer than 4 digits (e.g., ::1:2)",
+	KeyErrTokenZero:           "The interface ID token must not be zero, which forms the Subnet-Router anycast address of the prefix (e.g., ::1:2)",
+}
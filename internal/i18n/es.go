@@ -0,0 +1,314 @@
+package i18n
+
+// spanish is the Spanish catalog.
+var spanish = Messages{
+	KeyAppTitle:       "Calculadora EUI-64",
+	KeyThemeLabel:     "Tema",
+	KeyThemeSystem:    "Sistema",
+	KeyThemeLight:     "Claro",
+	KeyThemeDark:      "Oscuro",
+	KeyLanguageLabel:  "Idioma",
+	KeyLanguageSubmit: "Aplicar",
+
+	KeyAppDescription: "Introduce una dirección MAC y un prefijo IPv6 para calcular la dirección EUI-64.",
+	KeyMACLabel:       "Dirección MAC",
+	KeyMACTitle: "La dirección MAC debe tener el formato xx-xx-xx-xx-xx-xx o xx:xx:xx:xx:xx:xx " +
+		"(p. ej., 00-14-22-01-23-45 o 00:14:22:01:23:45)",
+	KeyMACHint:     "Seis pares de dígitos hexadecimales separados por guiones o dos puntos.",
+	KeyCopyMAC:     "Copiar dirección MAC",
+	KeyPrefixLabel: "Inicio de la dirección IPv6",
+	KeyPrefixTitle: "El prefijo IPv6 debe tener como máximo 4 hextetos (p. ej., 2001:db8::)",
+	KeyPrefixHint:  "Hasta cuatro grupos de dígitos hexadecimales separados por dos puntos.",
+	KeyCopyPrefix:  "Copiar prefijo IPv6",
+	KeyCalculate:   "Calcular",
+	KeyClear:       "Borrar",
+	KeyCopy:        "Copiar",
+	KeyCopied:      "Copiado al portapapeles",
+
+	KeyLinkTypeLabel:    "Tipo de enlace",
+	KeyLinkTypeHint:     "El tipo de dirección de capa de enlace introducida en el campo de dirección MAC, o un token de identificador de interfaz usado tal cual, como con ip token.",
+	KeyLinkTypeEthernet: "Ethernet (dirección MAC de 48 bits)",
+	KeyLinkTypeShort:    "IEEE 802.15.4 / Thread, dirección corta (16 bits)",
+	KeyLinkTypeExtended: "IEEE 802.15.4 / Thread, dirección extendida (64 bits)",
+	KeyLinkTypeBLE:      "Dirección de dispositivo Bluetooth LE (48 bits)",
+	KeyLinkTypeToken:    "Token de identificador de interfaz (p. ej., ::1:2)",
+
+	KeyShortcutsTitle:      "Atajos de teclado",
+	KeyShortcutFocusMAC:    "Ir al campo de dirección MAC",
+	KeyShortcutFocusPrefix: "Ir al campo de prefijo IPv6",
+	KeyShortcutCopyResult:  "Copiar la dirección IPv6 calculada",
+	KeyShortcutClearForm:   "Borrar el formulario y el resultado",
+
+	KeyInterfaceIDLabel: "Final de la dirección IPv6",
+	KeyCopyInterfaceID:  "Copiar ID de interfaz",
+	KeyFullIPLabel:      "Dirección IPv6",
+	KeyCopyFullIP:       "Copiar dirección IPv6",
+	KeyDUIDLLLabel:      "DUID-LL",
+	KeyDUIDLLTLabel:     "DUID-LLT",
+
+	KeyPrefixTypeLabel:             "Tipo de prefijo",
+	KeyPrefixTypeGUA:               "Unidifusión global (GUA)",
+	KeyPrefixTypeULA:               "Local única (ULA)",
+	KeyPrefixTypeLinkLocal:         "Enlace local",
+	KeyPrefixTypeDocumentation:     "Documentación",
+	KeyPrefixTypeMulticast:         "Multidifusión",
+	KeyPrefixType6to4:              "6to4",
+	KeyPrefixTypeTeredo:            "Teredo",
+	KeyPrefixTypeReserved:          "Reservado",
+	KeyPrefixWarningMulticast:      "Los prefijos de multidifusión identifican grupos, no interfaces; los hosts no pueden formar direcciones EUI-64 en ellos.",
+	KeyPrefixWarningLinkLocalBits:  "Las direcciones de enlace local usan fe80::/64; para SLAAC, los bits después de fe80 deben ser cero.",
+	KeyPrefixWarningULAUnassigned:  "fc00::/8 está reservado; los prefijos ULA asignados localmente empiezan por fd.",
+	KeyPrefixWarningDocumentation:  "Este prefijo está reservado para documentación y ejemplos y no se usa en redes reales.",
+	KeyPrefixWarningTeredo:         "Las direcciones Teredo codifican direcciones IPv4 en lugar de un identificador de interfaz EUI-64.",
+	KeyPrefixWarningDeprecated6to4: "6to4 está obsoleto (RFC 7526); es preferible un prefijo global nativo.",
+	KeyPrefixWarningReserved:       "Este prefijo no está asignado a direcciones de unidifusión; los hosts no pueden formar direcciones EUI-64 en él.",
+
+	KeyPlanTitle:         "Plan de subredes",
+	KeyPlanDescription:   "Introduce una dirección MAC, un prefijo padre como un /48 o /56 e identificadores de subred para calcular la dirección EUI-64 en cada subred /64.",
+	KeyPlanParentLabel:   "Prefijo padre",
+	KeyPlanParentTitle:   "El prefijo padre debe ser un prefijo IPv6 más corto que /64 en notación CIDR (p. ej., 2001:db8:1::/48)",
+	KeyPlanParentHint:    "Un prefijo IPv6 en notación CIDR más corto que /64, como un /48 o /56.",
+	KeyPlanIDsLabel:      "Identificadores de subred",
+	KeyPlanIDsTitle:      "Los identificadores de subred deben ser números hexadecimales o rangos separados por comas (p. ej., 1, a-f)",
+	KeyPlanIDsHint:       "Identificadores de subred hexadecimales, tal como aparecen en la dirección, o rangos como 10-1f, separados por comas.",
+	KeyPlanSubmit:        "Planificar subredes",
+	KeyPlanCaption:       "Direcciones EUI-64 del identificador de interfaz",
+	KeyPlanIDHeader:      "ID de subred",
+	KeyPlanPrefixHeader:  "Subred",
+	KeyPlanAddressHeader: "Dirección IPv6",
+
+	KeyMatrixTitle:         "Matriz de direcciones",
+	KeyMatrixDescription:   "Introduce varias direcciones MAC y prefijos IPv6 para descargar la dirección EUI-64 de cada dirección MAC en cada prefijo como archivo CSV.",
+	KeyMatrixMACsLabel:     "Direcciones MAC",
+	KeyMatrixMACsHint:      "Direcciones MAC separadas por comas o en líneas distintas.",
+	KeyMatrixPrefixesLabel: "Prefijos IPv6",
+	KeyMatrixPrefixesHint:  "Prefijos IPv6 de hasta 4 hextetos separados por comas o en líneas distintas.",
+	KeyMatrixSubmit:        "Descargar CSV",
+
+	KeyULATitle:         "Generar un prefijo ULA",
+	KeyULADescription:   "Genera un prefijo local único /48 según la RFC 4193 y elige una de sus subredes /64 como prefijo IPv6.",
+	KeyULAMethodLabel:   "ID global",
+	KeyULAMethodDerived: "Derivado de la hora y de la dirección MAC introducida arriba",
+	KeyULAMethodRandom:  "Aleatorio",
+	KeyULASubnetLabel:   "ID de subred",
+	KeyULASubnetHint:    "Un identificador de subred hexadecimal de 0 a ffff, 0 si se deja vacío.",
+	KeyULASubmit:        "Generar",
+	KeyULAPrefixLabel:   "Prefijo ULA",
+	KeyULASubnetPrefix:  "Subred",
+	KeyULAUse:           "Usar como prefijo IPv6",
+
+	KeyAnalyzerTitle:            "Analizador de direcciones IPv6",
+	KeyAnalyzerDescription:      "Introduzca cualquier dirección IPv6 para descifrar su ámbito, tipo de prefijo, identificador de interfaz y direcciones IPv4 incrustadas.",
+	KeyAnalyzerAddressLabel:     "Dirección IPv6",
+	KeyAnalyzerAddressHint:      "Cualquier dirección IPv6, opcionalmente con zona (p. ej., 2001:db8::214:22ff:fe01:2345 o fe80::1%eth0)",
+	KeyAnalyzerSubmit:           "Analizar",
+	KeyAnalyzerLink:             "Analizar una dirección IPv6",
+	KeyAnalyzerBack:             "Volver a la calculadora EUI-64",
+	KeyDUIDDecoderTitle:         "Descifrar un DUID de DHCPv6",
+	KeyDUIDDecoderDescription:   "Introduzca un DUID de DHCPv6, como uno de las reservas o concesiones de un servidor DHCPv6, para descifrar su tipo y sus componentes, recuperando la dirección MAC de los DUID de capa de enlace.",
+	KeyDUIDLabel:                "DUID",
+	KeyDUIDHint:                 "Bytes hexadecimales, opcionalmente separados por dos puntos (p. ej., 00:03:00:01:00:14:22:01:23:45)",
+	KeyDUIDSubmit:               "Descifrar",
+	KeyFactAddress:              "Dirección",
+	KeyFactExpanded:             "Expandida",
+	KeyFactScope:                "Ámbito",
+	KeyFactInterfaceID:          "Identificador de interfaz",
+	KeyFactIIDType:              "Tipo de identificador de interfaz",
+	KeyFactMAC:                  "Dirección MAC",
+	KeyFactIPv46to4:             "Dirección IPv4 de 6to4",
+	KeyFactIPv4ISATAP:           "Dirección IPv4 de ISATAP",
+	KeyFactIPv4Mapped:           "Dirección IPv4 mapeada",
+	KeyFactIPv4NAT64:            "Dirección IPv4 de NAT64 (RFC 6052)",
+	KeyFactNAT64Local:           "Prefijo NAT64 de uso local (RFC 8215)",
+	KeyFactTeredoServer:         "Servidor Teredo",
+	KeyFactTeredoClient:         "Cliente Teredo",
+	KeyFactTeredoPort:           "Puerto del cliente Teredo",
+	KeyFactTeredoNAT:            "NAT de Teredo",
+	KeyFactDUIDType:             "Tipo de DUID",
+	KeyFactHardwareType:         "Tipo de hardware",
+	KeyHardwareEthernet:         "%d (Ethernet)",
+	KeyFactDUIDTime:             "Generado",
+	KeyFactLinkLayerAddress:     "Dirección de capa de enlace",
+	KeyFactEnterpriseNumber:     "Número de empresa",
+	KeyFactDUIDIdentifier:       "Identificador",
+	KeyFactUUID:                 "UUID",
+	KeyTeredoCone:               "NAT de cono",
+	KeyTeredoRestricted:         "NAT restringida o simétrica",
+	KeyScopeNone:                "Ninguno (dirección no especificada)",
+	KeyScopeInterfaceLocal:      "Local de interfaz",
+	KeyScopeLinkLocal:           "Enlace local",
+	KeyScopeRealmLocal:          "Local de dominio",
+	KeyScopeAdminLocal:          "Local administrativo",
+	KeyScopeSiteLocal:           "Local de sitio",
+	KeyScopeOrganizationLocal:   "Local de organización",
+	KeyScopeGlobal:              "Global",
+	KeyScopeReserved:            "Reservado",
+	KeyIIDEUI64:                 "EUI-64, derivado de una dirección MAC",
+	KeyIIDShortAddress:          "6LoWPAN, derivado de una dirección corta IEEE 802.15.4",
+	KeyIIDISATAP:                "ISATAP, con una dirección IPv4 incrustada",
+	KeyIIDTeredo:                "Teredo, con la asignación NAT del cliente",
+	KeyIIDLowByte:               "De bits bajos, probablemente asignado manualmente",
+	KeyIIDRandom:                "Probablemente aleatorio, como un identificador de privacidad u opaco estable",
+	KeyVerifyTitle:              "Verificar direcciones",
+	KeyVerifyDescription:        "Compruebe si una dirección observada en la tabla de un switch o router es la dirección EUI-64 de una dirección MAC, para confirmar qué hosts usan SLAAC con EUI-64.",
+	KeyVerifyMACLabel:           "Dirección MAC",
+	KeyVerifyMACHint:            "La dirección MAC del host (p. ej., 00-14-22-01-23-45).",
+	KeyVerifyAddressLabel:       "Dirección IPv6 observada",
+	KeyVerifyAddressHint:        "La dirección IPv6 completa observada para la dirección MAC (p. ej., 2001:db8::214:22ff:fe01:2345).",
+	KeyVerifyPrefixLabel:        "Prefijo esperado (opcional)",
+	KeyVerifyPrefixHint:         "Hasta 4 hextetos; déjelo en blanco para aceptar la dirección en cualquier prefijo.",
+	KeyVerifySubmit:             "Verificar",
+	KeyVerifyCSVLabel:           "Pares en CSV",
+	KeyVerifyCSVHint:            "Una dirección MAC y una dirección IPv6 observada por línea, opcionalmente seguidas del prefijo esperado, separadas por comas.",
+	KeyVerifyCSVSubmit:          "Descargar la verificación en CSV",
+	KeyVerifyMatch:              "Coincide: la dirección es la dirección EUI-64 de la dirección MAC.",
+	KeyVerifyNotEUI64:           "No coincide: la dirección no usa un ID de interfaz EUI-64 (%s).",
+	KeyVerifyIIDMismatch:        "No coincide: el ID de interfaz es el ID de interfaz EUI-64 de otra dirección MAC, %s.",
+	KeyVerifyPrefixMismatch:     "No coincide: el ID de interfaz corresponde a la dirección MAC, pero la dirección no está en el prefijo esperado.",
+	KeyFactExpectedAddress:      "Dirección esperada",
+	KeyFactObservedMAC:          "Dirección MAC en la dirección",
+	KeyRangeSummary:             "Rango de MAC",
+	KeyRangeEndLabel:            "Dirección MAC final o bloque",
+	KeyRangeEndHint:             "La última dirección MAC de un rango que empieza en la dirección MAC anterior, o una longitud de bloque como /40 para todas las direcciones de su bloque.",
+	KeyRangeCountLabel:          "Cantidad",
+	KeyRangeCountHint:           "El número de direcciones MAC consecutivas a partir de la dirección MAC anterior, en lugar de una dirección final.",
+	KeyRangeCaption:             "Direcciones EUI-64 de %d direcciones MAC",
+	KeyRangeMACHeader:           "Dirección MAC",
+	KeyRangeInterfaceIDHeader:   "ID de interfaz",
+	KeyRangeAddressHeader:       "Dirección IPv6",
+	KeyDUIDOptionsSummary:       "Opciones de DUID",
+	KeyDUIDHardwareLabel:        "Tipo de hardware del DUID",
+	KeyDUIDHardwareHint:         "El tipo de hardware IANA de los DUID, como número decimal; 1 (Ethernet) si se deja en blanco.",
+	KeyDUIDTimeLabel:            "Hora del DUID-LLT",
+	KeyDUIDTimeHint:             "Cuándo se generó el DUID-LLT, como fecha y hora RFC 3339 o segundos desde 2000; la hora del cálculo si se deja en blanco.",
+	KeyImportTitle:              "Importar direcciones MAC",
+	KeyImportDescription:        "Calcula las direcciones EUI-64 de los hosts de un archivo de concesiones DHCP o una tabla de vecinos.",
+	KeyImportFileLabel:          "Archivo",
+	KeyImportFileHint:           "Un archivo de concesiones de dnsmasq o ISC dhcpd, un archivo CSV de concesiones de Kea, la salida de ip -6 neigh o ip -j neigh, o la salida de show mac address-table de Cisco.",
+	KeyImportFormatLabel:        "Formato",
+	KeyImportFormatAuto:         "Detectar automáticamente",
+	KeyImportPrefixLabel:        "Prefijo IPv6",
+	KeyImportPrefixHint:         "El prefijo en el que se calculan las direcciones, de hasta cuatro hextetos.",
+	KeyImportSubmit:             "Importar",
+	KeyImportCaption:            "Direcciones EUI-64 de %d direcciones MAC importadas de %s",
+	KeyImportLineHeader:         "Línea",
+	KeyImportHostnameHeader:     "Nombre de host",
+	KeyRATitle:                  "Simulador de Router Advertisement",
+	KeyRADescription:            "Introduce una dirección MAC y las opciones Prefix Information de un Router Advertisement para ver todas las direcciones que el host forma con SLAAC.",
+	KeyRAPrefixesLabel:          "Opciones Prefix Information",
+	KeyRAPrefixesHint:           "Un prefijo por línea en notación CIDR, seguido opcionalmente de sus indicadores (L, A, LA o -), su vida útil válida y su vida útil preferida en segundos o infinite.",
+	KeyRASubmit:                 "Simular",
+	KeyRACaption:                "Direcciones formadas con el identificador de interfaz",
+	KeyRAPrefixHeader:           "Prefijo",
+	KeyRAFlagsHeader:            "Indicadores",
+	KeyRAValidHeader:            "Vida útil válida",
+	KeyRAPreferredHeader:        "Vida útil preferida",
+	KeyRAAddressHeader:          "Dirección IPv6",
+	KeyRALinkLocal:              "enlace local",
+	KeyRADeprecated:             "obsoleta",
+	KeyRALifetimeInfinite:       "infinita",
+	KeyRALifetimeSeconds:        "%d s",
+	KeyRAStatusNotAutonomous:    "No formada: el indicador A no está activado, así que el prefijo no se usa para SLAAC.",
+	KeyRAStatusLinkLocal:        "No formada: los hosts ignoran los prefijos de enlace local en los Router Advertisements.",
+	KeyRAStatusMulticast:        "No formada: los prefijos de multidifusión identifican grupos de interfaces, no interfaces individuales.",
+	KeyRAStatusInvalidLifetimes: "No formada: la vida útil preferida supera la vida útil válida.",
+	KeyRAStatusExpired:          "No formada: la vida útil válida es cero.",
+	KeyRAStatusNot64:            "No formada: SLAAC con un identificador de interfaz EUI-64 de 64 bits requiere un prefijo /64.",
+
+	KeyErrCalculation:        "No se pudo calcular la dirección EUI-64",
+	KeyErrTooManyRequests:    "Demasiadas solicitudes, espera un momento y vuelve a intentarlo",
+	KeyErrInvalidCSRFToken:   "Tu sesión ha caducado, recarga la página y vuelve a intentarlo",
+	KeyErrOfflineUnavailable: "No se puede acceder al servidor y el cálculo sin conexión no está disponible",
+	KeyErrClientUnavailable:  "No se pudo cargar la calculadora, recarga la página",
+
+	KeyErrMACRequired:          "Se requiere una dirección MAC (p. ej., 00-14-22-01-23-45)",
+	KeyErrMACTooLong:           "La dirección MAC tiene más de 17 caracteres (p. ej., 00-14-22-01-23-45)",
+	KeyErrMACMalformed:         "La dirección MAC debe tener seis pares hexadecimales separados por guiones o dos puntos (p. ej., 00-14-22-01-23-45)",
+	KeyErrMACInvalidChar:       "La dirección MAC contiene un carácter que no es un dígito hexadecimal ni un separador (p. ej., 00-14-22-01-23-45)",
+	KeyErrMACInvalidCharAt:     "La dirección MAC contiene %[1]q en la posición %[2]d, que no es un dígito hexadecimal ni un separador (p. ej., 00-14-22-01-23-45)",
+	KeyErrMACLength:            "La dirección MAC debe tener 6 bytes (p. ej., 00-14-22-01-23-45)",
+	KeyErrPrefixRequired:       "Se requiere un prefijo IPv6 (p. ej., 2001:db8::)",
+	KeyErrPrefixTooLong:        "El prefijo IPv6 tiene más de 19 caracteres (p. ej., 2001:db8:85a3:0)",
+	KeyErrPrefixTooManyParts:   "El prefijo IPv6 tiene más de 4 hextetos, introduce solo los primeros 64 bits (p. ej., 2001:db8:85a3:0)",
+	KeyErrPrefixEmptyHextet:    "El prefijo IPv6 tiene un hexteto vacío entre dos signos de dos puntos, \"::\" solo puede ir al final (p. ej., 2001:db8::)",
+	KeyErrPrefixEmptyHextetAt:  "El hexteto %[3]d del prefijo IPv6 está vacío, \"::\" en la posición %[2]d solo puede ir al final (p. ej., 2001:db8::)",
+	KeyErrPrefixInvalidChar:    "El prefijo IPv6 contiene un carácter que no es un dígito hexadecimal (p. ej., 2001:db8::)",
+	KeyErrPrefixInvalidCharAt:  "El prefijo IPv6 contiene %[1]q en la posición %[2]d, en el hexteto %[3]d, que no es un dígito hexadecimal (p. ej., 2001:db8::)",
+	KeyErrPrefixHextetLength:   "Un hexteto del prefijo IPv6 tiene más de 4 dígitos (p. ej., 2001:db8::)",
+	KeyErrPrefixHextetLengthAt: "El hexteto %[3]d del prefijo IPv6, %[1]q en la posición %[2]d, tiene más de 4 dígitos (p. ej., 2001:db8::)",
+	KeyErrPrefixInvalidHextet:  "Un hexteto del prefijo IPv6 no es un número hexadecimal (p. ej., 2001:db8::)",
+	KeyErrParentRequired:       "El prefijo padre es obligatorio (p. ej., 2001:db8:1::/48)",
+	KeyErrParentInvalid:        "El prefijo padre debe ser un prefijo IPv6 en notación CIDR (p. ej., 2001:db8:1::/48)",
+	KeyErrParentTooLong:        "El prefijo padre debe ser más corto que /64 para dejar sitio a los identificadores de subred (p. ej., 2001:db8:1::/48)",
+	KeyErrSubnetIDsRequired:    "Se necesita al menos un identificador de subred (p. ej., 1, a-f)",
+	KeyErrSubnetIDInvalid:      "Los identificadores de subred deben ser números hexadecimales o rangos de ellos (p. ej., 1, a-f)",
+	KeyErrSubnetIDRange:        "Un rango de identificadores de subred no puede terminar antes de empezar (p. ej., a-f)",
+	KeyErrSubnetIDOutOfRange:   "Un identificador de subred no cabe entre el prefijo padre y /64, el mayor de un /56 es ff (p. ej., 1, a-f)",
+	KeyErrTooManySubnets:       "Los identificadores de subred abarcan más de 256 subredes (p. ej., 0-ff)",
+	KeyErrMatrixNoMACs:         "Se necesita al menos una dirección MAC (p. ej., 00-14-22-01-23-45)",
+	KeyErrMatrixNoPrefixes:     "Se necesita al menos un prefijo IPv6 (p. ej., 2001:db8::, fd00::)",
+	KeyErrMatrixTooLarge:       "La matriz tiene más de 1048576 combinaciones de direcciones MAC y prefijos, divídela en otras más pequeñas",
+	KeyErrULASubnetID:          "El identificador de subred debe ser un número hexadecimal de 0 a ffff (p. ej., 1)",
+	KeyErrAnalyzerRequired:     "Introduzca una dirección IPv6 para analizar (p. ej., 2001:db8::214:22ff:fe01:2345)",
+	KeyErrAnalyzerInvalid:      "El valor no es una dirección IPv6 válida (p. ej., 2001:db8::214:22ff:fe01:2345)",
+	KeyErrAnalyzerNotIPv6:      "El valor es una dirección IPv4; introduzca una dirección IPv6 (p. ej., 2001:db8::214:22ff:fe01:2345)",
+	KeyErrVerifyNoInterfaceID:  "La dirección no tiene ID de interfaz, como las direcciones multicast y las IPv4 mapeadas; introduzca una dirección unicast (p. ej., 2001:db8::214:22ff:fe01:2345)",
+	KeyErrVerifyCSVInvalid:     "El CSV está mal formado; introduzca un par por línea (p. ej., 00-14-22-01-23-45,2001:db8::214:22ff:fe01:2345)",
+	KeyErrVerifyCSVFields:      "Cada línea del CSV debe tener una dirección MAC, una dirección IPv6 y opcionalmente un prefijo (p. ej., 00-14-22-01-23-45,2001:db8::214:22ff:fe01:2345,2001:db8::)",
+	KeyErrVerifyNoPairs:        "Se requiere al menos un par de dirección MAC y dirección IPv6 (p. ej., 00-14-22-01-23-45,2001:db8::214:22ff:fe01:2345)",
+	KeyErrVerifyTooManyPairs:   "El CSV tiene más de 65536 pares, divídalo en otros más pequeños",
+	KeyErrRangeEndAndCount:     "Introduce una dirección MAC final o una cantidad, no ambas (p. ej., 50)",
+	KeyErrRangeEndBeforeStart:  "La dirección MAC final es anterior a la inicial; introduce una posterior (p. ej., 00-14-22-01-23-ff)",
+	KeyErrRangeCount:           "La cantidad debe ser un número entero positivo (p. ej., 50)",
+	KeyErrRangeBeyondLast:      "El rango va más allá de ff-ff-ff-ff-ff-ff; introduce una cantidad menor (p. ej., 50)",
+	KeyErrRangeBlock:           "La longitud de bloque debe estar entre /24 y /48 (p. ej., /40)",
+	KeyErrRangeTooLarge:        "El rango tiene más de %d direcciones MAC, divídelo en otros más pequeños",
+	KeyErrImportFile:           "Selecciona un archivo para importar (p. ej., /var/lib/misc/dnsmasq.leases)",
+	KeyErrImportFormat:         "El formato no es compatible; elige uno de la lista (p. ej., concesiones de dnsmasq)",
+	KeyErrImportUndetected:     "No se pudo detectar el formato del archivo; elígelo de la lista (p. ej., ISC dhcpd.leases)",
+	KeyErrImportInvalid:        "No se pudo leer el archivo en el formato elegido; comprueba el formato (p. ej., la salida de ip -j neigh es JSON)",
+	KeyErrImportNoMACs:         "El archivo no tiene direcciones MAC; importa un archivo de concesiones o una tabla de vecinos (p. ej., la salida de ip -6 neigh)",
+	KeyErrImportTooLarge:       "El archivo supera 1 MiB, divídelo en otros más pequeños",
+	KeyErrImportTooManyMACs:    "El archivo tiene más de 4096 direcciones MAC, divídelo en otros más pequeños",
+	KeyErrRARequired:           "Se necesita al menos una opción Prefix Information (p. ej., 2001:db8:1::/64 LA 2592000 604800)",
+	KeyErrRAPrefix:             "El prefijo debe ser un prefijo IPv6 en notación CIDR (p. ej., 2001:db8:1::/64)",
+	KeyErrRAFlags:              "Los indicadores deben ser L, A, LA o - para ninguno (p. ej., 2001:db8:1::/64 LA)",
+	KeyErrRALifetime:           "Las vidas útiles deben ser segundos hasta 4294967295 o infinite (p. ej., 2001:db8:1::/64 LA infinite 604800)",
+	KeyErrRAFields:             "Una línea tiene como mucho un prefijo, sus indicadores y dos vidas útiles (p. ej., 2001:db8:1::/64 LA 2592000 604800)",
+	KeyErrRATooMany:            "Hay más de 64 opciones Prefix Information, divídelas en grupos más pequeños",
+	KeyErrRALine:               "Línea %d: %s",
+	KeyErrDUIDTime:             "Las horas de DUID-LLT deben estar entre 2000 y 2136; compruebe la hora del DUID-LLT o el reloj",
+	KeyErrDUIDSyntax:           "El DUID debe ser bytes hexadecimales, opcionalmente separados por dos puntos (p. ej., 00:03:00:01:00:14:22:01:23:45)",
+	KeyErrDUIDLength:           "El DUID es demasiado corto o demasiado largo para su tipo (p. ej., 00:03:00:01:00:14:22:01:23:45)",
+	KeyErrDUIDTooLong:          "El DUID supera los 130 bytes (p. ej., 00:03:00:01:00:14:22:01:23:45)",
+	KeyErrDUIDUnknownType:      "El tipo de DUID debe ser 1 (LLT), 2 (EN), 3 (LL) o 4 (UUID) (p. ej., 00:03:00:01:00:14:22:01:23:45)",
+	KeyErrDUIDHardware:         "El tipo de hardware del DUID debe ser un número decimal de 0 a 65535 (p. ej., 1 para Ethernet)",
+	KeyErrDUIDTimeSyntax:       "La hora del DUID-LLT debe ser una fecha y hora RFC 3339 o segundos desde 2000 (p. ej., 2024-05-01T12:00:00Z)",
+
+	KeyErrLinkTypeUnknown:              "El tipo de enlace debe ser Ethernet, IEEE 802.15.4, Bluetooth LE o un token de identificador de interfaz",
+	KeyErrLinkTypeRange:                "Los rangos de direcciones solo se pueden calcular a partir de direcciones MAC Ethernet, elija el tipo de enlace Ethernet",
+	KeyErrShortAddressRequired:         "Se requiere una dirección corta IEEE 802.15.4 (p. ej., 0x1a2b)",
+	KeyErrShortAddressTooLong:          "La dirección corta IEEE 802.15.4 tiene más de 4 dígitos hexadecimales (p. ej., 0x1a2b)",
+	KeyErrShortAddressMalformed:        "La dirección corta IEEE 802.15.4 debe tener de uno a cuatro dígitos hexadecimales, opcionalmente precedidos de 0x (p. ej., 0x1a2b)",
+	KeyErrShortAddressInvalidChar:      "La dirección corta IEEE 802.15.4 contiene un carácter que no es un dígito hexadecimal (p. ej., 0x1a2b)",
+	KeyErrShortAddressInvalidCharAt:    "La dirección corta IEEE 802.15.4 contiene %[1]q en la posición %[2]d, que no es un dígito hexadecimal (p. ej., 0x1a2b)",
+	KeyErrExtendedAddressRequired:      "Se requiere una dirección extendida IEEE 802.15.4 (p. ej., 00:12:4b:00:01:02:03:04)",
+	KeyErrExtendedAddressTooLong:       "La dirección extendida IEEE 802.15.4 tiene más de 23 caracteres (p. ej., 00:12:4b:00:01:02:03:04)",
+	KeyErrExtendedAddressMalformed:     "La dirección extendida IEEE 802.15.4 debe tener ocho pares hexadecimales separados por guiones, dos puntos o sin separar (p. ej., 00:12:4b:00:01:02:03:04)",
+	KeyErrExtendedAddressInvalidChar:   "La dirección extendida IEEE 802.15.4 contiene un carácter que no es un dígito hexadecimal ni un separador (p. ej., 00:12:4b:00:01:02:03:04)",
+	KeyErrExtendedAddressInvalidCharAt: "La dirección extendida IEEE 802.15.4 contiene %[1]q en la posición %[2]d, que no es un dígito hexadecimal ni un separador (p. ej., 00:12:4b:00:01:02:03:04)",
+	KeyErrBLEAddressRequired:           "Se requiere una dirección de dispositivo Bluetooth (p. ej., c2:14:22:01:23:45)",
+	KeyErrBLEAddressTooLong:            "La dirección de dispositivo Bluetooth tiene más de 17 caracteres (p. ej., c2:14:22:01:23:45)",
+	KeyErrBLEAddressMalformed:          "La dirección de dispositivo Bluetooth debe tener seis pares hexadecimales separados por guiones o dos puntos (p. ej., c2:14:22:01:23:45)",
+	KeyErrBLEAddressInvalidChar:        "La dirección de dispositivo Bluetooth contiene un carácter que no es un dígito hexadecimal ni un separador (p. ej., c2:14:22:01:23:45)",
+	KeyErrBLEAddressInvalidCharAt:      "La dirección de dispositivo Bluetooth contiene %[1]q en la posición %[2]d, que no es un dígito hexadecimal ni un separador (p. ej., c2:14:22:01:23:45)",
+
+	KeyErrTokenRequired:       "Se requiere un token de identificador de interfaz (p. ej., ::1:2)",
+	KeyErrTokenTooLong:        "El token de identificador de interfaz tiene más de 19 caracteres (p. ej., ::1:2)",
+	KeyErrTokenMalformed:      "El token de identificador de interfaz debe tener hasta 4 hextetos separados por dos puntos, con como máximo un \"::\" que sustituya al menos un hexteto (p. ej., ::1:2)",
+	KeyErrTokenInvalidChar:    "El token de identificador de interfaz contiene un carácter que no es un dígito hexadecimal ni dos puntos (p. ej., ::1:2)",
+	KeyErrTokenInvalidCharAt:  "El token de identificador de interfaz contiene %[1]q en la posición %[2]d, que no es un dígito hexadecimal ni dos puntos (p. ej., ::1:2)",
+	KeyErrTokenHextetLength:   "Un hexteto del token de identificador de interfaz tiene más de 4 dígitos (p. ej., ::1:2)",
+	KeyErrTokenHextetLengthAt: "El hexteto %[1]q del token de identificador de interfaz en la posición %[2]d tiene más de 4 dígitos (p. ej., ::1:2)",
+	KeyErrTokenZero:           "El token de identificador de interfaz no puede ser cero, ya que forma la dirección anycast Subnet-Router del prefijo (p. ej., ::1:2)",
+}
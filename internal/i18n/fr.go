@@ -0,0 +1,314 @@
+package i18n
+
+// french is the French catalog.
+var french = Messages{
+	KeyAppTitle:       "Calculateur EUI-64",
+	KeyThemeLabel:     "Thème",
+	KeyThemeSystem:    "Système",
+	KeyThemeLight:     "Clair",
+	KeyThemeDark:      "Sombre",
+	KeyLanguageLabel:  "Langue",
+	KeyLanguageSubmit: "Appliquer",
+
+	KeyAppDescription: "Saisissez une adresse MAC et un préfixe IPv6 pour calculer l’adresse EUI-64.",
+	KeyMACLabel:       "Adresse MAC",
+	KeyMACTitle: "L’adresse MAC doit être au format xx-xx-xx-xx-xx-xx ou xx:xx:xx:xx:xx:xx " +
+		"(par ex. 00-14-22-01-23-45 ou 00:14:22:01:23:45)",
+	KeyMACHint:     "Six paires de chiffres hexadécimaux séparées par des tirets ou des deux-points.",
+	KeyCopyMAC:     "Copier l’adresse MAC",
+	KeyPrefixLabel: "Début de l’adresse IPv6",
+	KeyPrefixTitle: "Le préfixe IPv6 doit comporter au plus 4 hextets (par ex. 2001:db8::)",
+	KeyPrefixHint:  "Jusqu’à quatre groupes de chiffres hexadécimaux séparés par des deux-points.",
+	KeyCopyPrefix:  "Copier le préfixe IPv6",
+	KeyCalculate:   "Calculer",
+	KeyClear:       "Effacer",
+	KeyCopy:        "Copier",
+	KeyCopied:      "Copié dans le presse-papiers",
+
+	KeyLinkTypeLabel:    "Type de lien",
+	KeyLinkTypeHint:     "Le type d’adresse de couche liaison saisie dans le champ Adresse MAC, ou un jeton d’identifiant d’interface utilisé tel quel, comme avec ip token.",
+	KeyLinkTypeEthernet: "Ethernet (adresse MAC de 48 bits)",
+	KeyLinkTypeShort:    "IEEE 802.15.4 / Thread, adresse courte (16 bits)",
+	KeyLinkTypeExtended: "IEEE 802.15.4 / Thread, adresse étendue (64 bits)",
+	KeyLinkTypeBLE:      "Adresse d’appareil Bluetooth LE (48 bits)",
+	KeyLinkTypeToken:    "Jeton d’identifiant d’interface (par ex. ::1:2)",
+
+	KeyShortcutsTitle:      "Raccourcis clavier",
+	KeyShortcutFocusMAC:    "Aller au champ de l’adresse MAC",
+	KeyShortcutFocusPrefix: "Aller au champ du préfixe IPv6",
+	KeyShortcutCopyResult:  "Copier l’adresse IPv6 calculée",
+	KeyShortcutClearForm:   "Effacer le formulaire et le résultat",
+
+	KeyInterfaceIDLabel: "Fin de l’adresse IPv6",
+	KeyCopyInterfaceID:  "Copier l’identifiant d’interface",
+	KeyFullIPLabel:      "Adresse IPv6",
+	KeyCopyFullIP:       "Copier l’adresse IPv6",
+	KeyDUIDLLLabel:      "DUID-LL",
+	KeyDUIDLLTLabel:     "DUID-LLT",
+
+	KeyPrefixTypeLabel:             "Type de préfixe",
+	KeyPrefixTypeGUA:               "Unicast global (GUA)",
+	KeyPrefixTypeULA:               "Local unique (ULA)",
+	KeyPrefixTypeLinkLocal:         "Lien local",
+	KeyPrefixTypeDocumentation:     "Documentation",
+	KeyPrefixTypeMulticast:         "Multicast",
+	KeyPrefixType6to4:              "6to4",
+	KeyPrefixTypeTeredo:            "Teredo",
+	KeyPrefixTypeReserved:          "Réservé",
+	KeyPrefixWarningMulticast:      "Les préfixes multicast désignent des groupes, pas des interfaces ; les hôtes ne peuvent pas y former d’adresses EUI-64.",
+	KeyPrefixWarningLinkLocalBits:  "Les adresses lien local utilisent fe80::/64 ; pour SLAAC, les bits après fe80 doivent être nuls.",
+	KeyPrefixWarningULAUnassigned:  "fc00::/8 est réservé ; les préfixes ULA attribués localement commencent par fd.",
+	KeyPrefixWarningDocumentation:  "Ce préfixe est réservé à la documentation et aux exemples et n’est pas utilisé sur de vrais réseaux.",
+	KeyPrefixWarningTeredo:         "Les adresses Teredo encodent des adresses IPv4 à la place d’un identifiant d’interface EUI-64.",
+	KeyPrefixWarningDeprecated6to4: "6to4 est obsolète (RFC 7526) ; préférez un préfixe global natif.",
+	KeyPrefixWarningReserved:       "Ce préfixe n’est pas attribué aux adresses unicast ; les hôtes ne peuvent pas y former d’adresses EUI-64.",
+
+	KeyPlanTitle:         "Plan de sous-réseaux",
+	KeyPlanDescription:   "Saisissez une adresse MAC, un préfixe parent tel qu’un /48 ou un /56 et des identifiants de sous-réseau pour calculer l’adresse EUI-64 dans chaque sous-réseau /64.",
+	KeyPlanParentLabel:   "Préfixe parent",
+	KeyPlanParentTitle:   "Le préfixe parent doit être un préfixe IPv6 plus court que /64 en notation CIDR (par ex. 2001:db8:1::/48)",
+	KeyPlanParentHint:    "Un préfixe IPv6 en notation CIDR plus court que /64, tel qu’un /48 ou un /56.",
+	KeyPlanIDsLabel:      "Identifiants de sous-réseau",
+	KeyPlanIDsTitle:      "Les identifiants de sous-réseau doivent être des nombres hexadécimaux ou des plages séparés par des virgules (par ex. 1, a-f)",
+	KeyPlanIDsHint:       "Identifiants de sous-réseau hexadécimaux, tels qu’écrits dans l’adresse, ou plages comme 10-1f, séparés par des virgules.",
+	KeyPlanSubmit:        "Planifier les sous-réseaux",
+	KeyPlanCaption:       "Adresses EUI-64 de l’identifiant d’interface",
+	KeyPlanIDHeader:      "ID de sous-réseau",
+	KeyPlanPrefixHeader:  "Sous-réseau",
+	KeyPlanAddressHeader: "Adresse IPv6",
+
+	KeyMatrixTitle:         "Matrice d’adresses",
+	KeyMatrixDescription:   "Saisissez plusieurs adresses MAC et préfixes IPv6 pour télécharger l’adresse EUI-64 de chaque adresse MAC dans chaque préfixe sous forme de fichier CSV.",
+	KeyMatrixMACsLabel:     "Adresses MAC",
+	KeyMatrixMACsHint:      "Adresses MAC séparées par des virgules ou sur des lignes distinctes.",
+	KeyMatrixPrefixesLabel: "Préfixes IPv6",
+	KeyMatrixPrefixesHint:  "Préfixes IPv6 d’au plus 4 hextets séparés par des virgules ou sur des lignes distinctes.",
+	KeyMatrixSubmit:        "Télécharger le CSV",
+
+	KeyULATitle:         "Générer un préfixe ULA",
+	KeyULADescription:   "Générez un préfixe local unique /48 selon la RFC 4193 et choisissez l’un de ses sous-réseaux /64 comme préfixe IPv6.",
+	KeyULAMethodLabel:   "ID global",
+	KeyULAMethodDerived: "Dérivé de l’heure et de l’adresse MAC saisie ci-dessus",
+	KeyULAMethodRandom:  "Aléatoire",
+	KeyULASubnetLabel:   "ID de sous-réseau",
+	KeyULASubnetHint:    "Un identifiant de sous-réseau hexadécimal de 0 à ffff, 0 si vide.",
+	KeyULASubmit:        "Générer",
+	KeyULAPrefixLabel:   "Préfixe ULA",
+	KeyULASubnetPrefix:  "Sous-réseau",
+	KeyULAUse:           "Utiliser comme préfixe IPv6",
+
+	KeyAnalyzerTitle:            "Analyseur d’adresses IPv6",
+	KeyAnalyzerDescription:      "Saisissez n’importe quelle adresse IPv6 pour décoder sa portée, son type de préfixe, son identifiant d’interface et ses adresses IPv4 intégrées.",
+	KeyAnalyzerAddressLabel:     "Adresse IPv6",
+	KeyAnalyzerAddressHint:      "N’importe quelle adresse IPv6, éventuellement avec une zone (par ex. 2001:db8::214:22ff:fe01:2345 ou fe80::1%eth0)",
+	KeyAnalyzerSubmit:           "Analyser",
+	KeyAnalyzerLink:             "Analyser une adresse IPv6",
+	KeyAnalyzerBack:             "Retour au calculateur EUI-64",
+	KeyDUIDDecoderTitle:         "Décoder un DUID DHCPv6",
+	KeyDUIDDecoderDescription:   "Saisissez un DUID DHCPv6, par exemple issu des réservations ou des baux d’un serveur DHCPv6, pour décoder son type et ses composants, et retrouver l’adresse MAC des DUID de couche liaison.",
+	KeyDUIDLabel:                "DUID",
+	KeyDUIDHint:                 "Octets hexadécimaux, éventuellement séparés par des deux-points (par ex. 00:03:00:01:00:14:22:01:23:45)",
+	KeyDUIDSubmit:               "Décoder",
+	KeyFactAddress:              "Adresse",
+	KeyFactExpanded:             "Forme développée",
+	KeyFactScope:                "Portée",
+	KeyFactInterfaceID:          "Identifiant d’interface",
+	KeyFactIIDType:              "Type d’identifiant d’interface",
+	KeyFactMAC:                  "Adresse MAC",
+	KeyFactIPv46to4:             "Adresse IPv4 6to4",
+	KeyFactIPv4ISATAP:           "Adresse IPv4 ISATAP",
+	KeyFactIPv4Mapped:           "Adresse IPv4 mappée",
+	KeyFactIPv4NAT64:            "Adresse IPv4 NAT64 (RFC 6052)",
+	KeyFactNAT64Local:           "Préfixe NAT64 à usage local (RFC 8215)",
+	KeyFactTeredoServer:         "Serveur Teredo",
+	KeyFactTeredoClient:         "Client Teredo",
+	KeyFactTeredoPort:           "Port du client Teredo",
+	KeyFactTeredoNAT:            "NAT Teredo",
+	KeyFactDUIDType:             "Type de DUID",
+	KeyFactHardwareType:         "Type de matériel",
+	KeyHardwareEthernet:         "%d (Ethernet)",
+	KeyFactDUIDTime:             "Généré",
+	KeyFactLinkLayerAddress:     "Adresse de couche liaison",
+	KeyFactEnterpriseNumber:     "Numéro d’entreprise",
+	KeyFactDUIDIdentifier:       "Identifiant",
+	KeyFactUUID:                 "UUID",
+	KeyTeredoCone:               "NAT conique",
+	KeyTeredoRestricted:         "NAT restreint ou symétrique",
+	KeyScopeNone:                "Aucune (adresse non spécifiée)",
+	KeyScopeInterfaceLocal:      "Locale à l’interface",
+	KeyScopeLinkLocal:           "Lien local",
+	KeyScopeRealmLocal:          "Locale au domaine",
+	KeyScopeAdminLocal:          "Locale administrative",
+	KeyScopeSiteLocal:           "Locale au site",
+	KeyScopeOrganizationLocal:   "Locale à l’organisation",
+	KeyScopeGlobal:              "Globale",
+	KeyScopeReserved:            "Réservée",
+	KeyIIDEUI64:                 "EUI-64, dérivé d’une adresse MAC",
+	KeyIIDShortAddress:          "6LoWPAN, dérivé d’une adresse courte IEEE 802.15.4",
+	KeyIIDISATAP:                "ISATAP, intégrant une adresse IPv4",
+	KeyIIDTeredo:                "Teredo, encodant la correspondance NAT du client",
+	KeyIIDLowByte:               "Bits de poids faible, probablement attribué manuellement",
+	KeyIIDRandom:                "Probablement aléatoire, comme un identifiant temporaire ou opaque stable",
+	KeyVerifyTitle:              "Vérifier des adresses",
+	KeyVerifyDescription:        "Vérifiez si une adresse observée dans la table d’un commutateur ou d’un routeur est l’adresse EUI-64 d’une adresse MAC, pour confirmer quels hôtes utilisent SLAAC avec EUI-64.",
+	KeyVerifyMACLabel:           "Adresse MAC",
+	KeyVerifyMACHint:            "L’adresse MAC de l’hôte (p. ex. 00-14-22-01-23-45).",
+	KeyVerifyAddressLabel:       "Adresse IPv6 observée",
+	KeyVerifyAddressHint:        "L’adresse IPv6 complète observée pour l’adresse MAC (p. ex. 2001:db8::214:22ff:fe01:2345).",
+	KeyVerifyPrefixLabel:        "Préfixe attendu (facultatif)",
+	KeyVerifyPrefixHint:         "Jusqu’à 4 hextets ; laissez vide pour accepter l’adresse dans n’importe quel préfixe.",
+	KeyVerifySubmit:             "Vérifier",
+	KeyVerifyCSVLabel:           "Paires en CSV",
+	KeyVerifyCSVHint:            "Une adresse MAC et une adresse IPv6 observée par ligne, éventuellement suivies du préfixe attendu, séparées par des virgules.",
+	KeyVerifyCSVSubmit:          "Télécharger la vérification en CSV",
+	KeyVerifyMatch:              "Correspondance : l’adresse est l’adresse EUI-64 de l’adresse MAC.",
+	KeyVerifyNotEUI64:           "Pas de correspondance : l’adresse n’utilise pas d’identifiant d’interface EUI-64 (%s).",
+	KeyVerifyIIDMismatch:        "Pas de correspondance : l’identifiant d’interface est l’identifiant EUI-64 d’une autre adresse MAC, %s.",
+	KeyVerifyPrefixMismatch:     "Pas de correspondance : l’identifiant d’interface correspond à l’adresse MAC, mais l’adresse n’est pas dans le préfixe attendu.",
+	KeyFactExpectedAddress:      "Adresse attendue",
+	KeyFactObservedMAC:          "Adresse MAC dans l’adresse",
+	KeyRangeSummary:             "Plage MAC",
+	KeyRangeEndLabel:            "Adresse MAC de fin ou bloc",
+	KeyRangeEndHint:             "La dernière adresse MAC d’une plage commençant à l’adresse MAC ci-dessus, ou une longueur de bloc comme /40 pour toutes les adresses de son bloc.",
+	KeyRangeCountLabel:          "Nombre",
+	KeyRangeCountHint:           "Le nombre d’adresses MAC consécutives à partir de l’adresse MAC ci-dessus, au lieu d’une adresse de fin.",
+	KeyRangeCaption:             "Adresses EUI-64 de %d adresses MAC",
+	KeyRangeMACHeader:           "Adresse MAC",
+	KeyRangeInterfaceIDHeader:   "Identifiant d’interface",
+	KeyRangeAddressHeader:       "Adresse IPv6",
+	KeyDUIDOptionsSummary:       "Options des DUID",
+	KeyDUIDHardwareLabel:        "Type de matériel des DUID",
+	KeyDUIDHardwareHint:         "Le type de matériel IANA des DUID, en nombre décimal ; 1 (Ethernet) si vide.",
+	KeyDUIDTimeLabel:            "Heure du DUID-LLT",
+	KeyDUIDTimeHint:             "Quand le DUID-LLT a été généré, en date et heure RFC 3339 ou en secondes depuis 2000 ; l’heure du calcul si vide.",
+	KeyImportTitle:              "Importer des adresses MAC",
+	KeyImportDescription:        "Calculez les adresses EUI-64 des hôtes d’un fichier de baux DHCP ou d’une table de voisins.",
+	KeyImportFileLabel:          "Fichier",
+	KeyImportFileHint:           "Un fichier de baux dnsmasq ou ISC dhcpd, un fichier de baux CSV Kea, la sortie de ip -6 neigh ou ip -j neigh, ou la sortie de show mac address-table de Cisco.",
+	KeyImportFormatLabel:        "Format",
+	KeyImportFormatAuto:         "Détecter automatiquement",
+	KeyImportPrefixLabel:        "Préfixe IPv6",
+	KeyImportPrefixHint:         "Le préfixe dans lequel calculer les adresses, jusqu’à quatre hextets.",
+	KeyImportSubmit:             "Importer",
+	KeyImportCaption:            "Adresses EUI-64 de %d adresses MAC importées depuis %s",
+	KeyImportLineHeader:         "Ligne",
+	KeyImportHostnameHeader:     "Nom d’hôte",
+	KeyRATitle:                  "Simulateur de Router Advertisement",
+	KeyRADescription:            "Saisissez une adresse MAC et les options Prefix Information d’un Router Advertisement pour voir chaque adresse que l’hôte forme par SLAAC.",
+	KeyRAPrefixesLabel:          "Options Prefix Information",
+	KeyRAPrefixesHint:           "Un préfixe par ligne en notation CIDR, suivi éventuellement de ses drapeaux (L, A, LA ou -), de sa durée de vie valide et de sa durée de vie préférée en secondes ou infinite.",
+	KeyRASubmit:                 "Simuler",
+	KeyRACaption:                "Adresses formées avec l’identifiant d’interface",
+	KeyRAPrefixHeader:           "Préfixe",
+	KeyRAFlagsHeader:            "Drapeaux",
+	KeyRAValidHeader:            "Durée de vie valide",
+	KeyRAPreferredHeader:        "Durée de vie préférée",
+	KeyRAAddressHeader:          "Adresse IPv6",
+	KeyRALinkLocal:              "lien local",
+	KeyRADeprecated:             "obsolète",
+	KeyRALifetimeInfinite:       "infinie",
+	KeyRALifetimeSeconds:        "%d s",
+	KeyRAStatusNotAutonomous:    "Non formée : le drapeau A n’est pas positionné, le préfixe n’est donc pas utilisé pour SLAAC.",
+	KeyRAStatusLinkLocal:        "Non formée : les hôtes ignorent les préfixes lien local des Router Advertisements.",
+	KeyRAStatusMulticast:        "Non formée : les préfixes multicast désignent des groupes d’interfaces, pas des interfaces individuelles.",
+	KeyRAStatusInvalidLifetimes: "Non formée : la durée de vie préférée dépasse la durée de vie valide.",
+	KeyRAStatusExpired:          "Non formée : la durée de vie valide est nulle.",
+	KeyRAStatusNot64:            "Non formée : SLAAC avec un identifiant d’interface EUI-64 de 64 bits exige un préfixe /64.",
+
+	KeyErrCalculation:        "Impossible de calculer l’adresse EUI-64",
+	KeyErrTooManyRequests:    "Trop de requêtes, veuillez patienter un instant puis réessayer",
+	KeyErrInvalidCSRFToken:   "Votre session a expiré, veuillez recharger la page puis réessayer",
+	KeyErrOfflineUnavailable: "Le serveur est injoignable et le calcul hors ligne n’est pas disponible",
+	KeyErrClientUnavailable:  "Le calculateur n’a pas pu être chargé, veuillez recharger la page",
+
+	KeyErrMACRequired:          "Une adresse MAC est requise (par ex. 00-14-22-01-23-45)",
+	KeyErrMACTooLong:           "L’adresse MAC dépasse 17 caractères (par ex. 00-14-22-01-23-45)",
+	KeyErrMACMalformed:         "L’adresse MAC doit comporter six paires hexadécimales séparées par des tirets ou des deux-points (par ex. 00-14-22-01-23-45)",
+	KeyErrMACInvalidChar:       "L’adresse MAC contient un caractère qui n’est ni un chiffre hexadécimal ni un séparateur (par ex. 00-14-22-01-23-45)",
+	KeyErrMACInvalidCharAt:     "L’adresse MAC contient %[1]q en position %[2]d, qui n’est ni un chiffre hexadécimal ni un séparateur (par ex. 00-14-22-01-23-45)",
+	KeyErrMACLength:            "L’adresse MAC doit comporter 6 octets (par ex. 00-14-22-01-23-45)",
+	KeyErrPrefixRequired:       "Un préfixe IPv6 est requis (par ex. 2001:db8::)",
+	KeyErrPrefixTooLong:        "Le préfixe IPv6 dépasse 19 caractères (par ex. 2001:db8:85a3:0)",
+	KeyErrPrefixTooManyParts:   "Le préfixe IPv6 comporte plus de 4 hextets, saisissez uniquement les 64 premiers bits (par ex. 2001:db8:85a3:0)",
+	KeyErrPrefixEmptyHextet:    "Le préfixe IPv6 contient un hextet vide entre deux deux-points, \"::\" ne peut que terminer le préfixe (par ex. 2001:db8::)",
+	KeyErrPrefixEmptyHextetAt:  "L’hextet %[3]d du préfixe IPv6 est vide, \"::\" en position %[2]d ne peut que terminer le préfixe (par ex. 2001:db8::)",
+	KeyErrPrefixInvalidChar:    "Le préfixe IPv6 contient un caractère qui n’est pas un chiffre hexadécimal (par ex. 2001:db8::)",
+	KeyErrPrefixInvalidCharAt:  "Le préfixe IPv6 contient %[1]q en position %[2]d, dans l’hextet %[3]d, qui n’est pas un chiffre hexadécimal (par ex. 2001:db8::)",
+	KeyErrPrefixHextetLength:   "Un hextet du préfixe IPv6 dépasse 4 chiffres (par ex. 2001:db8::)",
+	KeyErrPrefixHextetLengthAt: "L’hextet %[3]d du préfixe IPv6, %[1]q en position %[2]d, dépasse 4 chiffres (par ex. 2001:db8::)",
+	KeyErrPrefixInvalidHextet:  "Un hextet du préfixe IPv6 n’est pas un nombre hexadécimal (par ex. 2001:db8::)",
+	KeyErrParentRequired:       "Un préfixe parent est requis (par ex. 2001:db8:1::/48)",
+	KeyErrParentInvalid:        "Le préfixe parent doit être un préfixe IPv6 en notation CIDR (par ex. 2001:db8:1::/48)",
+	KeyErrParentTooLong:        "Le préfixe parent doit être plus court que /64 pour laisser de la place aux identifiants de sous-réseau (par ex. 2001:db8:1::/48)",
+	KeyErrSubnetIDsRequired:    "Au moins un identifiant de sous-réseau est requis (par ex. 1, a-f)",
+	KeyErrSubnetIDInvalid:      "Les identifiants de sous-réseau doivent être des nombres hexadécimaux ou des plages de ceux-ci (par ex. 1, a-f)",
+	KeyErrSubnetIDRange:        "Une plage d’identifiants de sous-réseau ne peut pas se terminer avant de commencer (par ex. a-f)",
+	KeyErrSubnetIDOutOfRange:   "Un identifiant de sous-réseau ne tient pas entre le préfixe parent et /64, le plus grand d’un /56 est ff (par ex. 1, a-f)",
+	KeyErrTooManySubnets:       "Les identifiants de sous-réseau couvrent plus de 256 sous-réseaux (par ex. 0-ff)",
+	KeyErrMatrixNoMACs:         "Au moins une adresse MAC est requise (par ex. 00-14-22-01-23-45)",
+	KeyErrMatrixNoPrefixes:     "Au moins un préfixe IPv6 est requis (par ex. 2001:db8::, fd00::)",
+	KeyErrMatrixTooLarge:       "La matrice compte plus de 1048576 combinaisons d’adresses MAC et de préfixes, divisez-la en matrices plus petites",
+	KeyErrULASubnetID:          "L’identifiant de sous-réseau doit être un nombre hexadécimal de 0 à ffff (par ex. 1)",
+	KeyErrAnalyzerRequired:     "Saisissez une adresse IPv6 à analyser (par ex. 2001:db8::214:22ff:fe01:2345)",
+	KeyErrAnalyzerInvalid:      "La valeur n’est pas une adresse IPv6 valide (par ex. 2001:db8::214:22ff:fe01:2345)",
+	KeyErrAnalyzerNotIPv6:      "La valeur est une adresse IPv4 ; saisissez une adresse IPv6 (par ex. 2001:db8::214:22ff:fe01:2345)",
+	KeyErrVerifyNoInterfaceID:  "L’adresse n’a pas d’identifiant d’interface, comme les adresses multicast et IPv4 mappées ; saisissez une adresse unicast (p. ex. 2001:db8::214:22ff:fe01:2345)",
+	KeyErrVerifyCSVInvalid:     "Le CSV est mal formé ; saisissez une paire par ligne (p. ex. 00-14-22-01-23-45,2001:db8::214:22ff:fe01:2345)",
+	KeyErrVerifyCSVFields:      "Chaque ligne du CSV doit contenir une adresse MAC, une adresse IPv6 et éventuellement un préfixe (p. ex. 00-14-22-01-23-45,2001:db8::214:22ff:fe01:2345,2001:db8::)",
+	KeyErrVerifyNoPairs:        "Au moins une paire d’adresse MAC et d’adresse IPv6 est requise (p. ex. 00-14-22-01-23-45,2001:db8::214:22ff:fe01:2345)",
+	KeyErrVerifyTooManyPairs:   "Le CSV contient plus de 65536 paires, divisez-le en plus petits",
+	KeyErrRangeEndAndCount:     "Saisissez une adresse MAC de fin ou un nombre, pas les deux (par ex. 50)",
+	KeyErrRangeEndBeforeStart:  "L’adresse MAC de fin précède l’adresse de début ; saisissez-en une plus grande (par ex. 00-14-22-01-23-ff)",
+	KeyErrRangeCount:           "Le nombre doit être un entier positif (par ex. 50)",
+	KeyErrRangeBeyondLast:      "La plage dépasse ff-ff-ff-ff-ff-ff ; saisissez un nombre plus petit (par ex. 50)",
+	KeyErrRangeBlock:           "La longueur de bloc doit être comprise entre /24 et /48 (par ex. /40)",
+	KeyErrRangeTooLarge:        "La plage compte plus de %d adresses MAC, divisez-la en plages plus petites",
+	KeyErrImportFile:           "Sélectionnez un fichier à importer (par ex. /var/lib/misc/dnsmasq.leases)",
+	KeyErrImportFormat:         "Le format n’est pas pris en charge ; choisissez-en un dans la liste (par ex. baux dnsmasq)",
+	KeyErrImportUndetected:     "Le format du fichier n’a pas pu être détecté ; choisissez-le dans la liste (par ex. ISC dhcpd.leases)",
+	KeyErrImportInvalid:        "Le fichier n’a pas pu être lu dans le format choisi ; vérifiez le format (par ex. la sortie de ip -j neigh est en JSON)",
+	KeyErrImportNoMACs:         "Le fichier ne contient aucune adresse MAC ; importez un fichier de baux ou une table de voisins (par ex. la sortie de ip -6 neigh)",
+	KeyErrImportTooLarge:       "Le fichier dépasse 1 Mio, divisez-le en fichiers plus petits",
+	KeyErrImportTooManyMACs:    "Le fichier compte plus de 4096 adresses MAC, divisez-le en fichiers plus petits",
+	KeyErrRARequired:           "Au moins une option Prefix Information est requise (par ex. 2001:db8:1::/64 LA 2592000 604800)",
+	KeyErrRAPrefix:             "Le préfixe doit être un préfixe IPv6 en notation CIDR (par ex. 2001:db8:1::/64)",
+	KeyErrRAFlags:              "Les drapeaux doivent être L, A, LA ou - pour aucun (par ex. 2001:db8:1::/64 LA)",
+	KeyErrRALifetime:           "Les durées de vie doivent être des secondes jusqu’à 4294967295 ou infinite (par ex. 2001:db8:1::/64 LA infinite 604800)",
+	KeyErrRAFields:             "Une ligne contient au plus un préfixe, ses drapeaux et deux durées de vie (par ex. 2001:db8:1::/64 LA 2592000 604800)",
+	KeyErrRATooMany:            "Il y a plus de 64 options Prefix Information, divisez-les en groupes plus petits",
+	KeyErrRALine:               "Ligne %d : %s",
+	KeyErrDUIDTime:             "Les heures des DUID-LLT doivent être comprises entre 2000 et 2136 ; vérifiez l’heure du DUID-LLT ou l’horloge",
+	KeyErrDUIDSyntax:           "Le DUID doit être composé d’octets hexadécimaux, éventuellement séparés par des deux-points (par ex. 00:03:00:01:00:14:22:01:23:45)",
+	KeyErrDUIDLength:           "Le DUID est trop court ou trop long pour son type (par ex. 00:03:00:01:00:14:22:01:23:45)",
+	KeyErrDUIDTooLong:          "Le DUID dépasse 130 octets (par ex. 00:03:00:01:00:14:22:01:23:45)",
+	KeyErrDUIDUnknownType:      "Le type de DUID doit être 1 (LLT), 2 (EN), 3 (LL) ou 4 (UUID) (par ex. 00:03:00:01:00:14:22:01:23:45)",
+	KeyErrDUIDHardware:         "Le type de matériel des DUID doit être un nombre décimal de 0 à 65535 (par ex. 1 pour Ethernet)",
+	KeyErrDUIDTimeSyntax:       "L’heure du DUID-LLT doit être une date et heure RFC 3339 ou des secondes depuis 2000 (par ex. 2024-05-01T12:00:00Z)",
+
+	KeyErrLinkTypeUnknown:              "Le type de lien doit être Ethernet, IEEE 802.15.4, Bluetooth LE ou un jeton d’identifiant d’interface",
+	KeyErrLinkTypeRange:                "Les plages d’adresses ne peuvent être calculées qu’à partir d’adresses MAC Ethernet, choisissez le type de lien Ethernet",
+	KeyErrShortAddressRequired:         "Une adresse courte IEEE 802.15.4 est requise (par ex. 0x1a2b)",
+	KeyErrShortAddressTooLong:          "L’adresse courte IEEE 802.15.4 dépasse 4 chiffres hexadécimaux (par ex. 0x1a2b)",
+	KeyErrShortAddressMalformed:        "L’adresse courte IEEE 802.15.4 doit comporter un à quatre chiffres hexadécimaux, éventuellement précédés de 0x (par ex. 0x1a2b)",
+	KeyErrShortAddressInvalidChar:      "L’adresse courte IEEE 802.15.4 contient un caractère qui n’est pas un chiffre hexadécimal (par ex. 0x1a2b)",
+	KeyErrShortAddressInvalidCharAt:    "L’adresse courte IEEE 802.15.4 contient %[1]q en position %[2]d, qui n’est pas un chiffre hexadécimal (par ex. 0x1a2b)",
+	KeyErrExtendedAddressRequired:      "Une adresse étendue IEEE 802.15.4 est requise (par ex. 00:12:4b:00:01:02:03:04)",
+	KeyErrExtendedAddressTooLong:       "L’adresse étendue IEEE 802.15.4 dépasse 23 caractères (par ex. 00:12:4b:00:01:02:03:04)",
+	KeyErrExtendedAddressMalformed:     "L’adresse étendue IEEE 802.15.4 doit comporter huit paires hexadécimales séparées par des tirets, des deux-points ou sans séparateur (par ex. 00:12:4b:00:01:02:03:04)",
+	KeyErrExtendedAddressInvalidChar:   "L’adresse étendue IEEE 802.15.4 contient un caractère qui n’est ni un chiffre hexadécimal ni un séparateur (par ex. 00:12:4b:00:01:02:03:04)",
+	KeyErrExtendedAddressInvalidCharAt: "L’adresse étendue IEEE 802.15.4 contient %[1]q en position %[2]d, qui n’est ni un chiffre hexadécimal ni un séparateur (par ex. 00:12:4b:00:01:02:03:04)",
+	KeyErrBLEAddressRequired:           "Une adresse d’appareil Bluetooth est requise (par ex. c2:14:22:01:23:45)",
+	KeyErrBLEAddressTooLong:            "L’adresse d’appareil Bluetooth dépasse 17 caractères (par ex. c2:14:22:01:23:45)",
+	KeyErrBLEAddressMalformed:          "L’adresse d’appareil Bluetooth doit comporter six paires hexadécimales séparées par des tirets ou des deux-points (par ex. c2:14:22:01:23:45)",
+	KeyErrBLEAddressInvalidChar:        "L’adresse d’appareil Bluetooth contient un caractère qui n’est ni un chiffre hexadécimal ni un séparateur (par ex. c2:14:22:01:23:45)",
+	KeyErrBLEAddressInvalidCharAt:      "L’adresse d’appareil Bluetooth contient %[1]q en position %[2]d, qui n’est ni un chiffre hexadécimal ni un séparateur (par ex. c2:14:22:01:23:45)",
+
+	KeyErrTokenRequired:       "Un jeton d’identifiant d’interface est requis (par ex. ::1:2)",
+	KeyErrTokenTooLong:        "Le jeton d’identifiant d’interface dépasse 19 caractères (par ex. ::1:2)",
+	KeyErrTokenMalformed:      "Le jeton d’identifiant d’interface doit comporter jusqu’à 4 hextets séparés par des deux-points, avec au plus un \"::\" remplaçant au moins un hextet (par ex. ::1:2)",
+	KeyErrTokenInvalidChar:    "Le jeton d’identifiant d’interface contient un caractère qui n’est ni un chiffre hexadécimal ni un deux-points (par ex. ::1:2)",
+	KeyErrTokenInvalidCharAt:  "Le jeton d’identifiant d’interface contient %[1]q en position %[2]d, qui n’est ni un chiffre hexadécimal ni un deux-points (par ex. ::1:2)",
+	KeyErrTokenHextetLength:   "Un hextet du jeton d’identifiant d’interface dépasse 4 chiffres (par ex. ::1:2)",
+	KeyErrTokenHextetLengthAt: "L’hextet %[1]q du jeton d’identifiant d’interface en position %[2]d dépasse 4 chiffres (par ex. ::1:2)",
+	KeyErrTokenZero:           "Le jeton d’identifiant d’interface ne doit pas être nul, car il forme l’adresse anycast Subnet-Router du préfixe (par ex. ::1:2)",
+}
@@ -0,0 +1,527 @@
+// Package i18n provides the message catalogs the EUI-64 calculator's user
+// interface is translated with, negotiates the locale of a request from its
+// preferences (an explicit choice or the Accept-Language header), and carries
+// the negotiated locale on the request context for templates and handlers.
+package i18n
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/analyzer"
+	"github.com/nicholas-fedor/eui64-calculator/internal/classify"
+	"github.com/nicholas-fedor/eui64-calculator/internal/duid"
+	"github.com/nicholas-fedor/eui64-calculator/internal/errcode"
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+	"github.com/nicholas-fedor/eui64-calculator/internal/verify"
+)
+
+// Messages maps message keys to their translation in one language. Messages
+// may contain fmt verbs, which are formatted with the arguments given to T.
+type Messages map[Key]string
+
+// Locale is a language the user interface is translated into.
+type Locale struct {
+	// Tag is the BCP 47 language tag of the locale (e.g., "de").
+	Tag string
+	// Name is the name of the language in the language itself (e.g., "Deutsch").
+	Name     string
+	messages Messages
+}
+
+// QueryParam is the query parameter selecting a locale on the server (e.g., "?lang=de").
+const QueryParam = "lang"
+
+// localeKey is the context key holding the negotiated Locale.
+type localeKey struct{}
+
+// Supported locales, in the order they are offered. English is the default.
+var (
+	English = &Locale{Tag: "en", Name: "English", messages: english}
+	German  = &Locale{Tag: "de", Name: "Deutsch", messages: german}
+	Spanish = &Locale{Tag: "es", Name: "Español", messages: spanish}
+	French  = &Locale{Tag: "fr", Name: "Français", messages: french}
+)
+
+// locales lists the supported locales; the first is the default.
+var locales = []*Locale{English, German, Spanish, French}
+
+// matcher matches language preferences against the supported locales.
+var matcher = language.NewMatcher(tags(locales))
+
+// errorKey is the message explaining an error and, if set, the message
+// explaining it when the error locates the offending part of the input.
+type errorKey struct {
+	key         Key
+	positionKey Key
+}
+
+// errorKeys maps the codes of the errors returned by the validators, the
+// calculator, the subnet planner, the matrix mode, the ULA generator, the
+// address analyzer, the address verifier, MAC ranges, the importer, the Router
+// Advertisement simulator, and DUIDs to the messages explaining them, see
+// errcode. Errors are identified by the first code in their tree, so errors
+// wrapping a sentinel error to add context are explained by the sentinel's
+// message.
+var errorKeys = map[errcode.Code]errorKey{
+	"mac.required":                       {KeyErrMACRequired, ""},
+	"mac.too_long":                       {KeyErrMACTooLong, ""},
+	"mac.invalid_character":              {KeyErrMACInvalidChar, KeyErrMACInvalidCharAt},
+	"mac.malformed":                      {KeyErrMACMalformed, ""},
+	"prefix.required":                    {KeyErrPrefixRequired, ""},
+	"prefix.too_long":                    {KeyErrPrefixTooLong, ""},
+	"prefix.too_many_hextets":            {KeyErrPrefixTooManyParts, ""},
+	"prefix.empty_hextet":                {KeyErrPrefixEmptyHextet, KeyErrPrefixEmptyHextetAt},
+	"prefix.invalid_character":           {KeyErrPrefixInvalidChar, KeyErrPrefixInvalidCharAt},
+	"prefix.hextet_length":               {KeyErrPrefixHextetLength, KeyErrPrefixHextetLengthAt},
+	"short_address.required":             {KeyErrShortAddressRequired, ""},
+	"short_address.too_long":             {KeyErrShortAddressTooLong, ""},
+	"short_address.invalid_character":    {KeyErrShortAddressInvalidChar, KeyErrShortAddressInvalidCharAt},
+	"short_address.malformed":            {KeyErrShortAddressMalformed, ""},
+	"extended_address.required":          {KeyErrExtendedAddressRequired, ""},
+	"extended_address.too_long":          {KeyErrExtendedAddressTooLong, ""},
+	"extended_address.invalid_character": {KeyErrExtendedAddressInvalidChar, KeyErrExtendedAddressInvalidCharAt},
+	"extended_address.malformed":         {KeyErrExtendedAddressMalformed, ""},
+	"ble_address.required":               {KeyErrBLEAddressRequired, ""},
+	"ble_address.too_long":               {KeyErrBLEAddressTooLong, ""},
+	"ble_address.invalid_character":      {KeyErrBLEAddressInvalidChar, KeyErrBLEAddressInvalidCharAt},
+	"ble_address.malformed":              {KeyErrBLEAddressMalformed, ""},
+	"token.required":                     {KeyErrTokenRequired, ""},
+	"token.too_long":                     {KeyErrTokenTooLong, ""},
+	"token.invalid_character":            {KeyErrTokenInvalidChar, KeyErrTokenInvalidCharAt},
+	"token.hextet_length":                {KeyErrTokenHextetLength, KeyErrTokenHextetLengthAt},
+	"token.malformed":                    {KeyErrTokenMalformed, ""},
+	"token.zero":                         {KeyErrTokenZero, ""},
+	"eui64.mac_malformed":                {KeyErrMACMalformed, ""},
+	"eui64.mac_length":                   {KeyErrMACLength, ""},
+	"eui64.prefix_too_many_hextets":      {KeyErrPrefixTooManyParts, ""},
+	"eui64.prefix_empty_hextet":          {KeyErrPrefixEmptyHextet, ""},
+	"eui64.prefix_invalid_hextet":        {KeyErrPrefixInvalidHextet, ""},
+	"link_type.unknown":                  {KeyErrLinkTypeUnknown, ""},
+	"eui64.short_address_malformed":      {KeyErrShortAddressMalformed, ""},
+	"eui64.extended_address_malformed":   {KeyErrExtendedAddressMalformed, ""},
+	"eui64.ble_address_malformed":        {KeyErrBLEAddressMalformed, ""},
+	"eui64.token_malformed":              {KeyErrTokenMalformed, ""},
+	"eui64.token_zero":                   {KeyErrTokenZero, ""},
+	"subnet.parent_required":             {KeyErrParentRequired, ""},
+	"subnet.parent_invalid":              {KeyErrParentInvalid, ""},
+	"subnet.parent_too_long":             {KeyErrParentTooLong, ""},
+	"subnet.ids_required":                {KeyErrSubnetIDsRequired, ""},
+	"subnet.id_invalid":                  {KeyErrSubnetIDInvalid, ""},
+	"subnet.id_range":                    {KeyErrSubnetIDRange, ""},
+	"subnet.id_out_of_range":             {KeyErrSubnetIDOutOfRange, ""},
+	"subnet.too_many_subnets":            {KeyErrTooManySubnets, ""},
+	"matrix.no_macs":                     {KeyErrMatrixNoMACs, ""},
+	"matrix.no_prefixes":                 {KeyErrMatrixNoPrefixes, ""},
+	"matrix.too_many_cells":              {KeyErrMatrixTooLarge, ""},
+	"ula.subnet_id":                      {KeyErrULASubnetID, ""},
+	"analyzer.required":                  {KeyErrAnalyzerRequired, ""},
+	"analyzer.invalid":                   {KeyErrAnalyzerInvalid, ""},
+	"analyzer.not_ipv6":                  {KeyErrAnalyzerNotIPv6, ""},
+	"verify.no_interface_id":             {KeyErrVerifyNoInterfaceID, ""},
+	"verify.csv_invalid":                 {KeyErrVerifyCSVInvalid, ""},
+	"verify.csv_fields":                  {KeyErrVerifyCSVFields, ""},
+	"verify.no_pairs":                    {KeyErrVerifyNoPairs, ""},
+	"verify.too_many_pairs":              {KeyErrVerifyTooManyPairs, ""},
+	"range.end_and_count":                {KeyErrRangeEndAndCount, ""},
+	"range.end_before_start":             {KeyErrRangeEndBeforeStart, ""},
+	"range.count":                        {KeyErrRangeCount, ""},
+	"range.beyond_last":                  {KeyErrRangeBeyondLast, ""},
+	"range.block":                        {KeyErrRangeBlock, ""},
+	"range.too_many_macs":                {KeyErrRangeTooLarge, ""},
+	"range.not_ethernet":                 {KeyErrLinkTypeRange, ""},
+	"import.file":                        {KeyErrImportFile, ""},
+	"import.format":                      {KeyErrImportFormat, ""},
+	"import.undetected":                  {KeyErrImportUndetected, ""},
+	"import.invalid":                     {KeyErrImportInvalid, ""},
+	"import.no_macs":                     {KeyErrImportNoMACs, ""},
+	"import.too_large":                   {KeyErrImportTooLarge, ""},
+	"import.too_many_macs":               {KeyErrImportTooManyMACs, ""},
+	"ra.required":                        {KeyErrRARequired, ""},
+	"ra.prefix":                          {KeyErrRAPrefix, ""},
+	"ra.flags":                           {KeyErrRAFlags, ""},
+	"ra.lifetime":                        {KeyErrRALifetime, ""},
+	"ra.fields":                          {KeyErrRAFields, ""},
+	"ra.too_many":                        {KeyErrRATooMany, ""},
+	"duid.time":                          {KeyErrDUIDTime, ""},
+	"duid.syntax":                        {KeyErrDUIDSyntax, ""},
+	"duid.length":                        {KeyErrDUIDLength, ""},
+	"duid.too_long":                      {KeyErrDUIDTooLong, ""},
+	"duid.unknown_type":                  {KeyErrDUIDUnknownType, ""},
+	"duid.hardware":                      {KeyErrDUIDHardware, ""},
+	"duid.time_syntax":                   {KeyErrDUIDTimeSyntax, ""},
+}
+
+// slaacStatusKeys maps the outcomes of SLAAC from Prefix Information options
+// that form no address to the messages explaining them.
+var slaacStatusKeys = map[eui64.SLAACStatus]Key{
+	eui64.SLAACNotAutonomous:    KeyRAStatusNotAutonomous,
+	eui64.SLAACLinkLocal:        KeyRAStatusLinkLocal,
+	eui64.SLAACMulticast:        KeyRAStatusMulticast,
+	eui64.SLAACInvalidLifetimes: KeyRAStatusInvalidLifetimes,
+	eui64.SLAACExpired:          KeyRAStatusExpired,
+	eui64.SLAACNot64:            KeyRAStatusNot64,
+}
+
+// prefixTypeKeys maps the types of address space prefixes are classified as to
+// their names.
+var prefixTypeKeys = map[classify.Type]Key{
+	classify.TypeGUA:           KeyPrefixTypeGUA,
+	classify.TypeULA:           KeyPrefixTypeULA,
+	classify.TypeLinkLocal:     KeyPrefixTypeLinkLocal,
+	classify.TypeDocumentation: KeyPrefixTypeDocumentation,
+	classify.TypeMulticast:     KeyPrefixTypeMulticast,
+	classify.Type6to4:          KeyPrefixType6to4,
+	classify.TypeTeredo:        KeyPrefixTypeTeredo,
+	classify.TypeReserved:      KeyPrefixTypeReserved,
+}
+
+// prefixWarningKeys maps the warnings about classified prefixes to the messages
+// explaining them.
+var prefixWarningKeys = map[classify.Warning]Key{
+	classify.WarningMulticast:       KeyPrefixWarningMulticast,
+	classify.WarningLinkLocalBits:   KeyPrefixWarningLinkLocalBits,
+	classify.WarningULAUnassigned:   KeyPrefixWarningULAUnassigned,
+	classify.WarningDocumentation:   KeyPrefixWarningDocumentation,
+	classify.WarningTeredo:          KeyPrefixWarningTeredo,
+	classify.WarningDeprecated6to4:  KeyPrefixWarningDeprecated6to4,
+	classify.WarningReservedUnicast: KeyPrefixWarningReserved,
+}
+
+// scopeKeys maps the scopes of analyzed addresses to their names.
+var scopeKeys = map[analyzer.Scope]Key{
+	analyzer.ScopeNone:              KeyScopeNone,
+	analyzer.ScopeInterfaceLocal:    KeyScopeInterfaceLocal,
+	analyzer.ScopeLinkLocal:         KeyScopeLinkLocal,
+	analyzer.ScopeRealmLocal:        KeyScopeRealmLocal,
+	analyzer.ScopeAdminLocal:        KeyScopeAdminLocal,
+	analyzer.ScopeSiteLocal:         KeyScopeSiteLocal,
+	analyzer.ScopeOrganizationLocal: KeyScopeOrganizationLocal,
+	analyzer.ScopeGlobal:            KeyScopeGlobal,
+	analyzer.ScopeReserved:          KeyScopeReserved,
+}
+
+// iidTypeKeys maps the types of interface identifiers of analyzed addresses to
+// their descriptions.
+var iidTypeKeys = map[analyzer.IIDType]Key{
+	analyzer.IIDEUI64:        KeyIIDEUI64,
+	analyzer.IIDShortAddress: KeyIIDShortAddress,
+	analyzer.IIDISATAP:       KeyIIDISATAP,
+	analyzer.IIDTeredo:       KeyIIDTeredo,
+	analyzer.IIDLowByte:      KeyIIDLowByte,
+	analyzer.IIDRandom:       KeyIIDRandom,
+}
+
+// embeddingKeys maps the mechanisms embedding IPv4 addresses in analyzed
+// addresses to the labels of the embedded addresses.
+var embeddingKeys = map[analyzer.Embedding]Key{
+	analyzer.Embedding6to4:   KeyFactIPv46to4,
+	analyzer.EmbeddingISATAP: KeyFactIPv4ISATAP,
+	analyzer.EmbeddingMapped: KeyFactIPv4Mapped,
+	analyzer.EmbeddingNAT64:  KeyFactIPv4NAT64,
+}
+
+// Fact is a labeled value describing an analyzed address, see Locale.Facts.
+type Fact struct {
+	Label string
+	Value string
+}
+
+// Default returns the locale used when no preference matches a supported locale.
+func Default() *Locale {
+	return locales[0]
+}
+
+// Locales returns the supported locales in the order they are offered.
+func Locales() []*Locale {
+	return append([]*Locale(nil), locales...)
+}
+
+// Lookup returns the supported locale with exactly the given tag.
+func Lookup(tag string) (*Locale, bool) {
+	for _, locale := range locales {
+		if locale.Tag == tag {
+			return locale, true
+		}
+	}
+
+	return nil, false
+}
+
+// Match returns the supported locale best matching the first preference that
+// matches any, or the default locale if none does. Each preference is a
+// language tag or an Accept-Language header value; empty and malformed
+// preferences are skipped.
+func Match(preferences ...string) *Locale {
+	for _, preference := range preferences {
+		if preference == "" {
+			continue
+		}
+
+		tags, _, err := language.ParseAcceptLanguage(preference)
+		if err != nil || len(tags) == 0 {
+			continue
+		}
+
+		if _, index, confidence := matcher.Match(tags...); confidence != language.No {
+			return locales[index]
+		}
+	}
+
+	return Default()
+}
+
+// WithLocale returns a copy of ctx carrying the locale to render messages in.
+func WithLocale(ctx context.Context, locale *Locale) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// FromContext returns the locale carried by ctx, or the default locale if none is set.
+func FromContext(ctx context.Context) *Locale {
+	if locale, ok := ctx.Value(localeKey{}).(*Locale); ok && locale != nil {
+		return locale
+	}
+
+	return Default()
+}
+
+// T returns the message for key in the locale, falling back to the default
+// locale's message and then to the key itself. Arguments are formatted into
+// the message with fmt.Sprintf.
+func (l *Locale) T(key Key, args ...any) string {
+	message, ok := l.messages[key]
+	if !ok {
+		message, ok = Default().messages[key]
+	}
+
+	if !ok {
+		return string(key)
+	}
+
+	if len(args) > 0 {
+		return fmt.Sprintf(message, args...)
+	}
+
+	return message
+}
+
+// Error returns the explanation of a validation or calculation error in the
+// locale, naming the offending character or hextet and its position when the
+// error locates it, or the error's own message if it has no translation.
+// Errors are identified by their code, see errcode, and explained with their
+// arguments if they have any, such as the maximum of MAC ranges. Invalid lines
+// of Prefix Information options are explained with their number.
+func (l *Locale) Error(err error) string {
+	var lineErr *eui64.LineError
+	if errors.As(err, &lineErr) {
+		return l.T(KeyErrRALine, lineErr.Line, l.Error(lineErr.Err))
+	}
+
+	code, _ := errcode.Of(err)
+
+	entry, ok := errorKeys[code]
+	if !ok {
+		return err.Error()
+	}
+
+	var located errcode.Located
+	if entry.positionKey != "" && errors.As(err, &located) {
+		if value, position, hextet := located.Location(); value != "" {
+			return l.T(entry.positionKey, value, position, hextet)
+		}
+	}
+
+	var detailed errcode.Detailed
+	if errors.As(err, &detailed) {
+		return l.T(entry.key, detailed.ErrorArgs()...)
+	}
+
+	return l.T(entry.key)
+}
+
+// PrefixType returns the name of a type of address space in the locale, or the
+// type itself if it has no translation.
+func (l *Locale) PrefixType(prefixType classify.Type) string {
+	if key, ok := prefixTypeKeys[prefixType]; ok {
+		return l.T(key)
+	}
+
+	return string(prefixType)
+}
+
+// PrefixWarning returns the explanation of a warning about a classified prefix
+// in the locale, or an empty string for classify.WarningNone.
+func (l *Locale) PrefixWarning(warning classify.Warning) string {
+	if warning == classify.WarningNone {
+		return ""
+	}
+
+	if key, ok := prefixWarningKeys[warning]; ok {
+		return l.T(key)
+	}
+
+	return string(warning)
+}
+
+// Facts describes an analyzed address in the locale: its compressed and
+// expanded forms, scope, and prefix type, followed by its interface identifier,
+// the MAC address it was derived from, the IPv4 addresses it embeds or the
+// local-use translation prefix it is in, and its Teredo fields, when it has them.
+func (l *Locale) Facts(analysis analyzer.Analysis) []Fact {
+	facts := []Fact{
+		{Label: l.T(KeyFactAddress), Value: analysis.Address.String()},
+		{Label: l.T(KeyFactExpanded), Value: analysis.Address.StringExpanded()},
+		{Label: l.T(KeyFactScope), Value: name(l, scopeKeys, analysis.Scope)},
+		{Label: l.T(KeyPrefixTypeLabel), Value: l.PrefixType(analysis.Prefix.Type)},
+	}
+
+	if analysis.IIDType != analyzer.IIDNone {
+		facts = append(facts,
+			Fact{Label: l.T(KeyFactInterfaceID), Value: analysis.InterfaceID},
+			Fact{Label: l.T(KeyFactIIDType), Value: name(l, iidTypeKeys, analysis.IIDType)},
+		)
+	}
+
+	if analysis.MAC != "" {
+		facts = append(facts, Fact{Label: l.T(KeyFactMAC), Value: analysis.MAC})
+	}
+
+	for _, embedded := range analysis.IPv4 {
+		facts = append(facts, Fact{Label: name(l, embeddingKeys, embedded.Embedding), Value: embedded.Address.String()})
+	}
+
+	if analysis.Translation.IsValid() {
+		facts = append(facts, Fact{Label: l.T(KeyFactNAT64Local), Value: analysis.Translation.String()})
+	}
+
+	if teredo := analysis.Teredo; teredo != nil {
+		nat := KeyTeredoRestricted
+		if teredo.Cone {
+			nat = KeyTeredoCone
+		}
+
+		facts = append(facts,
+			Fact{Label: l.T(KeyFactTeredoServer), Value: teredo.Server.String()},
+			Fact{Label: l.T(KeyFactTeredoClient), Value: teredo.Client.String()},
+			Fact{Label: l.T(KeyFactTeredoPort), Value: strconv.Itoa(int(teredo.Port))},
+			Fact{Label: l.T(KeyFactTeredoNAT), Value: l.T(nat)},
+		)
+	}
+
+	return facts
+}
+
+// Verdict returns the explanation of the outcome of a verification in the
+// locale: whether the address matches the MAC address and, if not, why.
+func (l *Locale) Verdict(result verify.Result) string {
+	switch result.Status {
+	case verify.StatusMatch:
+		return l.T(KeyVerifyMatch)
+	case verify.StatusNotEUI64:
+		return l.T(KeyVerifyNotEUI64, name(l, iidTypeKeys, result.IIDType))
+	case verify.StatusIIDMismatch:
+		return l.T(KeyVerifyIIDMismatch, result.ObservedMAC)
+	case verify.StatusPrefixMismatch:
+		return l.T(KeyVerifyPrefixMismatch)
+	default:
+		return string(result.Status)
+	}
+}
+
+// VerificationFacts returns the facts supporting the outcome of a verification
+// in the locale: the expected address, the interface ID of the observed one and
+// its type, and the MAC address an EUI-64 interface ID was derived from.
+func (l *Locale) VerificationFacts(result verify.Result) []Fact {
+	facts := []Fact{
+		{Label: l.T(KeyFactExpectedAddress), Value: result.Expected},
+		{Label: l.T(KeyFactInterfaceID), Value: result.InterfaceID},
+		{Label: l.T(KeyFactIIDType), Value: name(l, iidTypeKeys, result.IIDType)},
+	}
+
+	if result.ObservedMAC != "" {
+		facts = append(facts, Fact{Label: l.T(KeyFactObservedMAC), Value: result.ObservedMAC})
+	}
+
+	return facts
+}
+
+// DUIDFacts describes a decoded DUID in the locale: its type and the
+// components of that type, with the MAC address of DUIDs based on an Ethernet
+// address.
+func (l *Locale) DUIDFacts(decoded duid.DUID) []Fact {
+	facts := []Fact{{Label: l.T(KeyFactDUIDType), Value: decoded.Type.String()}}
+
+	switch decoded.Type {
+	case duid.TypeLLT, duid.TypeLL:
+		hardware := strconv.Itoa(int(decoded.HardwareType))
+		if decoded.HardwareType == duid.HardwareEthernet {
+			hardware = l.T(KeyHardwareEthernet, decoded.HardwareType)
+		}
+
+		facts = append(facts, Fact{Label: l.T(KeyFactHardwareType), Value: hardware})
+
+		if decoded.Type == duid.TypeLLT {
+			facts = append(facts, Fact{Label: l.T(KeyFactDUIDTime), Value: decoded.Time.Format(time.RFC3339)})
+		}
+
+		facts = append(facts, Fact{Label: l.T(KeyFactLinkLayerAddress), Value: duid.Format(decoded.LinkLayerAddress)})
+
+		if mac := decoded.MAC(); mac != "" {
+			facts = append(facts, Fact{Label: l.T(KeyFactMAC), Value: mac})
+		}
+	case duid.TypeEN:
+		facts = append(facts,
+			Fact{Label: l.T(KeyFactEnterpriseNumber), Value: strconv.FormatUint(uint64(decoded.EnterpriseNumber), 10)},
+			Fact{Label: l.T(KeyFactDUIDIdentifier), Value: duid.Format(decoded.Identifier)},
+		)
+	case duid.TypeUUID:
+		facts = append(facts, Fact{Label: l.T(KeyFactUUID), Value: decoded.UUID})
+	}
+
+	return facts
+}
+
+// SLAACStatus returns the explanation of why a Prefix Information option forms
+// no address in the locale, or an empty string for eui64.SLAACFormed.
+func (l *Locale) SLAACStatus(status eui64.SLAACStatus) string {
+	if status == eui64.SLAACFormed {
+		return ""
+	}
+
+	return name(l, slaacStatusKeys, status)
+}
+
+// Lifetime returns a lifetime of a Prefix Information option in the locale, in
+// seconds or infinite.
+func (l *Locale) Lifetime(seconds uint32) string {
+	if seconds == eui64.InfiniteLifetime {
+		return l.T(KeyRALifetimeInfinite)
+	}
+
+	return l.T(KeyRALifetimeSeconds, seconds)
+}
+
+// name returns the message in the locale naming value with the key keys maps it
+// to, or value itself if it has no translation.
+func name[T ~string](l *Locale, keys map[T]Key, value T) string {
+	if key, ok := keys[value]; ok {
+		return l.T(key)
+	}
+
+	return string(value)
+}
+
+// tags returns the language tags of the given locales.
+func tags(locales []*Locale) []language.Tag {
+	result := make([]language.Tag, len(locales))
+	for i, locale := range locales {
+		result[i] = language.Make(locale.Tag)
+	}
+
+	return result
+}
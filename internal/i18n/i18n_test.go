@@ -0,0 +1,644 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/analyzer"
+	"github.com/nicholas-fedor/eui64-calculator/internal/classify"
+	"github.com/nicholas-fedor/eui64-calculator/internal/duid"
+	"github.com/nicholas-fedor/eui64-calculator/internal/errcode"
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+	"github.com/nicholas-fedor/eui64-calculator/internal/importer"
+	"github.com/nicholas-fedor/eui64-calculator/internal/macrange"
+	"github.com/nicholas-fedor/eui64-calculator/internal/matrix"
+	"github.com/nicholas-fedor/eui64-calculator/internal/subnet"
+	"github.com/nicholas-fedor/eui64-calculator/internal/ula"
+	"github.com/nicholas-fedor/eui64-calculator/internal/validators"
+	"github.com/nicholas-fedor/eui64-calculator/internal/verify"
+)
+
+// formatVerb matches the fmt verbs a message may contain, including explicit
+// argument indexes.
+var formatVerb = regexp.MustCompile(`%[-+# 0]*(?:\[[0-9]+\])?[0-9]*(?:\.[0-9]+)?[a-zA-Z%]`)
+
+// formatVerbs returns the fmt verbs of a message, sorted so that translations
+// may use them in a different order.
+func formatVerbs(message string) []string {
+	verbs := formatVerb.FindAllString(message, -1)
+	slices.Sort(verbs)
+
+	return verbs
+}
+
+// declaredKeys returns the values of the Key constants declared in keys.go.
+func declaredKeys(t *testing.T) []Key {
+	t.Helper()
+
+	file, err := parser.ParseFile(token.NewFileSet(), "keys.go", nil, 0)
+	require.NoError(t, err)
+
+	var keys []Key
+
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			for _, value := range spec.(*ast.ValueSpec).Values {
+				literal, ok := value.(*ast.BasicLit)
+				require.True(t, ok, "Keys should be string literals")
+
+				key, err := strconv.Unquote(literal.Value)
+				require.NoError(t, err)
+
+				keys = append(keys, Key(key))
+			}
+		}
+	}
+
+	return keys
+}
+
+// TestCatalogs verifies that every catalog translates exactly the declared
+// keys, with no empty messages, and keeps the fmt verbs of the English message,
+// in any order.
+func TestCatalogs(t *testing.T) {
+	t.Parallel()
+
+	keys := declaredKeys(t)
+	require.NotEmpty(t, keys)
+
+	for _, locale := range Locales() {
+		t.Run(locale.Tag, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Len(t, locale.messages, len(keys), "Catalog should have a message for each key")
+
+			for _, key := range keys {
+				message, ok := locale.messages[key]
+				if !assert.True(t, ok, "Missing message for %q", key) {
+					continue
+				}
+
+				assert.NotEmpty(t, message, "Empty message for %q", key)
+				assert.Equal(
+					t,
+					formatVerbs(English.messages[key]),
+					formatVerbs(message),
+					"Message for %q should keep the English fmt verbs",
+					key,
+				)
+			}
+		})
+	}
+}
+
+// TestLocales verifies that locales have unique tags and names, can be looked
+// up by tag, and that English is the default.
+func TestLocales(t *testing.T) {
+	t.Parallel()
+
+	assert.Same(t, English, Default())
+
+	tags := map[string]bool{}
+	names := map[string]bool{}
+
+	for _, locale := range Locales() {
+		assert.False(t, tags[locale.Tag], "Duplicate tag %q", locale.Tag)
+		assert.False(t, names[locale.Name], "Duplicate name %q", locale.Name)
+		tags[locale.Tag], names[locale.Name] = true, true
+
+		found, ok := Lookup(locale.Tag)
+		assert.True(t, ok)
+		assert.Same(t, locale, found)
+	}
+
+	_, ok := Lookup("de-DE")
+	assert.False(t, ok, "Lookup should only accept supported tags")
+}
+
+// TestMatch verifies locale negotiation from language tags and Accept-Language values.
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		preferences []string
+		want        *Locale
+	}{
+		{name: "No preferences", preferences: nil, want: English},
+		{name: "Exact tag", preferences: []string{"fr"}, want: French},
+		{name: "Regional variant", preferences: []string{"de-CH"}, want: German},
+		{name: "Quality values", preferences: []string{"en;q=0.5, es;q=0.8"}, want: Spanish},
+		{name: "Unsupported language", preferences: []string{"ja"}, want: English},
+		{name: "Malformed preference", preferences: []string{";;;"}, want: English},
+		{name: "First matching preference wins", preferences: []string{"", "ja", "fr", "de"}, want: French},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Same(t, tt.want, Match(tt.preferences...))
+		})
+	}
+}
+
+// TestT verifies message lookup, argument formatting and fallbacks.
+func TestT(t *testing.T) {
+	t.Parallel()
+
+	partial := &Locale{
+		Tag:      "xx",
+		Name:     "Test",
+		messages: Messages{KeyCalculate: "Compute %d"},
+	}
+
+	assert.Equal(t, "Berechnen", German.T(KeyCalculate))
+	assert.Equal(t, "Compute 4", partial.T(KeyCalculate, 4))
+	assert.Equal(t, English.T(KeyClear), partial.T(KeyClear), "Missing messages fall back to English")
+	assert.Equal(t, "missing.key", partial.T(Key("missing.key")), "Unknown keys render as themselves")
+}
+
+// TestError verifies that validation and calculation errors, wrapped or not,
+// are explained in the locale, and other errors keep their message.
+func TestError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "Validator error",
+			err:  validators.ErrEmptyHextet,
+			want: German.T(KeyErrPrefixEmptyHextet),
+		},
+		{
+			name: "Wrapped validator error",
+			err:  fmt.Errorf("%w: %w", validators.ErrMACParseFailed, errors.New("invalid MAC")),
+			want: German.T(KeyErrMACMalformed),
+		},
+		{
+			name: "Calculator error",
+			err:  eui64.ErrInvalidMACLength,
+			want: German.T(KeyErrMACLength),
+		},
+		{
+			name: "Subnet planner error",
+			err:  fmt.Errorf("%w: %q exceeds ff", subnet.ErrIDOutOfRange, "100"),
+			want: German.T(KeyErrSubnetIDOutOfRange),
+		},
+		{
+			name: "Matrix error",
+			err:  matrix.Check([]string{"00-14-22-01-23-45"}, nil),
+			want: German.T(KeyErrMatrixNoPrefixes),
+		},
+		{
+			name: "ULA generator error",
+			err:  fmt.Errorf("%w, got %q", ula.ErrInvalidSubnetID, "10000"),
+			want: German.T(KeyErrULASubnetID),
+		},
+		{
+			name: "Analyzer error",
+			err:  fmt.Errorf("%w: %s", analyzer.ErrNotIPv6, "192.0.2.1"),
+			want: German.T(KeyErrAnalyzerNotIPv6),
+		},
+		{
+			name: "Verification input error",
+			err:  &verify.InputError{Input: verify.InputAddress, Err: verify.ErrNoInterfaceID},
+			want: German.T(KeyErrVerifyNoInterfaceID),
+		},
+		{
+			name: "MAC range ending before its start",
+			err:  &macrange.InputError{Input: macrange.InputEnd, Err: macrange.ErrEndBeforeStart},
+			want: German.T(KeyErrRangeEndBeforeStart),
+		},
+		{
+			name: "MAC range above its maximum",
+			err:  &macrange.InputError{Input: macrange.InputCount, Err: &macrange.LimitError{Count: 300, Max: 256}},
+			want: "Der Bereich hat mehr als 256 MAC-Adressen, teilen Sie ihn in kleinere auf",
+		},
+		{
+			name: "Import format not detected",
+			err:  importer.ErrUndetected,
+			want: German.T(KeyErrImportUndetected),
+		},
+		{
+			name: "Invalid line of Prefix Information options",
+			err:  &eui64.LineError{Line: 3, Err: fmt.Errorf("%w: %q", eui64.ErrPrefixInfoFlags, "X")},
+			want: "Zeile 3: " + German.T(KeyErrRAFlags),
+		},
+		{
+			name: "Positioned invalid prefix character",
+			err:  validators.ValidateIPv6Prefix("2001:db8:85a3:g000"),
+			want: `Das IPv6-Präfix enthält an Position 15, in Hextet 4, "g", das keine hexadezimale Ziffer ist (z. B. 2001:db8::)`,
+		},
+		{
+			name: "Positioned empty hextet",
+			err:  validators.ValidateIPv6Prefix("2001::85a3:0"),
+			want: `Hextet 2 des IPv6-Präfixes ist leer, "::" an Position 5 darf nur am Ende stehen (z. B. 2001:db8::)`,
+		},
+		{
+			name: "Positioned long hextet",
+			err:  validators.ValidateIPv6Prefix("2001:db8:85a3:12345"),
+			want: `Hextet 4 des IPv6-Präfixes, "12345" an Position 15, ist länger als 4 Ziffern (z. B. 2001:db8::)`,
+		},
+		{
+			name: "Positioned invalid MAC character",
+			err:  validators.ValidateMAC("00-14-22-01-23-4g"),
+			want: `Die MAC-Adresse enthält an Position 17 "g", das weder eine hexadezimale Ziffer noch ein Trennzeichen ist (z. B. 00-14-22-01-23-45)`,
+		},
+		{
+			name: "Malformed MAC without position",
+			err:  validators.ValidateMAC("00-14-22-01-23"),
+			want: German.T(KeyErrMACMalformed),
+		},
+		{
+			name: "Calculator hextet error",
+			err:  fmt.Errorf("%w %q in IPv6 prefix", eui64.ErrInvalidHextet, "zz"),
+			want: German.T(KeyErrPrefixInvalidHextet),
+		},
+		{
+			name: "Positioned invalid BLE address character",
+			err:  validators.ValidateLinkAddress(eui64.LinkBLE, "c2-14-22-01-23-4g"),
+			want: `Die Bluetooth-Geräteadresse enthält an Position 17 "g", das weder eine hexadezimale Ziffer noch ein Trennzeichen ist (z. B. c2:14:22:01:23:45)`,
+		},
+		{
+			name: "Malformed extended address",
+			err:  validators.ValidateLinkAddress(eui64.LinkIEEE802154Extended, "00-14-22-01-23-45"),
+			want: German.T(KeyErrExtendedAddressMalformed),
+		},
+		{
+			name: "Unknown link type",
+			err:  validators.ValidateLinkAddress("token-ring", "00-14-22-01-23-45"),
+			want: German.T(KeyErrLinkTypeUnknown),
+		},
+		{
+			name: "Positioned long token hextet",
+			err:  validators.ValidateLinkAddress(eui64.LinkToken, "1::2:12345"),
+			want: `Das Hextet "12345" des Interface-ID-Tokens an Position 6 ist länger als 4 Ziffern (z. B. ::1:2)`,
+		},
+		{
+			name: "Calculator token error",
+			err:  fmt.Errorf("%w: %q", eui64.ErrParseToken, "1::2::3"),
+			want: German.T(KeyErrTokenMalformed),
+		},
+		{
+			name: "DUID error",
+			err:  fmt.Errorf("%w: %d", duid.ErrUnknownType, 9),
+			want: German.T(KeyErrDUIDUnknownType),
+		},
+		{
+			name: "Unknown error",
+			err:  errors.New("boom"),
+			want: "boom",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, German.Error(tt.err))
+		})
+	}
+}
+
+// TestErrorPositionArguments verifies that every locale formats the messages of
+// positioned errors with all of their arguments and no formatting errors.
+func TestErrorPositionArguments(t *testing.T) {
+	t.Parallel()
+
+	for _, locale := range Locales() {
+		for _, entry := range errorKeys {
+			if entry.positionKey == "" {
+				continue
+			}
+
+			message := locale.T(entry.positionKey, "x", 7, 3)
+			assert.NotContains(t, message, "%!", "Malformed %s message for %q", locale.Tag, entry.positionKey)
+			assert.Contains(t, message, "7", "%s message for %q should name the position", locale.Tag, entry.positionKey)
+		}
+	}
+}
+
+// TestErrorCodes verifies that the code of every sentinel error of the domain
+// packages has a translation, so no error falls back to its English message.
+func TestErrorCodes(t *testing.T) {
+	t.Parallel()
+
+	sentinels := []error{
+		validators.ErrMACRequired, validators.ErrMACLengthExceeds, validators.ErrMACParseFailed,
+		validators.ErrInvalidMACChar, validators.ErrShortAddressRequired, validators.ErrShortAddressLengthExceeds,
+		validators.ErrInvalidShortAddressChar, validators.ErrShortAddressParseFailed,
+		validators.ErrExtendedAddressRequired, validators.ErrExtendedAddressLengthExceeds,
+		validators.ErrInvalidExtendedAddressChar, validators.ErrExtendedAddressParseFailed,
+		validators.ErrBLEAddressRequired, validators.ErrBLEAddressLengthExceeds, validators.ErrInvalidBLEAddressChar,
+		validators.ErrBLEAddressParseFailed, validators.ErrEmptyPrefix, validators.ErrPrefixLengthExceeds,
+		validators.ErrPrefixHextetsExceeds, validators.ErrEmptyHextet, validators.ErrInvalidHextetChar,
+		validators.ErrInvalidHextetLength, validators.ErrTokenRequired, validators.ErrTokenLengthExceeds,
+		validators.ErrInvalidTokenChar, validators.ErrInvalidTokenHextet,
+		validators.ErrTokenMalformed, validators.ErrTokenZero,
+		eui64.ErrPrefixInfoRequired, eui64.ErrPrefixInfoPrefix, eui64.ErrPrefixInfoFlags, eui64.ErrPrefixInfoLifetime,
+		eui64.ErrPrefixInfoFields, eui64.ErrTooManyPrefixInfo, eui64.ErrParseMAC, eui64.ErrInvalidMACLength,
+		eui64.ErrPrefixExceedsHextets, eui64.ErrInvalidEmptyHextet, eui64.ErrInvalidHextet, eui64.ErrUnknownLinkType,
+		eui64.ErrParseShortAddress, eui64.ErrParseExtendedAddress, eui64.ErrParseBLEAddress, eui64.ErrParseToken,
+		eui64.ErrZeroToken,
+		subnet.ErrParentRequired, subnet.ErrInvalidParent, subnet.ErrParentTooLong, subnet.ErrIDsRequired,
+		subnet.ErrInvalidID, subnet.ErrIDOutOfRange, subnet.ErrInvalidRange, subnet.ErrTooManySubnets,
+		matrix.ErrNoMACs, matrix.ErrNoPrefixes, matrix.ErrTooManyCells,
+		ula.ErrInvalidSubnetID,
+		analyzer.ErrAddressRequired, analyzer.ErrInvalidAddress, analyzer.ErrNotIPv6,
+		verify.ErrNoInterfaceID, verify.ErrInvalidCSV, verify.ErrRecordFields, verify.ErrNoPairs,
+		verify.ErrTooManyPairs,
+		macrange.ErrEndAndCount, macrange.ErrEndBeforeStart, macrange.ErrInvalidCount, macrange.ErrBeyondLastMAC,
+		macrange.ErrInvalidBlock, macrange.ErrTooManyMACs, macrange.ErrNotEthernet,
+		importer.ErrNoFile, importer.ErrUnknownFormat, importer.ErrUndetected, importer.ErrInvalidFile,
+		importer.ErrNoEntries, importer.ErrTooLarge, importer.ErrTooManyMACs,
+		duid.ErrTime, duid.ErrSyntax, duid.ErrLength, duid.ErrTooLong, duid.ErrUnknownType, duid.ErrHardware, duid.ErrTimeSyntax,
+	}
+
+	for _, err := range sentinels {
+		code, ok := errcode.Of(err)
+		require.True(t, ok, "%q should have a code", err)
+		assert.Contains(t, errorKeys, code, "Code %q of %q should be translated", code, err)
+	}
+}
+
+// TestPrefixType verifies that every type of address space has a name and that
+// unknown types fall back to the type itself.
+func TestPrefixType(t *testing.T) {
+	t.Parallel()
+
+	types := []classify.Type{
+		classify.TypeMulticast, classify.TypeLinkLocal, classify.TypeULA, classify.TypeDocumentation,
+		classify.TypeTeredo, classify.Type6to4, classify.TypeGUA, classify.TypeReserved,
+	}
+	for _, prefixType := range types {
+		assert.Contains(t, prefixTypeKeys, prefixType)
+	}
+
+	assert.Equal(t, "Link-Local", German.PrefixType(classify.TypeLinkLocal))
+	assert.Equal(t, "unknown", German.PrefixType("unknown"))
+}
+
+// TestPrefixWarning verifies that every warning about a classified prefix has
+// an explanation and that the absence of a warning has none.
+func TestPrefixWarning(t *testing.T) {
+	t.Parallel()
+
+	warnings := []classify.Warning{
+		classify.WarningMulticast, classify.WarningLinkLocalBits, classify.WarningULAUnassigned,
+		classify.WarningDocumentation, classify.WarningTeredo, classify.WarningDeprecated6to4,
+		classify.WarningReservedUnicast,
+	}
+	for _, warning := range warnings {
+		assert.NotEmpty(t, French.PrefixWarning(warning))
+		assert.NotEqual(t, string(warning), French.PrefixWarning(warning))
+	}
+
+	assert.Empty(t, French.PrefixWarning(classify.WarningNone))
+}
+
+// TestFacts verifies that analyzed addresses are described by the facts they
+// have, in order, with their values named in the locale.
+func TestFacts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		address string
+		want    []Fact
+	}{
+		{
+			name:    "EUI-64",
+			address: "fe80::214:22ff:fe01:2345",
+			want: []Fact{
+				{"Address", "fe80::214:22ff:fe01:2345"},
+				{"Expanded", "fe80:0000:0000:0000:0214:22ff:fe01:2345"},
+				{"Scope", "Link-local"},
+				{"Prefix Type", "Link-local"},
+				{"Interface ID", "0214:22ff:fe01:2345"},
+				{"Interface ID Type", "EUI-64, derived from a MAC address"},
+				{"MAC Address", "00-14-22-01-23-45"},
+			},
+		},
+		{
+			name:    "Teredo",
+			address: "2001:0:4136:e378:8000:63bf:3fff:fdd2",
+			want: []Fact{
+				{"Address", "2001:0:4136:e378:8000:63bf:3fff:fdd2"},
+				{"Expanded", "2001:0000:4136:e378:8000:63bf:3fff:fdd2"},
+				{"Scope", "Global"},
+				{"Prefix Type", "Teredo"},
+				{"Interface ID", "8000:63bf:3fff:fdd2"},
+				{"Interface ID Type", "Teredo, encoding the client's NAT mapping"},
+				{"Teredo Server", "65.54.227.120"},
+				{"Teredo Client", "192.0.2.45"},
+				{"Teredo Client Port", "40000"},
+				{"Teredo NAT", "Cone NAT"},
+			},
+		},
+		{
+			name:    "IPv4-mapped",
+			address: "::ffff:192.0.2.1",
+			want: []Fact{
+				{"Address", "::ffff:192.0.2.1"},
+				{"Expanded", "0000:0000:0000:0000:0000:ffff:c000:0201"},
+				{"Scope", "Global"},
+				{"Prefix Type", "Reserved"},
+				{"IPv4-Mapped Address", "192.0.2.1"},
+			},
+		},
+		{
+			name:    "Local-use NAT64",
+			address: "64:ff9b:1::c000:221",
+			want: []Fact{
+				{"Address", "64:ff9b:1::c000:221"},
+				{"Expanded", "0064:ff9b:0001:0000:0000:0000:c000:0221"},
+				{"Scope", "Global"},
+				{"Prefix Type", "Reserved"},
+				{"Local-Use NAT64 Prefix (RFC 8215)", "64:ff9b:1::/48"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			analysis, err := analyzer.Analyze(tt.address)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, English.Facts(analysis))
+		})
+	}
+}
+
+// TestDUIDFacts verifies that decoded DUIDs are described by the components of
+// their type, with the MAC address of those based on an Ethernet address.
+func TestDUIDFacts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		duid string
+		want []Fact
+	}{
+		{
+			name: "DUID-LLT",
+			duid: "00:01:00:01:25:9e:9d:80:00:14:22:01:23:45",
+			want: []Fact{
+				{"DUID Type", "DUID-LLT"},
+				{"Hardware Type", "1 (Ethernet)"},
+				{"Generated", "2020-01-01T00:00:00Z"},
+				{"Link-Layer Address", "00:14:22:01:23:45"},
+				{"MAC Address", "00-14-22-01-23-45"},
+			},
+		},
+		{
+			name: "DUID-LL of another hardware type",
+			duid: "00:03:00:06:00:14:22:01:23:45",
+			want: []Fact{
+				{"DUID Type", "DUID-LL"},
+				{"Hardware Type", "6"},
+				{"Link-Layer Address", "00:14:22:01:23:45"},
+			},
+		},
+		{
+			name: "DUID-EN",
+			duid: "00:02:00:00:00:09:0c:0c:00:01",
+			want: []Fact{
+				{"DUID Type", "DUID-EN"},
+				{"Enterprise Number", "9"},
+				{"Identifier", "0c:0c:00:01"},
+			},
+		},
+		{
+			name: "DUID-UUID",
+			duid: "00:04:4c:4c:45:44:00:51:50:10:80:4a:b4:c0:4f:4e:4b:32",
+			want: []Fact{
+				{"DUID Type", "DUID-UUID"},
+				{"UUID", "4c4c4544-0051-5010-804a-b4c04f4e4b32"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			decoded, err := duid.Parse(tt.duid)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, English.DUIDFacts(decoded))
+		})
+	}
+}
+
+// TestFromContext verifies that the locale is carried by the context and
+// defaults to English.
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+
+	assert.Same(t, English, FromContext(context.Background()))
+	assert.Same(t, Spanish, FromContext(WithLocale(context.Background(), Spanish)))
+}
+
+// TestVerdict tests the Verdict and VerificationFacts methods, verifying that
+// each outcome of a verification is explained with the facts supporting it.
+func TestVerdict(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		pair        verify.Pair
+		wantVerdict string
+		wantFacts   []Fact
+	}{
+		{
+			name:        "Match",
+			pair:        verify.Pair{MAC: "00-14-22-01-23-45", Address: "fe80::214:22ff:fe01:2345", Prefix: ""},
+			wantVerdict: "Match: the address is the EUI-64 address of the MAC address.",
+			wantFacts: []Fact{
+				{"Expected Address", "fe80::214:22ff:fe01:2345"},
+				{"Interface ID", "0214:22ff:fe01:2345"},
+				{"Interface ID Type", "EUI-64, derived from a MAC address"},
+				{"MAC Address in the Address", "00-14-22-01-23-45"},
+			},
+		},
+		{
+			name:        "Not EUI-64",
+			pair:        verify.Pair{MAC: "00-14-22-01-23-45", Address: "2001:db8::53", Prefix: "2001:db8::"},
+			wantVerdict: "Mismatch: the address does not use an EUI-64 interface ID (Low-byte, likely assigned manually).",
+			wantFacts: []Fact{
+				{"Expected Address", "2001:db8::214:22ff:fe01:2345"},
+				{"Interface ID", "0000:0000:0000:0053"},
+				{"Interface ID Type", "Low-byte, likely assigned manually"},
+			},
+		},
+		{
+			name:        "Interface ID of another MAC address",
+			pair:        verify.Pair{MAC: "00-14-22-01-23-45", Address: "fe80::214:22ff:fe01:2346", Prefix: ""},
+			wantVerdict: "Mismatch: the interface ID is the EUI-64 interface ID of another MAC address, 00-14-22-01-23-46.",
+			wantFacts:   nil,
+		},
+		{
+			name:        "Prefix mismatch",
+			pair:        verify.Pair{MAC: "00-14-22-01-23-45", Address: "fe80::214:22ff:fe01:2345", Prefix: "2001:db8::"},
+			wantVerdict: English.T(KeyVerifyPrefixMismatch),
+			wantFacts:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := verify.Verify(&eui64.DefaultCalculator{}, tt.pair)
+			require.NoError(t, result.Err)
+			assert.Equal(t, tt.wantVerdict, English.Verdict(result))
+
+			if tt.wantFacts != nil {
+				assert.Equal(t, tt.wantFacts, English.VerificationFacts(result))
+			}
+		})
+	}
+}
+
+// TestSLAACStatus verifies that outcomes of SLAAC forming no address are
+// explained, and that an address being formed needs no explanation.
+func TestSLAACStatus(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, English.SLAACStatus(eui64.SLAACFormed))
+	assert.Equal(t, English.T(KeyRAStatusNot64), English.SLAACStatus(eui64.SLAACNot64))
+	assert.Equal(t, "unknown", English.SLAACStatus("unknown"))
+
+	for status, key := range slaacStatusKeys {
+		assert.Equal(t, French.T(key), French.SLAACStatus(status))
+	}
+}
+
+// TestLifetime verifies that lifetimes are shown in seconds, or as infinite.
+func TestLifetime(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "604800 s", English.Lifetime(eui64.DefaultPreferredLifetime))
+	assert.Equal(t, "0 s", English.Lifetime(0))
+	assert.Equal(t, "unbegrenzt", German.Lifetime(eui64.InfiniteLifetime))
+}
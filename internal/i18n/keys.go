@@ -0,0 +1,391 @@
+package i18n
+
+// Key identifies a translatable message in the catalogs.
+type Key string
+
+// Messages of the page layout and its preference selectors.
+const (
+	KeyAppTitle       Key = "app.title"
+	KeyThemeLabel     Key = "theme.label"
+	KeyThemeSystem    Key = "theme.system"
+	KeyThemeLight     Key = "theme.light"
+	KeyThemeDark      Key = "theme.dark"
+	KeyLanguageLabel  Key = "language.label"
+	KeyLanguageSubmit Key = "language.submit"
+)
+
+// Messages of the calculator form.
+const (
+	KeyAppDescription Key = "form.description"
+	KeyMACLabel       Key = "form.mac.label"
+	KeyMACTitle       Key = "form.mac.title"
+	KeyMACHint        Key = "form.mac.hint"
+	KeyCopyMAC        Key = "form.mac.copy"
+	KeyPrefixLabel    Key = "form.prefix.label"
+	KeyPrefixTitle    Key = "form.prefix.title"
+	KeyPrefixHint     Key = "form.prefix.hint"
+	KeyCopyPrefix     Key = "form.prefix.copy"
+	KeyCalculate      Key = "form.calculate"
+	KeyClear          Key = "form.clear"
+	KeyCopy           Key = "form.copy"
+	KeyCopied         Key = "form.copied"
+)
+
+// Messages of the link type selector of the calculator form, naming the kinds
+// of link-layer addresses interface identifiers are derived from.
+const (
+	KeyLinkTypeLabel    Key = "form.link_type.label"
+	KeyLinkTypeHint     Key = "form.link_type.hint"
+	KeyLinkTypeEthernet Key = "form.link_type.ethernet"
+	KeyLinkTypeShort    Key = "form.link_type.ieee802154_short"
+	KeyLinkTypeExtended Key = "form.link_type.ieee802154_extended"
+	KeyLinkTypeBLE      Key = "form.link_type.ble"
+	KeyLinkTypeToken    Key = "form.link_type.token"
+)
+
+// Messages of the keyboard shortcuts help.
+const (
+	KeyShortcutsTitle      Key = "shortcuts.title"
+	KeyShortcutFocusMAC    Key = "shortcuts.focus_mac"
+	KeyShortcutFocusPrefix Key = "shortcuts.focus_prefix"
+	KeyShortcutCopyResult  Key = "shortcuts.copy_result"
+	KeyShortcutClearForm   Key = "shortcuts.clear"
+)
+
+// Messages of the calculation result.
+const (
+	KeyInterfaceIDLabel Key = "result.interface_id.label"
+	KeyCopyInterfaceID  Key = "result.interface_id.copy"
+	KeyFullIPLabel      Key = "result.full_ip.label"
+	KeyCopyFullIP       Key = "result.full_ip.copy"
+	KeyDUIDLLLabel      Key = "result.duid_ll.label"
+	KeyDUIDLLTLabel     Key = "result.duid_llt.label"
+)
+
+// Messages of the prefix classification shown with a calculation result, see
+// Locale.PrefixType and Locale.PrefixWarning.
+const (
+	KeyPrefixTypeLabel             Key = "prefix.type.label"
+	KeyPrefixTypeGUA               Key = "prefix.type.gua"
+	KeyPrefixTypeULA               Key = "prefix.type.ula"
+	KeyPrefixTypeLinkLocal         Key = "prefix.type.link_local"
+	KeyPrefixTypeDocumentation     Key = "prefix.type.documentation"
+	KeyPrefixTypeMulticast         Key = "prefix.type.multicast"
+	KeyPrefixType6to4              Key = "prefix.type.6to4"
+	KeyPrefixTypeTeredo            Key = "prefix.type.teredo"
+	KeyPrefixTypeReserved          Key = "prefix.type.reserved"
+	KeyPrefixWarningMulticast      Key = "prefix.warning.multicast"
+	KeyPrefixWarningLinkLocalBits  Key = "prefix.warning.link_local_bits"
+	KeyPrefixWarningULAUnassigned  Key = "prefix.warning.ula_unassigned"
+	KeyPrefixWarningDocumentation  Key = "prefix.warning.documentation"
+	KeyPrefixWarningTeredo         Key = "prefix.warning.teredo"
+	KeyPrefixWarningDeprecated6to4 Key = "prefix.warning.6to4_deprecated"
+	KeyPrefixWarningReserved       Key = "prefix.warning.reserved"
+)
+
+// Messages of the subnet planner.
+const (
+	KeyPlanTitle         Key = "plan.title"
+	KeyPlanDescription   Key = "plan.description"
+	KeyPlanParentLabel   Key = "plan.parent.label"
+	KeyPlanParentTitle   Key = "plan.parent.title"
+	KeyPlanParentHint    Key = "plan.parent.hint"
+	KeyPlanIDsLabel      Key = "plan.ids.label"
+	KeyPlanIDsTitle      Key = "plan.ids.title"
+	KeyPlanIDsHint       Key = "plan.ids.hint"
+	KeyPlanSubmit        Key = "plan.submit"
+	KeyPlanCaption       Key = "plan.caption"
+	KeyPlanIDHeader      Key = "plan.id"
+	KeyPlanPrefixHeader  Key = "plan.prefix"
+	KeyPlanAddressHeader Key = "plan.address"
+)
+
+// Messages of the matrix mode.
+const (
+	KeyMatrixTitle         Key = "matrix.title"
+	KeyMatrixDescription   Key = "matrix.description"
+	KeyMatrixMACsLabel     Key = "matrix.macs.label"
+	KeyMatrixMACsHint      Key = "matrix.macs.hint"
+	KeyMatrixPrefixesLabel Key = "matrix.prefixes.label"
+	KeyMatrixPrefixesHint  Key = "matrix.prefixes.hint"
+	KeyMatrixSubmit        Key = "matrix.submit"
+)
+
+// Messages of the ULA prefix generator.
+const (
+	KeyULATitle         Key = "ula.title"
+	KeyULADescription   Key = "ula.description"
+	KeyULAMethodLabel   Key = "ula.method.label"
+	KeyULAMethodDerived Key = "ula.method.derived"
+	KeyULAMethodRandom  Key = "ula.method.random"
+	KeyULASubnetLabel   Key = "ula.subnet.label"
+	KeyULASubnetHint    Key = "ula.subnet.hint"
+	KeyULASubmit        Key = "ula.submit"
+	KeyULAPrefixLabel   Key = "ula.prefix"
+	KeyULASubnetPrefix  Key = "ula.subnet_prefix"
+	KeyULAUse           Key = "ula.use"
+)
+
+// Messages of the address analyzer, see Locale.Facts.
+const (
+	KeyAnalyzerTitle          Key = "analyzer.title"
+	KeyAnalyzerDescription    Key = "analyzer.description"
+	KeyAnalyzerAddressLabel   Key = "analyzer.address.label"
+	KeyAnalyzerAddressHint    Key = "analyzer.address.hint"
+	KeyAnalyzerSubmit         Key = "analyzer.submit"
+	KeyAnalyzerLink           Key = "analyzer.link"
+	KeyAnalyzerBack           Key = "analyzer.back"
+	KeyDUIDDecoderTitle       Key = "duid.decoder.title"
+	KeyDUIDDecoderDescription Key = "duid.decoder.description"
+	KeyDUIDLabel              Key = "duid.decoder.label"
+	KeyDUIDHint               Key = "duid.decoder.hint"
+	KeyDUIDSubmit             Key = "duid.decoder.submit"
+	KeyFactAddress            Key = "analyzer.fact.address"
+	KeyFactExpanded           Key = "analyzer.fact.expanded"
+	KeyFactScope              Key = "analyzer.fact.scope"
+	KeyFactInterfaceID        Key = "analyzer.fact.interface_id"
+	KeyFactIIDType            Key = "analyzer.fact.iid_type"
+	KeyFactMAC                Key = "analyzer.fact.mac"
+	KeyFactIPv46to4           Key = "analyzer.fact.ipv4.6to4"
+	KeyFactIPv4ISATAP         Key = "analyzer.fact.ipv4.isatap"
+	KeyFactIPv4Mapped         Key = "analyzer.fact.ipv4.mapped"
+	KeyFactIPv4NAT64          Key = "analyzer.fact.ipv4.nat64"
+	KeyFactNAT64Local         Key = "analyzer.fact.nat64_local"
+	KeyFactTeredoServer       Key = "analyzer.fact.teredo.server"
+	KeyFactTeredoClient       Key = "analyzer.fact.teredo.client"
+	KeyFactTeredoPort         Key = "analyzer.fact.teredo.port"
+	KeyFactTeredoNAT          Key = "analyzer.fact.teredo.nat"
+	KeyTeredoCone             Key = "analyzer.teredo.cone"
+	KeyTeredoRestricted       Key = "analyzer.teredo.restricted"
+	KeyScopeNone              Key = "analyzer.scope.none"
+	KeyScopeInterfaceLocal    Key = "analyzer.scope.interface_local"
+	KeyScopeLinkLocal         Key = "analyzer.scope.link_local"
+	KeyScopeRealmLocal        Key = "analyzer.scope.realm_local"
+	KeyScopeAdminLocal        Key = "analyzer.scope.admin_local"
+	KeyScopeSiteLocal         Key = "analyzer.scope.site_local"
+	KeyScopeOrganizationLocal Key = "analyzer.scope.organization_local"
+	KeyScopeGlobal            Key = "analyzer.scope.global"
+	KeyScopeReserved          Key = "analyzer.scope.reserved"
+	KeyIIDEUI64               Key = "analyzer.iid.eui64"
+	KeyIIDShortAddress        Key = "analyzer.iid.short_address"
+	KeyIIDISATAP              Key = "analyzer.iid.isatap"
+	KeyIIDTeredo              Key = "analyzer.iid.teredo"
+	KeyIIDLowByte             Key = "analyzer.iid.low_byte"
+	KeyIIDRandom              Key = "analyzer.iid.random"
+)
+
+// Labels of the facts describing a decoded DUID, see Locale.DUIDFacts.
+const (
+	KeyFactDUIDType         Key = "duid.fact.type"
+	KeyFactHardwareType     Key = "duid.fact.hardware_type"
+	KeyHardwareEthernet     Key = "duid.hardware_type.ethernet"
+	KeyFactDUIDTime         Key = "duid.fact.time"
+	KeyFactLinkLayerAddress Key = "duid.fact.link_layer_address"
+	KeyFactEnterpriseNumber Key = "duid.fact.enterprise_number"
+	KeyFactDUIDIdentifier   Key = "duid.fact.identifier"
+	KeyFactUUID             Key = "duid.fact.uuid"
+)
+
+// Messages of the address verifier, see Locale.Verdict and
+// Locale.VerificationFacts. Verdicts of mismatches of the interface ID are
+// formatted with the type of the interface ID or the MAC address it was
+// derived from.
+const (
+	KeyVerifyTitle          Key = "verify.title"
+	KeyVerifyDescription    Key = "verify.description"
+	KeyVerifyMACLabel       Key = "verify.mac.label"
+	KeyVerifyMACHint        Key = "verify.mac.hint"
+	KeyVerifyAddressLabel   Key = "verify.address.label"
+	KeyVerifyAddressHint    Key = "verify.address.hint"
+	KeyVerifyPrefixLabel    Key = "verify.prefix.label"
+	KeyVerifyPrefixHint     Key = "verify.prefix.hint"
+	KeyVerifySubmit         Key = "verify.submit"
+	KeyVerifyCSVLabel       Key = "verify.csv.label"
+	KeyVerifyCSVHint        Key = "verify.csv.hint"
+	KeyVerifyCSVSubmit      Key = "verify.csv.submit"
+	KeyVerifyMatch          Key = "verify.match"
+	KeyVerifyNotEUI64       Key = "verify.not_eui64"
+	KeyVerifyIIDMismatch    Key = "verify.iid_mismatch"
+	KeyVerifyPrefixMismatch Key = "verify.prefix_mismatch"
+	KeyFactExpectedAddress  Key = "verify.fact.expected"
+	KeyFactObservedMAC      Key = "verify.fact.observed_mac"
+)
+
+// Messages of the MAC range fields of the calculator form and the table of the
+// addresses of a range. The caption is formatted with the number of addresses.
+const (
+	KeyRangeSummary           Key = "range.summary"
+	KeyRangeEndLabel          Key = "range.end.label"
+	KeyRangeEndHint           Key = "range.end.hint"
+	KeyRangeCountLabel        Key = "range.count.label"
+	KeyRangeCountHint         Key = "range.count.hint"
+	KeyRangeCaption           Key = "range.caption"
+	KeyRangeMACHeader         Key = "range.mac"
+	KeyRangeInterfaceIDHeader Key = "range.interface_id"
+	KeyRangeAddressHeader     Key = "range.address"
+)
+
+// Messages of the DUID fields of the calculator form.
+const (
+	KeyDUIDOptionsSummary Key = "duid_options.summary"
+	KeyDUIDHardwareLabel  Key = "duid_options.hardware.label"
+	KeyDUIDHardwareHint   Key = "duid_options.hardware.hint"
+	KeyDUIDTimeLabel      Key = "duid_options.time.label"
+	KeyDUIDTimeHint       Key = "duid_options.time.hint"
+)
+
+// Messages of the MAC address importer and the table of its results. The
+// caption is formatted with the number of MAC addresses and the name of the
+// format they were imported from.
+const (
+	KeyImportTitle          Key = "import.title"
+	KeyImportDescription    Key = "import.description"
+	KeyImportFileLabel      Key = "import.file.label"
+	KeyImportFileHint       Key = "import.file.hint"
+	KeyImportFormatLabel    Key = "import.format.label"
+	KeyImportFormatAuto     Key = "import.format.auto"
+	KeyImportPrefixLabel    Key = "import.prefix.label"
+	KeyImportPrefixHint     Key = "import.prefix.hint"
+	KeyImportSubmit         Key = "import.submit"
+	KeyImportCaption        Key = "import.caption"
+	KeyImportLineHeader     Key = "import.line"
+	KeyImportHostnameHeader Key = "import.hostname"
+)
+
+// Messages of the Router Advertisement simulator and the table of the
+// addresses it simulates, see Locale.SLAACStatus and Locale.Lifetime.
+// KeyRALifetimeSeconds is formatted with a lifetime in seconds.
+const (
+	KeyRATitle                  Key = "ra.title"
+	KeyRADescription            Key = "ra.description"
+	KeyRAPrefixesLabel          Key = "ra.prefixes.label"
+	KeyRAPrefixesHint           Key = "ra.prefixes.hint"
+	KeyRASubmit                 Key = "ra.submit"
+	KeyRACaption                Key = "ra.caption"
+	KeyRAPrefixHeader           Key = "ra.prefix"
+	KeyRAFlagsHeader            Key = "ra.flags"
+	KeyRAValidHeader            Key = "ra.valid"
+	KeyRAPreferredHeader        Key = "ra.preferred"
+	KeyRAAddressHeader          Key = "ra.address"
+	KeyRALinkLocal              Key = "ra.link_local"
+	KeyRADeprecated             Key = "ra.deprecated"
+	KeyRALifetimeInfinite       Key = "ra.lifetime.infinite"
+	KeyRALifetimeSeconds        Key = "ra.lifetime.seconds"
+	KeyRAStatusNotAutonomous    Key = "ra.status.not_autonomous"
+	KeyRAStatusLinkLocal        Key = "ra.status.link_local"
+	KeyRAStatusMulticast        Key = "ra.status.multicast"
+	KeyRAStatusInvalidLifetimes Key = "ra.status.invalid_lifetimes"
+	KeyRAStatusExpired          Key = "ra.status.expired"
+	KeyRAStatusNot64            Key = "ra.status.not_64"
+)
+
+// Error messages shown in place of a result.
+const (
+	KeyErrCalculation        Key = "error.calculation"
+	KeyErrTooManyRequests    Key = "error.too_many_requests"
+	KeyErrInvalidCSRFToken   Key = "error.invalid_csrf_token"
+	KeyErrOfflineUnavailable Key = "error.offline_unavailable"
+	KeyErrClientUnavailable  Key = "error.client_unavailable"
+)
+
+// Explanations of validation and calculation errors, see Locale.Error. Each
+// ends with an example of correct input. Keys ending in "At" explain errors
+// locating the offending part of the input, and are formatted with its value,
+// its position, and its hextet, which messages refer to by explicit argument
+// indexes (%[1]q, %[2]d, %[3]d) so translations can reorder or omit them.
+// KeyErrRangeTooLarge is formatted with the maximum number of MAC addresses in
+// a range, which is configurable. KeyErrRALine is formatted with the number of
+// an invalid line of Prefix Information options and the explanation of why it
+// is invalid.
+const (
+	KeyErrMACRequired          Key = "validation.mac.required"
+	KeyErrMACTooLong           Key = "validation.mac.too_long"
+	KeyErrMACMalformed         Key = "validation.mac.malformed"
+	KeyErrMACInvalidChar       Key = "validation.mac.invalid_character"
+	KeyErrMACInvalidCharAt     Key = "validation.mac.invalid_character_at"
+	KeyErrMACLength            Key = "validation.mac.length"
+	KeyErrPrefixRequired       Key = "validation.prefix.required"
+	KeyErrPrefixTooLong        Key = "validation.prefix.too_long"
+	KeyErrPrefixTooManyParts   Key = "validation.prefix.too_many_hextets"
+	KeyErrPrefixEmptyHextet    Key = "validation.prefix.empty_hextet"
+	KeyErrPrefixEmptyHextetAt  Key = "validation.prefix.empty_hextet_at"
+	KeyErrPrefixInvalidChar    Key = "validation.prefix.invalid_character"
+	KeyErrPrefixInvalidCharAt  Key = "validation.prefix.invalid_character_at"
+	KeyErrPrefixHextetLength   Key = "validation.prefix.hextet_length"
+	KeyErrPrefixHextetLengthAt Key = "validation.prefix.hextet_length_at"
+	KeyErrPrefixInvalidHextet  Key = "validation.prefix.invalid_hextet"
+	KeyErrParentRequired       Key = "validation.parent.required"
+	KeyErrParentInvalid        Key = "validation.parent.invalid"
+	KeyErrParentTooLong        Key = "validation.parent.too_long"
+	KeyErrSubnetIDsRequired    Key = "validation.subnet_ids.required"
+	KeyErrSubnetIDInvalid      Key = "validation.subnet_ids.invalid"
+	KeyErrSubnetIDRange        Key = "validation.subnet_ids.range"
+	KeyErrSubnetIDOutOfRange   Key = "validation.subnet_ids.out_of_range"
+	KeyErrTooManySubnets       Key = "validation.subnet_ids.too_many"
+	KeyErrMatrixNoMACs         Key = "validation.matrix.no_macs"
+	KeyErrMatrixNoPrefixes     Key = "validation.matrix.no_prefixes"
+	KeyErrMatrixTooLarge       Key = "validation.matrix.too_large"
+	KeyErrULASubnetID          Key = "validation.ula.subnet_id"
+	KeyErrAnalyzerRequired     Key = "validation.analyzer.required"
+	KeyErrAnalyzerInvalid      Key = "validation.analyzer.invalid"
+	KeyErrAnalyzerNotIPv6      Key = "validation.analyzer.not_ipv6"
+	KeyErrVerifyNoInterfaceID  Key = "validation.verify.no_interface_id"
+	KeyErrVerifyCSVInvalid     Key = "validation.verify.csv_invalid"
+	KeyErrVerifyCSVFields      Key = "validation.verify.csv_fields"
+	KeyErrVerifyNoPairs        Key = "validation.verify.no_pairs"
+	KeyErrVerifyTooManyPairs   Key = "validation.verify.too_many_pairs"
+	KeyErrRangeEndAndCount     Key = "validation.range.end_and_count"
+	KeyErrRangeEndBeforeStart  Key = "validation.range.end_before_start"
+	KeyErrRangeCount           Key = "validation.range.count"
+	KeyErrRangeBeyondLast      Key = "validation.range.beyond_last"
+	KeyErrRangeBlock           Key = "validation.range.block"
+	KeyErrRangeTooLarge        Key = "validation.range.too_large"
+	KeyErrImportFile           Key = "validation.import.file"
+	KeyErrImportFormat         Key = "validation.import.format"
+	KeyErrImportUndetected     Key = "validation.import.undetected"
+	KeyErrImportInvalid        Key = "validation.import.invalid"
+	KeyErrImportNoMACs         Key = "validation.import.no_macs"
+	KeyErrImportTooLarge       Key = "validation.import.too_large"
+	KeyErrImportTooManyMACs    Key = "validation.import.too_many_macs"
+	KeyErrRARequired           Key = "validation.ra.required"
+	KeyErrRAPrefix             Key = "validation.ra.prefix"
+	KeyErrRAFlags              Key = "validation.ra.flags"
+	KeyErrRALifetime           Key = "validation.ra.lifetime"
+	KeyErrRAFields             Key = "validation.ra.fields"
+	KeyErrRATooMany            Key = "validation.ra.too_many"
+	KeyErrRALine               Key = "validation.ra.line"
+	KeyErrDUIDTime             Key = "validation.duid.time"
+	KeyErrDUIDSyntax           Key = "validation.duid.syntax"
+	KeyErrDUIDLength           Key = "validation.duid.length"
+	KeyErrDUIDTooLong          Key = "validation.duid.too_long"
+	KeyErrDUIDUnknownType      Key = "validation.duid.unknown_type"
+	KeyErrDUIDHardware         Key = "validation.duid.hardware"
+	KeyErrDUIDTimeSyntax       Key = "validation.duid.time_syntax"
+
+	KeyErrLinkTypeUnknown              Key = "validation.link_type.unknown"
+	KeyErrLinkTypeRange                Key = "validation.link_type.range"
+	KeyErrShortAddressRequired         Key = "validation.short_address.required"
+	KeyErrShortAddressTooLong          Key = "validation.short_address.too_long"
+	KeyErrShortAddressMalformed        Key = "validation.short_address.malformed"
+	KeyErrShortAddressInvalidChar      Key = "validation.short_address.invalid_character"
+	KeyErrShortAddressInvalidCharAt    Key = "validation.short_address.invalid_character_at"
+	KeyErrExtendedAddressRequired      Key = "validation.extended_address.required"
+	KeyErrExtendedAddressTooLong       Key = "validation.extended_address.too_long"
+	KeyErrExtendedAddressMalformed     Key = "validation.extended_address.malformed"
+	KeyErrExtendedAddressInvalidChar   Key = "validation.extended_address.invalid_character"
+	KeyErrExtendedAddressInvalidCharAt Key = "validation.extended_address.invalid_character_at"
+	KeyErrBLEAddressRequired           Key = "validation.ble_address.required"
+	KeyErrBLEAddressTooLong            Key = "validation.ble_address.too_long"
+	KeyErrBLEAddressMalformed          Key = "validation.ble_address.malformed"
+	KeyErrBLEAddressInvalidChar        Key = "validation.ble_address.invalid_character"
+	KeyErrBLEAddressInvalidCharAt      Key = "validation.ble_address.invalid_character_at"
+
+	KeyErrTokenRequired       Key = "validation.token.required"
+	KeyErrTokenTooLong        Key = "validation.token.too_long"
+	KeyErrTokenMalformed      Key = "validation.token.malformed"
+	KeyErrTokenInvalidChar    Key = "validation.token.invalid_character"
+	KeyErrTokenInvalidCharAt  Key = "validation.token.invalid_character_at"
+	KeyErrTokenHextetLength   Key = "validation.token.hextet_length"
+	KeyErrTokenHextetLengthAt Key = "validation.token.hextet_length_at"
+	KeyErrTokenZero           Key = "validation.token.zero"
+)
@@ -0,0 +1,503 @@
+// Package importer extracts the MAC addresses of hosts, and their hostnames
+// where the source records them, from the files networks already keep: dnsmasq
+// and ISC dhcpd DHCP leases, Kea CSV leases, the neighbor tables printed by
+// "ip -6 neigh" and "ip -j neigh", and Cisco "show mac address-table" output.
+// The format can be detected from the content, and the EUI-64 address of each
+// imported MAC address can be calculated in a prefix through any
+// eui64.Calculator.
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/errcode"
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+	"github.com/nicholas-fedor/eui64-calculator/internal/validators"
+)
+
+// Format is a format MAC addresses can be imported from.
+type Format string
+
+// Formats MAC addresses can be imported from.
+const (
+	FormatAuto        Format = "auto"          // FormatAuto detects the format from the content.
+	FormatDnsmasq     Format = "dnsmasq"       // FormatDnsmasq is a dnsmasq leases file.
+	FormatDhcpd       Format = "dhcpd"         // FormatDhcpd is an ISC dhcpd.leases file.
+	FormatKea         Format = "kea"           // FormatKea is a Kea memfile lease CSV file.
+	FormatIPNeigh     Format = "ip-neigh"      // FormatIPNeigh is "ip neigh" output.
+	FormatIPNeighJSON Format = "ip-neigh-json" // FormatIPNeighJSON is "ip -j neigh" output.
+	FormatCisco       Format = "cisco"         // FormatCisco is Cisco "show mac address-table" output.
+)
+
+// Formats lists the formats MAC addresses can be imported from, in the order
+// they are offered, with FormatAuto first.
+var Formats = []Format{
+	FormatAuto, FormatDnsmasq, FormatDhcpd, FormatKea, FormatIPNeigh, FormatIPNeighJSON, FormatCisco,
+}
+
+// names names the formats, after the software producing them.
+var names = map[Format]string{
+	FormatDnsmasq:     "dnsmasq leases",
+	FormatDhcpd:       "ISC dhcpd.leases",
+	FormatKea:         "Kea CSV leases",
+	FormatIPNeigh:     "ip -6 neigh",
+	FormatIPNeighJSON: "ip -j neigh",
+	FormatCisco:       "Cisco show mac address-table",
+}
+
+// Limits of an import.
+const (
+	MaxSize    = 1 << 20 // MaxSize is the maximum size of an imported file in bytes.
+	MaxEntries = 1 << 12 // MaxEntries is the maximum number of MAC addresses imported at once.
+)
+
+// Constants describing the formats.
+const (
+	macBytes        = 6                 // macBytes is the size of a MAC address.
+	dnsmasqFields   = 4                 // dnsmasqFields is the minimum number of fields of a dnsmasq lease.
+	dnsmasqMAC      = 1                 // dnsmasqMAC is the field of a dnsmasq lease holding the MAC address.
+	dnsmasqAddress  = 2                 // dnsmasqAddress is the field of a dnsmasq lease holding the leased address.
+	dnsmasqHostname = 3                 // dnsmasqHostname is the field of a dnsmasq lease holding the hostname.
+	noHostname      = "*"               // noHostname stands for a missing hostname in dnsmasq leases.
+	keaMACColumn    = "hwaddr"          // keaMACColumn is the Kea lease column holding the MAC address.
+	keaHostColumn   = "hostname"        // keaHostColumn is the Kea lease column holding the hostname.
+	keaAddrColumn   = "address"         // keaAddrColumn is the first Kea lease column, used to detect the format.
+	neighMACField   = "lladdr"          // neighMACField precedes the MAC address of a neighbor.
+	dhcpdLease      = "lease"           // dhcpdLease starts a lease in dhcpd.leases.
+	dhcpdHardware   = "hardware"        // dhcpdHardware starts the hardware address of a lease.
+	dhcpdHostname   = "client-hostname" // dhcpdHostname starts the client hostname of a lease.
+	dhcpdEnd        = "}"               // dhcpdEnd ends a lease in dhcpd.leases.
+	dhcpdMACFields  = 3                 // dhcpdMACFields is the number of fields of "hardware ethernet <mac>".
+	dhcpdHostFields = 2                 // dhcpdHostFields is the number of fields of "client-hostname <name>".
+)
+
+// Patterns detecting the formats.
+var (
+	// ciscoMAC matches MAC addresses as Cisco IOS writes them, such as 0014.2201.2345.
+	ciscoMAC = regexp.MustCompile(`(?i)\b[0-9a-f]{4}\.[0-9a-f]{4}\.[0-9a-f]{4}\b`)
+	// dhcpdLeaseStart matches the start of a lease in dhcpd.leases.
+	dhcpdLeaseStart = regexp.MustCompile(`(?m)^\s*lease\s+\S+\s*\{`)
+	// neighDevice matches the device of a neighbor in "ip neigh" output.
+	neighDevice = regexp.MustCompile(`\sdev\s+\S+`)
+)
+
+// Static error variables.
+var (
+	ErrNoFile        = errcode.New("import.file", "a file to import is expected")
+	ErrUnknownFormat = errcode.New("import.format", "unknown import format")
+	ErrUndetected    = errcode.New("import.undetected", "the format of the file could not be detected")
+	ErrInvalidFile   = errcode.New("import.invalid", "the file could not be parsed")
+	ErrNoEntries     = errcode.New("import.no_macs", "the file has no MAC addresses")
+	ErrTooLarge      = errcode.New("import.too_large", fmt.Sprintf("the file exceeds %d bytes", MaxSize))
+	ErrTooManyMACs   = errcode.New("import.too_many_macs", fmt.Sprintf("the file has more than %d MAC addresses", MaxEntries))
+)
+
+// Entry is a MAC address imported from a file.
+type Entry struct {
+	MAC      string // MAC is the MAC address, normalized like macrange.Format if it is valid.
+	Hostname string // Hostname is the hostname recorded with it, if any.
+	Line     int    // Line is the line of the file it was first found on, counting from one.
+}
+
+// Result is the outcome of calculating the EUI-64 address of an imported MAC
+// address. Err is set, and the addresses empty, if the MAC address is invalid
+// or the calculation fails.
+type Result struct {
+	Entry
+
+	InterfaceID string
+	FullIP      string
+	Err         error
+}
+
+// ParseFormat parses the name of a format, defaulting to FormatAuto if it is
+// empty.
+func ParseFormat(name string) (Format, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return FormatAuto, nil
+	}
+
+	for _, format := range Formats {
+		if string(format) == name {
+			return format, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %q", ErrUnknownFormat, name)
+}
+
+// Name returns the name of the format, after the software producing it, or the
+// format itself for FormatAuto, which names no software.
+func (f Format) Name() string {
+	if name, ok := names[f]; ok {
+		return name
+	}
+
+	return string(f)
+}
+
+// Read reads an imported file, up to MaxSize bytes.
+func Read(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, MaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("reading imported file: %w", err)
+	}
+
+	if len(data) > MaxSize {
+		return nil, ErrTooLarge
+	}
+
+	return data, nil
+}
+
+// Detect detects the format of a file from its content.
+func Detect(data []byte) (Format, error) {
+	content := strings.TrimSpace(string(data))
+	first, _, _ := strings.Cut(content, "\n")
+
+	switch {
+	case strings.HasPrefix(content, "["):
+		return FormatIPNeighJSON, nil
+	case strings.HasPrefix(first, keaAddrColumn+","):
+		return FormatKea, nil
+	case dhcpdLeaseStart.MatchString(content):
+		return FormatDhcpd, nil
+	case neighDevice.MatchString(content):
+		return FormatIPNeigh, nil
+	case ciscoMAC.MatchString(content):
+		return FormatCisco, nil
+	}
+
+	for line := range strings.Lines(content) {
+		fields := strings.Fields(line)
+		if len(fields) >= dnsmasqFields && isMAC(fields[dnsmasqMAC]) {
+			return FormatDnsmasq, nil
+		}
+	}
+
+	return "", ErrUndetected
+}
+
+// Parse extracts the MAC addresses of a file in the given format, detecting
+// it if it is FormatAuto, in the order they are found. Each MAC address is
+// listed once, with the first hostname recorded for it. At least one and at
+// most MaxEntries MAC addresses are expected.
+func Parse(data []byte, format Format) ([]Entry, Format, error) {
+	if format == FormatAuto {
+		detected, err := Detect(data)
+		if err != nil {
+			return nil, "", err
+		}
+
+		format = detected
+	}
+
+	var (
+		entries []Entry
+		err     error
+	)
+
+	switch format {
+	case FormatDnsmasq:
+		entries = parseDnsmasq(data)
+	case FormatDhcpd:
+		entries = parseDhcpd(data)
+	case FormatKea:
+		entries, err = parseKea(data)
+	case FormatIPNeigh:
+		entries = parseIPNeigh(data)
+	case FormatIPNeighJSON:
+		entries, err = parseIPNeighJSON(data)
+	case FormatCisco:
+		entries = parseCisco(data)
+	case FormatAuto:
+		fallthrough
+	default:
+		return nil, "", fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+
+	if err != nil {
+		return nil, format, err
+	}
+
+	entries = deduplicate(entries)
+
+	switch {
+	case len(entries) == 0:
+		return nil, format, ErrNoEntries
+	case len(entries) > MaxEntries:
+		return nil, format, ErrTooManyMACs
+	}
+
+	return entries, format, nil
+}
+
+// Calculate calculates the EUI-64 address of every entry in the prefix with
+// calc, in order, explaining invalid MAC addresses in their results rather
+// than failing the whole import.
+func Calculate(calc eui64.Calculator, entries []Entry, prefix string) []Result {
+	results := make([]Result, 0, len(entries))
+
+	for _, entry := range entries {
+		if err := validators.ValidateMAC(entry.MAC); err != nil {
+			results = append(results, Result{Entry: entry, InterfaceID: "", FullIP: "", Err: err})
+
+			continue
+		}
+
+		interfaceID, fullIP, err := calc.CalculateEUI64(entry.MAC, prefix)
+		if err != nil {
+			err = fmt.Errorf("calculating address of %s: %w", entry.MAC, err)
+		}
+
+		results = append(results, Result{Entry: entry, InterfaceID: interfaceID, FullIP: fullIP, Err: err})
+	}
+
+	return results
+}
+
+// parseDnsmasq parses a dnsmasq leases file, whose IPv4 leases are lines of an
+// expiry time, a MAC address, an address, a hostname or "*", and a client ID.
+// IPv6 leases, which record a DUID rather than a MAC address, are skipped.
+func parseDnsmasq(data []byte) []Entry {
+	var entries []Entry
+
+	for number, line := range lines(data) {
+		fields := strings.Fields(line)
+		if len(fields) < dnsmasqFields || strings.Contains(fields[dnsmasqAddress], ":") {
+			continue
+		}
+
+		hostname := fields[dnsmasqHostname]
+		if hostname == noHostname {
+			hostname = ""
+		}
+
+		entries = append(entries, newEntry(fields[dnsmasqMAC], hostname, number))
+	}
+
+	return entries
+}
+
+// parseDhcpd parses an ISC dhcpd.leases file, taking the hardware address and
+// client hostname of each lease block.
+func parseDhcpd(data []byte) []Entry {
+	var (
+		entries []Entry
+		lease   *Entry
+	)
+
+	for number, line := range lines(data) {
+		fields := strings.Fields(strings.TrimSuffix(strings.TrimSpace(line), ";"))
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch {
+		case fields[0] == dhcpdLease:
+			lease = &Entry{MAC: "", Hostname: "", Line: number}
+		case lease == nil:
+			continue
+		case fields[0] == dhcpdHardware && len(fields) == dhcpdMACFields:
+			lease.MAC = normalize(fields[dhcpdMACFields-1])
+			lease.Line = number
+		case fields[0] == dhcpdHostname && len(fields) == dhcpdHostFields:
+			lease.Hostname = strings.Trim(fields[1], `"`)
+		case fields[0] == dhcpdEnd:
+			if lease.MAC != "" {
+				entries = append(entries, *lease)
+			}
+
+			lease = nil
+		}
+	}
+
+	return entries
+}
+
+// parseKea parses a Kea memfile lease CSV file, locating the hardware address
+// and hostname columns from its header. Leases without a hardware address,
+// such as IPv6 leases of clients identified by their DUID, are skipped.
+func parseKea(data []byte) ([]Entry, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+	}
+
+	macColumn, hostColumn := -1, -1
+
+	for i, column := range header {
+		switch strings.TrimSpace(column) {
+		case keaMACColumn:
+			macColumn = i
+		case keaHostColumn:
+			hostColumn = i
+		}
+	}
+
+	if macColumn < 0 {
+		return nil, fmt.Errorf("%w: no %s column", ErrInvalidFile, keaMACColumn)
+	}
+
+	var entries []Entry
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+		}
+
+		if macColumn >= len(record) || strings.TrimSpace(record[macColumn]) == "" {
+			continue
+		}
+
+		hostname := ""
+		if hostColumn >= 0 && hostColumn < len(record) {
+			hostname = strings.TrimSpace(record[hostColumn])
+		}
+
+		line, _ := reader.FieldPos(macColumn)
+		entries = append(entries, newEntry(record[macColumn], hostname, line))
+	}
+
+	return entries, nil
+}
+
+// parseIPNeigh parses "ip neigh" output, taking the link-layer address of each
+// neighbor that has one.
+func parseIPNeigh(data []byte) []Entry {
+	var entries []Entry
+
+	for number, line := range lines(data) {
+		fields := strings.Fields(line)
+		for i := range len(fields) - 1 {
+			if fields[i] == neighMACField {
+				entries = append(entries, newEntry(fields[i+1], "", number))
+
+				break
+			}
+		}
+	}
+
+	return entries
+}
+
+// parseIPNeighJSON parses "ip -j neigh" output, taking the link-layer address
+// of each neighbor that has one. Lines are counted from the neighbors' order,
+// as the JSON may be on a single line.
+func parseIPNeighJSON(data []byte) ([]Entry, error) {
+	var neighbors []struct {
+		LLAddr string `json:"lladdr"`
+	}
+
+	if err := json.Unmarshal(data, &neighbors); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+	}
+
+	var entries []Entry
+
+	for i, neighbor := range neighbors {
+		if neighbor.LLAddr != "" {
+			entries = append(entries, newEntry(neighbor.LLAddr, "", i+1))
+		}
+	}
+
+	return entries, nil
+}
+
+// parseCisco parses Cisco "show mac address-table" output, taking the MAC
+// address of each row of the table.
+func parseCisco(data []byte) []Entry {
+	var entries []Entry
+
+	for number, line := range lines(data) {
+		if mac := ciscoMAC.FindString(line); mac != "" {
+			entries = append(entries, newEntry(mac, "", number))
+		}
+	}
+
+	return entries
+}
+
+// lines returns the lines of a file, numbered from one, without their line
+// endings.
+func lines(data []byte) iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		scanner.Buffer(nil, MaxSize)
+
+		for number := 1; scanner.Scan(); number++ {
+			if !yield(number, scanner.Text()) {
+				return
+			}
+		}
+	}
+}
+
+// newEntry returns the entry of a MAC address found on a line.
+func newEntry(mac, hostname string, line int) Entry {
+	return Entry{MAC: normalize(mac), Hostname: hostname, Line: line}
+}
+
+// deduplicate lists each MAC address of the entries once, at its first
+// position, taking the first hostname recorded for it.
+func deduplicate(entries []Entry) []Entry {
+	positions := make(map[string]int, len(entries))
+	unique := make([]Entry, 0, len(entries))
+
+	for _, entry := range entries {
+		position, seen := positions[entry.MAC]
+		if !seen {
+			positions[entry.MAC] = len(unique)
+			unique = append(unique, entry)
+
+			continue
+		}
+
+		if unique[position].Hostname == "" {
+			unique[position].Hostname = entry.Hostname
+		}
+	}
+
+	return unique
+}
+
+// normalize formats a MAC address as six pairs of lowercase hexadecimal digits
+// separated by hyphens, as the calculator accepts it, leaving it unchanged if it
+// is not a valid 48-bit MAC address so the calculation can explain why.
+func normalize(mac string) string {
+	mac = strings.TrimSpace(mac)
+
+	hardware, err := net.ParseMAC(mac)
+	if err != nil || len(hardware) != macBytes {
+		return mac
+	}
+
+	return strings.ReplaceAll(hardware.String(), ":", "-")
+}
+
+// isMAC reports whether a value is a valid 48-bit MAC address.
+func isMAC(value string) bool {
+	hardware, err := net.ParseMAC(value)
+
+	return err == nil && len(hardware) == macBytes
+}
@@ -0,0 +1,205 @@
+package importer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+	"github.com/nicholas-fedor/eui64-calculator/internal/validators"
+)
+
+// Sample files of each format, listing 00-14-22-01-23-45 and 00-14-22-01-23-46.
+const (
+	dnsmasqLeases = `1700000000 00:14:22:01:23:45 192.0.2.10 printer 01:00:14:22:01:23:45
+1700000100 00:14:22:01:23:46 192.0.2.11 * *
+duid 00:01:00:01:2c:5f:11:22:00:14:22:01:23:45
+1700000200 1234567 2001:db8::10 laptop 00:01:00:01:2c:5f:11:22:00:14:22:01:23:46
+`
+	dhcpdLeases = `# The format of this file is documented in the dhcpd.leases(5) manual page.
+lease 192.0.2.10 {
+  starts 4 2024/01/04 10:00:00;
+  hardware ethernet 00:14:22:01:23:45;
+  client-hostname "printer";
+}
+lease 192.0.2.11 {
+  hardware ethernet 00:14:22:01:23:46;
+}
+lease 192.0.2.10 {
+  hardware ethernet 00:14:22:01:23:45;
+}
+`
+	keaLeases = `address,hwaddr,client_id,valid_lifetime,expire,subnet_id,fqdn_fwd,fqdn_rev,hostname,state,user_context
+192.0.2.10,00:14:22:01:23:45,,3600,1700003600,1,0,0,printer,0,
+192.0.2.11,00:14:22:01:23:46,,3600,1700003600,1,0,0,,0,
+192.0.2.12,,01:02:03,3600,1700003600,1,0,0,,0,
+`
+	ipNeigh = `fe80::214:22ff:fe01:2345 dev eth0 lladdr 00:14:22:01:23:45 router REACHABLE
+2001:db8::214:22ff:fe01:2346 dev eth0 lladdr 00:14:22:01:23:46 STALE
+2001:db8::99 dev eth0 FAILED
+`
+	ipNeighJSON = `[{"dst":"fe80::214:22ff:fe01:2345","dev":"eth0","lladdr":"00:14:22:01:23:45","router":null,"state":["REACHABLE"]},` +
+		`{"dst":"2001:db8::99","dev":"eth0","state":["FAILED"]},` +
+		`{"dst":"2001:db8::214:22ff:fe01:2346","dev":"eth0","lladdr":"00:14:22:01:23:46","state":["STALE"]}]`
+	ciscoTable = `          Mac Address Table
+-------------------------------------------
+
+Vlan    Mac Address       Type        Ports
+----    -----------       --------    -----
+   1    0014.2201.2345    DYNAMIC     Gi0/1
+  10    0014.2201.2346    DYNAMIC     Gi0/2
+Total Mac Addresses for this criterion: 2
+`
+)
+
+// TestParse tests that Parse extracts the MAC addresses of every format, once
+// each and in order, with their hostnames and lines, whether the format is
+// given or detected.
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		data   string
+		format Format
+		want   []Entry
+	}{
+		{"dnsmasq", dnsmasqLeases, FormatDnsmasq, []Entry{
+			{MAC: "00-14-22-01-23-45", Hostname: "printer", Line: 1},
+			{MAC: "00-14-22-01-23-46", Hostname: "", Line: 2},
+		}},
+		{"dhcpd", dhcpdLeases, FormatDhcpd, []Entry{
+			{MAC: "00-14-22-01-23-45", Hostname: "printer", Line: 4},
+			{MAC: "00-14-22-01-23-46", Hostname: "", Line: 8},
+		}},
+		{"Kea", keaLeases, FormatKea, []Entry{
+			{MAC: "00-14-22-01-23-45", Hostname: "printer", Line: 2},
+			{MAC: "00-14-22-01-23-46", Hostname: "", Line: 3},
+		}},
+		{"ip neigh", ipNeigh, FormatIPNeigh, []Entry{
+			{MAC: "00-14-22-01-23-45", Hostname: "", Line: 1},
+			{MAC: "00-14-22-01-23-46", Hostname: "", Line: 2},
+		}},
+		{"ip -j neigh", ipNeighJSON, FormatIPNeighJSON, []Entry{
+			{MAC: "00-14-22-01-23-45", Hostname: "", Line: 1},
+			{MAC: "00-14-22-01-23-46", Hostname: "", Line: 3},
+		}},
+		{"Cisco", ciscoTable, FormatCisco, []Entry{
+			{MAC: "00-14-22-01-23-45", Hostname: "", Line: 6},
+			{MAC: "00-14-22-01-23-46", Hostname: "", Line: 7},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, format, err := Parse([]byte(tt.data), tt.format)
+			require.NoError(t, err)
+			assert.Equal(t, tt.format, format)
+			assert.Equal(t, tt.want, got)
+
+			detected, format, err := Parse([]byte(tt.data), FormatAuto)
+			require.NoError(t, err)
+			assert.Equal(t, tt.format, format, "Detected format")
+			assert.Equal(t, tt.want, detected)
+		})
+	}
+}
+
+// TestParseInvalid tests that Parse rejects files it cannot import.
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		data    string
+		format  Format
+		wantErr error
+	}{
+		{"Undetected format", "hello world\n", FormatAuto, ErrUndetected},
+		{"Unknown format", dnsmasqLeases, Format("arp"), ErrUnknownFormat},
+		{"No MAC addresses", "2001:db8::99 dev eth0 FAILED\n", FormatAuto, ErrNoEntries},
+		{"Invalid JSON", `[{"lladdr":`, FormatIPNeighJSON, ErrInvalidFile},
+		{"Kea without hardware addresses", "address,client_id\n192.0.2.10,01:02\n", FormatKea, ErrInvalidFile},
+		{"Too many MAC addresses", manyNeighbors(MaxEntries + 1), FormatIPNeigh, ErrTooManyMACs},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := Parse([]byte(tt.data), tt.format)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+// TestParseFormat tests that ParseFormat accepts the names of the formats and
+// defaults to detecting the format, and that formats are named after their
+// software.
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range Formats {
+		got, err := ParseFormat(string(format))
+		require.NoError(t, err)
+		assert.Equal(t, format, got)
+	}
+
+	got, err := ParseFormat(" ")
+	require.NoError(t, err)
+	assert.Equal(t, FormatAuto, got)
+
+	_, err = ParseFormat("arp")
+	require.ErrorIs(t, err, ErrUnknownFormat)
+
+	assert.Equal(t, "ISC dhcpd.leases", FormatDhcpd.Name())
+	assert.Equal(t, "auto", FormatAuto.Name())
+}
+
+// TestRead tests that Read rejects files larger than MaxSize.
+func TestRead(t *testing.T) {
+	t.Parallel()
+
+	data, err := Read(strings.NewReader(ipNeigh))
+	require.NoError(t, err)
+	assert.Equal(t, ipNeigh, string(data))
+
+	_, err = Read(strings.NewReader(strings.Repeat("a", MaxSize+1)))
+	require.ErrorIs(t, err, ErrTooLarge)
+}
+
+// TestCalculate tests that Calculate returns the EUI-64 address of every entry
+// in order, explaining invalid MAC addresses in their results.
+func TestCalculate(t *testing.T) {
+	t.Parallel()
+
+	entries := []Entry{
+		{MAC: "00-14-22-01-23-45", Hostname: "printer", Line: 1},
+		{MAC: "00:14:22:01:23:45:67:89", Hostname: "", Line: 2},
+	}
+
+	got := Calculate(&eui64.DefaultCalculator{}, entries, "2001:db8::")
+	require.Len(t, got, 2)
+	assert.Equal(t, entries[0], got[0].Entry)
+	assert.Equal(t, "0214:22ff:fe01:2345", got[0].InterfaceID)
+	assert.Equal(t, "2001:db8::214:22ff:fe01:2345", got[0].FullIP)
+	require.NoError(t, got[0].Err)
+	assert.Equal(t, entries[1], got[1].Entry)
+	require.ErrorIs(t, got[1].Err, validators.ErrMACLengthExceeds)
+}
+
+// manyNeighbors returns "ip neigh" output listing count distinct neighbors.
+func manyNeighbors(count int) string {
+	var builder strings.Builder
+
+	for i := range count {
+		fmt.Fprintf(&builder, "fe80::1 dev eth0 lladdr 00:14:22:01:%02x:%02x STALE\n", i>>8, i&0xff)
+	}
+
+	return builder.String()
+}
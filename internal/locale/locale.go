@@ -0,0 +1,78 @@
+// Package locale provides Fiber middleware that negotiates the locale of each
+// request from an explicit selection, a remembered selection, or the
+// Accept-Language header, and stores it on the request context with
+// i18n.WithLocale for templates and handlers.
+package locale
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/i18n"
+)
+
+// Config defines how the middleware negotiates and remembers the locale.
+type Config struct {
+	// QueryParam is the query parameter selecting a locale explicitly (e.g., "?lang=de").
+	QueryParam string
+	// CookieName is the cookie remembering an explicitly selected locale.
+	CookieName string
+	// CookieMaxAge is how long the selection is remembered.
+	CookieMaxAge time.Duration
+	// CookieSecure marks the cookie as Secure; enable when served over HTTPS.
+	CookieSecure bool
+}
+
+// Constants defining the default middleware configuration.
+const (
+	// DefaultCookieName is the default cookie remembering the selected locale.
+	DefaultCookieName = "lang"
+	// DefaultCookieMaxAge remembers the selected locale for a year.
+	DefaultCookieMaxAge = 365 * 24 * time.Hour
+)
+
+// DefaultConfig returns the default middleware configuration.
+func DefaultConfig() Config {
+	return Config{
+		QueryParam:   i18n.QueryParam,
+		CookieName:   DefaultCookieName,
+		CookieMaxAge: DefaultCookieMaxAge,
+		CookieSecure: false,
+	}
+}
+
+// New creates middleware that negotiates the locale of each request and stores
+// it on the request context with i18n.WithLocale. A supported locale selected with
+// the query parameter is used and remembered in the cookie; otherwise the
+// remembered locale is used, then the best match for the Accept-Language
+// header, then the default locale. Responses are marked as varying with those
+// inputs and carry the negotiated locale in Content-Language.
+func New(config Config) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		locale, selected := i18n.Lookup(c.Query(config.QueryParam))
+		if selected {
+			c.Cookie(&fiber.Cookie{
+				Name:        config.CookieName,
+				Value:       locale.Tag,
+				Path:        "/",
+				Domain:      "",
+				MaxAge:      int(config.CookieMaxAge.Seconds()),
+				Expires:     time.Time{},
+				Secure:      config.CookieSecure,
+				HTTPOnly:    true,
+				SameSite:    fiber.CookieSameSiteLaxMode,
+				Partitioned: false,
+				SessionOnly: false,
+			})
+		} else {
+			locale = i18n.Match(c.Cookies(config.CookieName), c.Get(fiber.HeaderAcceptLanguage))
+		}
+
+		c.Vary(fiber.HeaderAcceptLanguage, fiber.HeaderCookie)
+		c.Set(fiber.HeaderContentLanguage, locale.Tag)
+		c.SetContext(i18n.WithLocale(c.Context(), locale))
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,88 @@
+package locale
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/i18n"
+)
+
+// TestNew verifies that the middleware stores the negotiated locale on the
+// request context, declares it in Content-Language and Vary, and remembers an
+// explicit selection in a cookie configured as requested.
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultConfig()
+	config.CookieSecure = true
+
+	app := fiber.New()
+	app.Use(New(config))
+	app.Get("/", func(c fiber.Ctx) error {
+		return c.SendString(i18n.FromContext(c.Context()).Tag)
+	})
+
+	tests := []struct {
+		name           string
+		target         string
+		acceptLanguage string
+		wantTag        string
+		wantCookie     bool
+	}{
+		{
+			name:           "Negotiated from Accept-Language",
+			target:         "/",
+			acceptLanguage: "es-MX",
+			wantTag:        "es",
+			wantCookie:     false,
+		},
+		{
+			name:           "Selected with the query parameter",
+			target:         "/?lang=de",
+			acceptLanguage: "es-MX",
+			wantTag:        "de",
+			wantCookie:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req, _ := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://localhost"+tt.target, http.NoBody)
+			req.Header.Set(fiber.HeaderAcceptLanguage, tt.acceptLanguage)
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantTag, string(body))
+			assert.Equal(t, tt.wantTag, resp.Header.Get(fiber.HeaderContentLanguage))
+			assert.Equal(t, "Accept-Language, Cookie", resp.Header.Get(fiber.HeaderVary))
+
+			cookies := resp.Cookies()
+			if !tt.wantCookie {
+				assert.Empty(t, cookies)
+
+				return
+			}
+
+			require.Len(t, cookies, 1)
+			assert.Equal(t, DefaultCookieName, cookies[0].Name)
+			assert.Equal(t, tt.wantTag, cookies[0].Value)
+			assert.Equal(t, int(DefaultCookieMaxAge.Seconds()), cookies[0].MaxAge)
+			assert.True(t, cookies[0].Secure)
+			assert.True(t, cookies[0].HttpOnly)
+			assert.Equal(t, http.SameSiteLaxMode, cookies[0].SameSite)
+		})
+	}
+}
@@ -0,0 +1,237 @@
+// Package macrange enumerates sequential MAC addresses, such as those allocated
+// to the hosts of a lab, from a start address and either an end address, a
+// count, or the length of the block the start address belongs to, such as an
+// IEEE MA-S block (/36). Addresses are counted as 48-bit numbers, so they carry
+// across bytes, and the EUI-64 address of each can be calculated in a prefix
+// through any eui64.Calculator.
+package macrange
+
+import (
+	"fmt"
+	"iter"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/errcode"
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+	"github.com/nicholas-fedor/eui64-calculator/internal/validators"
+)
+
+// DefaultMaxCount is the default maximum number of MAC addresses in a range.
+const DefaultMaxCount = 256
+
+// Constants defining the layout of MAC addresses and blocks.
+const (
+	macBytes      = 6              // macBytes is the size of a MAC address.
+	macBits       = 48             // macBits is the size of a MAC address in bits.
+	byteBits      = 8              // byteBits is the number of bits in a byte.
+	byteMask      = 0xff           // byteMask masks the low byte of a value.
+	lastMAC       = 1<<macBits - 1 // lastMAC is ff-ff-ff-ff-ff-ff.
+	minBlockBits  = 24             // minBlockBits is the length of the largest block, an OUI (MA-L).
+	blockMarker   = "/"            // blockMarker introduces a block length in place of an end address.
+	countBitSize  = 64             // countBitSize is the size of a parsed count in bits.
+	formatPattern = "%02x-%02x-%02x-%02x-%02x-%02x"
+)
+
+// Input identifies the value of a range that is invalid.
+type Input string
+
+// Values of a range.
+const (
+	InputStart Input = "start"
+	InputEnd   Input = "end"
+	InputCount Input = "count"
+)
+
+// Static error variables.
+var (
+	ErrEndAndCount    = errcode.New("range.end_and_count", "a MAC range takes an end address or a count, not both")
+	ErrEndBeforeStart = errcode.New("range.end_before_start", "end MAC address is before the start address")
+	ErrInvalidCount   = errcode.New("range.count", "count must be a positive whole number")
+	ErrBeyondLastMAC  = errcode.New("range.beyond_last", "MAC range extends beyond ff-ff-ff-ff-ff-ff")
+	ErrInvalidBlock   = errcode.New("range.block", fmt.Sprintf("block length must be between /%d and /%d", minBlockBits, macBits))
+	ErrTooManyMACs    = errcode.New("range.too_many_macs", "MAC range exceeds the maximum number of addresses")
+	ErrNotEthernet    = errcode.New("range.not_ethernet", "MAC ranges can only be calculated for Ethernet MAC addresses")
+)
+
+// InputError is returned when a value of a range is invalid, naming the value.
+type InputError struct {
+	Input Input // Input is the invalid value.
+	Err   error // Err explains why the value is invalid.
+}
+
+// Error returns the message of the underlying error.
+func (e *InputError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *InputError) Unwrap() error {
+	return e.Err
+}
+
+// LimitError is returned, wrapping ErrTooManyMACs, when a range has more MAC
+// addresses than allowed, naming the maximum so it can be explained.
+type LimitError struct {
+	Count uint64 // Count is the number of MAC addresses in the range.
+	Max   int    // Max is the maximum number of MAC addresses allowed.
+}
+
+// Error describes the range and the maximum.
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%s: %d addresses, at most %d allowed", ErrTooManyMACs, e.Count, e.Max)
+}
+
+// Unwrap returns ErrTooManyMACs.
+func (e *LimitError) Unwrap() error {
+	return ErrTooManyMACs
+}
+
+// ErrorArgs returns the maximum, implementing errcode.Detailed so it can be
+// explained.
+func (e *LimitError) ErrorArgs() []any {
+	return []any{e.Max}
+}
+
+// Range is a run of sequential MAC addresses.
+type Range struct {
+	First uint64 // First is the first MAC address as a 48-bit number.
+	Count int    // Count is the number of MAC addresses, at least one.
+}
+
+// Address is a MAC address of a range and its EUI-64 addresses.
+type Address struct {
+	MAC         string
+	InterfaceID string
+	FullIP      string
+}
+
+// IsRange reports whether an end or a count is given, so the MAC address field
+// starts a range rather than holding a single address.
+func IsRange(end, count string) bool {
+	return strings.TrimSpace(end) != "" || strings.TrimSpace(count) != ""
+}
+
+// Parse parses the range starting at the start MAC address and ending at the
+// end MAC address, inclusive, or holding count addresses. In place of an end
+// address, a block length such as /36 selects every address of the block the
+// start address belongs to. At most maxCount addresses are allowed. An invalid
+// value is reported as an *InputError naming it.
+func Parse(start, end, count string, maxCount int) (Range, error) {
+	end = strings.TrimSpace(end)
+	count = strings.TrimSpace(count)
+
+	first, err := parseMAC(start)
+	if err != nil {
+		return Range{}, &InputError{Input: InputStart, Err: err}
+	}
+
+	var (
+		total uint64
+		input Input
+	)
+
+	switch {
+	case end != "" && count != "":
+		return Range{}, &InputError{Input: InputCount, Err: ErrEndAndCount}
+	case strings.HasPrefix(end, blockMarker):
+		input = InputEnd
+
+		bits, err := strconv.Atoi(strings.TrimPrefix(end, blockMarker))
+		if err != nil || bits < minBlockBits || bits > macBits {
+			return Range{}, &InputError{Input: InputEnd, Err: ErrInvalidBlock}
+		}
+
+		total = 1 << (macBits - bits)
+		first &^= total - 1
+	case end != "":
+		input = InputEnd
+
+		last, err := parseMAC(end)
+		if err != nil {
+			return Range{}, &InputError{Input: InputEnd, Err: err}
+		}
+
+		if last < first {
+			return Range{}, &InputError{Input: InputEnd, Err: fmt.Errorf("%w: %s", ErrEndBeforeStart, Format(last))}
+		}
+
+		total = last - first + 1
+	default:
+		input = InputCount
+
+		total, err = strconv.ParseUint(count, 10, countBitSize)
+		if err != nil || total == 0 {
+			return Range{}, &InputError{Input: InputCount, Err: fmt.Errorf("%w, got %q", ErrInvalidCount, count)}
+		}
+
+		if total-1 > lastMAC-first {
+			return Range{}, &InputError{Input: InputCount, Err: ErrBeyondLastMAC}
+		}
+	}
+
+	if maxCount < 1 || total > uint64(maxCount) {
+		return Range{}, &InputError{Input: input, Err: &LimitError{Count: total, Max: maxCount}}
+	}
+
+	return Range{First: first, Count: int(total)}, nil
+}
+
+// MACs returns the MAC addresses of the range in order, formatted as six pairs
+// of lowercase hexadecimal digits separated by hyphens.
+func (r Range) MACs() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for i := range r.Count {
+			if !yield(Format(r.First + uint64(i))) {
+				return
+			}
+		}
+	}
+}
+
+// Calculate calculates the EUI-64 address of every MAC address of the range in
+// the prefix with calc, in order, stopping at the first failure.
+func Calculate(calc eui64.Calculator, r Range, prefix string) ([]Address, error) {
+	addresses := make([]Address, 0, r.Count)
+
+	for mac := range r.MACs() {
+		interfaceID, fullIP, err := calc.CalculateEUI64(mac, prefix)
+		if err != nil {
+			return nil, fmt.Errorf("calculating address of %s: %w", mac, err)
+		}
+
+		addresses = append(addresses, Address{MAC: mac, InterfaceID: interfaceID, FullIP: fullIP})
+	}
+
+	return addresses, nil
+}
+
+// Format formats a MAC address given as a 48-bit number.
+func Format(mac uint64) string {
+	var octets [macBytes]any
+	for i := range octets {
+		octets[i] = (mac >> (byteBits * (macBytes - 1 - i))) & byteMask
+	}
+
+	return fmt.Sprintf(formatPattern, octets[:]...)
+}
+
+// parseMAC validates a MAC address and returns it as a 48-bit number.
+func parseMAC(mac string) (uint64, error) {
+	if err := validators.ValidateMAC(mac); err != nil {
+		return 0, fmt.Errorf("validating MAC address: %w", err)
+	}
+
+	hardware, err := net.ParseMAC(strings.TrimSpace(mac))
+	if err != nil || len(hardware) != macBytes {
+		return 0, fmt.Errorf("%w: %q", validators.ErrMACParseFailed, mac)
+	}
+
+	var value uint64
+	for _, octet := range hardware {
+		value = value<<byteBits | uint64(octet)
+	}
+
+	return value, nil
+}
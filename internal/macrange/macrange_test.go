@@ -0,0 +1,123 @@
+package macrange
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+	"github.com/nicholas-fedor/eui64-calculator/internal/validators"
+)
+
+// TestParse tests the Parse function with end addresses, counts, and block
+// lengths, including ranges carrying across bytes.
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		start     string
+		end       string
+		count     string
+		wantFirst string
+		wantLast  string
+		wantCount int
+	}{
+		{"End address", "00:14:22:01:23:00", "00:14:22:01:23:ff", "", "00-14-22-01-23-00", "00-14-22-01-23-ff", 256},
+		{"Single address", "00-14-22-01-23-45", "00-14-22-01-23-45", "", "00-14-22-01-23-45", "00-14-22-01-23-45", 1},
+		{"Count", "00-14-22-01-23-45", "", " 50 ", "00-14-22-01-23-45", "00-14-22-01-23-76", 50},
+		{"Carry across bytes", "00-14-22-01-ff-fe", "", "4", "00-14-22-01-ff-fe", "00-14-22-02-00-01", 4},
+		{"Last address", "ff-ff-ff-ff-ff-fe", "", "2", "ff-ff-ff-ff-ff-fe", "ff-ff-ff-ff-ff-ff", 2},
+		{"Block", "00-14-22-01-23-45", "/40", "", "00-14-22-01-23-00", "00-14-22-01-23-ff", 256},
+		{"Single address block", "00-14-22-01-23-45", "/48", "", "00-14-22-01-23-45", "00-14-22-01-23-45", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Parse(tt.start, tt.end, tt.count, DefaultMaxCount)
+			require.NoError(t, err)
+
+			macs := slices.Collect(got.MACs())
+			require.Len(t, macs, tt.wantCount)
+			assert.Equal(t, tt.wantCount, got.Count)
+			assert.Equal(t, tt.wantFirst, macs[0], "First MAC address")
+			assert.Equal(t, tt.wantLast, macs[len(macs)-1], "Last MAC address")
+		})
+	}
+}
+
+// TestParseInvalid tests that Parse names the value of a range that is invalid.
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		start     string
+		end       string
+		count     string
+		maxCount  int
+		wantInput Input
+		wantErr   error
+	}{
+		{"Invalid start", "00-14-22", "", "2", DefaultMaxCount, InputStart, validators.ErrMACParseFailed},
+		{"Invalid end", "00-14-22-01-23-00", "00-14-22-01-23-gg", "", DefaultMaxCount, InputEnd, validators.ErrInvalidMACChar},
+		{"End and count", "00-14-22-01-23-00", "00-14-22-01-23-ff", "2", DefaultMaxCount, InputCount, ErrEndAndCount},
+		{"End before start", "00-14-22-01-23-45", "00-14-22-01-23-00", "", DefaultMaxCount, InputEnd, ErrEndBeforeStart},
+		{"Zero count", "00-14-22-01-23-45", "", "0", DefaultMaxCount, InputCount, ErrInvalidCount},
+		{"Negative count", "00-14-22-01-23-45", "", "-1", DefaultMaxCount, InputCount, ErrInvalidCount},
+		{"Count beyond last address", "ff-ff-ff-ff-ff-fe", "", "3", DefaultMaxCount, InputCount, ErrBeyondLastMAC},
+		{"Invalid block", "00-14-22-01-23-45", "/16", "", DefaultMaxCount, InputEnd, ErrInvalidBlock},
+		{"Count above maximum", "00-14-22-01-23-00", "", "11", 10, InputCount, ErrTooManyMACs},
+		{"End above maximum", "00-14-22-01-23-00", "00-14-22-01-24-00", "", DefaultMaxCount, InputEnd, ErrTooManyMACs},
+		{"OUI block above maximum", "00-14-22-01-23-00", "/24", "", DefaultMaxCount, InputEnd, ErrTooManyMACs},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(tt.start, tt.end, tt.count, tt.maxCount)
+			require.ErrorIs(t, err, tt.wantErr)
+
+			var inputErr *InputError
+			require.ErrorAs(t, err, &inputErr)
+			assert.Equal(t, tt.wantInput, inputErr.Input)
+		})
+	}
+
+	_, err := Parse("00-14-22-01-23-00", "", "11", 10)
+
+	var limitErr *LimitError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, 10, limitErr.Max)
+	assert.Equal(t, uint64(11), limitErr.Count)
+}
+
+// TestIsRange tests that only a non-blank end or count makes a range.
+func TestIsRange(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, IsRange("", " "))
+	assert.True(t, IsRange("/40", ""))
+	assert.True(t, IsRange("", "5"))
+}
+
+// TestCalculate tests that Calculate returns the EUI-64 address of every MAC
+// address of a range in order.
+func TestCalculate(t *testing.T) {
+	t.Parallel()
+
+	r, err := Parse("00-14-22-01-23-ff", "", "2", DefaultMaxCount)
+	require.NoError(t, err)
+
+	got, err := Calculate(&eui64.DefaultCalculator{}, r, "2001:db8::")
+	require.NoError(t, err)
+	assert.Equal(t, []Address{
+		{MAC: "00-14-22-01-23-ff", InterfaceID: "0214:22ff:fe01:23ff", FullIP: "2001:db8::214:22ff:fe01:23ff"},
+		{MAC: "00-14-22-01-24-00", InterfaceID: "0214:22ff:fe01:2400", FullIP: "2001:db8::214:22ff:fe01:2400"},
+	}, got)
+}
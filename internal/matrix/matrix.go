@@ -0,0 +1,123 @@
+// Package matrix computes EUI-64 addresses for every combination of a list of
+// MAC addresses and a list of IPv6 prefixes, such as the interfaces of
+// dual-homed hosts across the ULA and GUA prefixes of a network. Cells are
+// produced lazily through any eui64.Calculator and can be streamed as CSV, so
+// large matrices never need to be held in memory.
+package matrix
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+	"unicode"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/csvstream"
+	"github.com/nicholas-fedor/eui64-calculator/internal/errcode"
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+	"github.com/nicholas-fedor/eui64-calculator/internal/validators"
+)
+
+// MaxCells is the maximum number of cells in a matrix, the product of its
+// number of MAC addresses and number of prefixes.
+const MaxCells = 1 << 20
+
+// Static error variables.
+var (
+	ErrNoMACs       = errcode.New("matrix.no_macs", "at least one MAC address is expected")
+	ErrNoPrefixes   = errcode.New("matrix.no_prefixes", "at least one IPv6 prefix is expected")
+	ErrTooManyCells = errcode.New("matrix.too_many_cells", fmt.Sprintf("matrix exceeds %d cells", MaxCells))
+)
+
+// header is the header row of the CSV output.
+var header = []string{"mac", "prefix", "interface_id", "ipv6_address", "error"}
+
+// Cell is the outcome of calculating the EUI-64 address of one MAC address in
+// one prefix. Err is set, and the addresses empty, if either value is invalid
+// or the calculation fails.
+type Cell struct {
+	MAC         string
+	Prefix      string
+	InterfaceID string
+	FullIP      string
+	Err         error
+}
+
+// ParseList splits a list of MAC addresses or prefixes separated by commas,
+// whitespace, or newlines.
+func ParseList(list string) []string {
+	return strings.FieldsFunc(list, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+}
+
+// Check reports whether a matrix of the given MAC addresses and prefixes can be
+// computed: both lists must be non-empty and the matrix no larger than MaxCells.
+func Check(macs, prefixes []string) error {
+	switch {
+	case len(macs) == 0:
+		return ErrNoMACs
+	case len(prefixes) == 0:
+		return ErrNoPrefixes
+	case len(macs) > MaxCells/len(prefixes):
+		return fmt.Errorf("%w, got %d MAC addresses and %d prefixes", ErrTooManyCells, len(macs), len(prefixes))
+	}
+
+	return nil
+}
+
+// Cells returns the cells of the matrix of the given MAC addresses and
+// prefixes, row by row: every prefix for the first MAC address, then for the
+// next. Each value is validated once, and valid pairs are calculated with calc.
+func Cells(calc eui64.Calculator, macs, prefixes []string) iter.Seq[Cell] {
+	return func(yield func(Cell) bool) {
+		prefixErrs := make([]error, len(prefixes))
+		for i, prefix := range prefixes {
+			prefixErrs[i] = validators.ValidateIPv6Prefix(prefix)
+		}
+
+		for _, mac := range macs {
+			macErr := validators.ValidateMAC(mac)
+
+			for i, prefix := range prefixes {
+				cell := Cell{MAC: mac, Prefix: prefix, InterfaceID: "", FullIP: "", Err: nil}
+
+				switch {
+				case macErr != nil:
+					cell.Err = macErr
+				case prefixErrs[i] != nil:
+					cell.Err = prefixErrs[i]
+				default:
+					cell.InterfaceID, cell.FullIP, cell.Err = calc.CalculateEUI64(mac, prefix)
+				}
+
+				if !yield(cell) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// WriteCSV writes the cells as CSV, after the header row, explaining each
+// cell's error with explain, or with the error's own message if explain is
+// nil. The output is streamed, see csvstream.Write.
+func WriteCSV(w io.Writer, cells iter.Seq[Cell], explain func(error) string) error {
+	if explain == nil {
+		explain = error.Error
+	}
+
+	err := csvstream.Write(w, header, cells, func(cell Cell) []string {
+		message := ""
+		if cell.Err != nil {
+			message = explain(cell.Err)
+		}
+
+		return []string{cell.MAC, cell.Prefix, cell.InterfaceID, cell.FullIP, message}
+	})
+	if err != nil {
+		return fmt.Errorf("writing matrix: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package matrix
+
+import (
+	"bufio"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+	"github.com/nicholas-fedor/eui64-calculator/internal/validators"
+)
+
+// errStubFailure is the error returned by stubCalculator for its failing MAC.
+var errStubFailure = errors.New("stub failure")
+
+// stubCalculator is an alternative Calculator recording the pairs it is asked
+// to calculate and failing for one MAC address.
+type stubCalculator struct {
+	calls   []string
+	failMAC string
+}
+
+// CalculateEUI64 records the pair and returns addresses naming it.
+func (s *stubCalculator) CalculateEUI64(mac, prefix string) (string, string, error) {
+	s.calls = append(s.calls, mac+" "+prefix)
+	if mac == s.failMAC {
+		return "", "", errStubFailure
+	}
+
+	return "id:" + mac, prefix + "/" + mac, nil
+}
+
+// TestParseList verifies that lists are split on commas, whitespace, and
+// newlines, dropping empty entries.
+func TestParseList(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(
+		t,
+		[]string{"00-14-22-01-23-45", "00:14:22:01:23:46", "2001:db8::", "fd00::"},
+		ParseList("00-14-22-01-23-45,\r\n00:14:22:01:23:46\n\n 2001:db8:: ,, fd00::\t"),
+	)
+	assert.Empty(t, ParseList(" ,\n"))
+}
+
+// TestCheck verifies that empty lists and matrices larger than MaxCells are
+// rejected before any cell is computed.
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		macs     int
+		prefixes int
+		wantErr  error
+	}{
+		{"Single cell", 1, 1, nil},
+		{"Largest matrix", MaxCells / 4, 4, nil},
+		{"No MACs", 0, 1, ErrNoMACs},
+		{"No prefixes", 1, 0, ErrNoPrefixes},
+		{"Too many cells", MaxCells/4 + 1, 4, ErrTooManyCells},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Check(make([]string, tt.macs), make([]string, tt.prefixes))
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestCells verifies that the matrix covers every MAC address and prefix pair
+// row by row, that invalid values produce per-cell validation errors without
+// reaching the calculator, and that calculation errors are reported per cell.
+func TestCells(t *testing.T) {
+	t.Parallel()
+
+	calc := &stubCalculator{calls: nil, failMAC: "00-00-00-00-00-02"}
+	macs := []string{"00-00-00-00-00-01", "invalid", "00-00-00-00-00-02"}
+	prefixes := []string{"2001:db8::", "fd00:g::"}
+
+	cells := slices.Collect(Cells(calc, macs, prefixes))
+	require.Len(t, cells, len(macs)*len(prefixes))
+
+	assert.Equal(t, Cell{
+		MAC:         "00-00-00-00-00-01",
+		Prefix:      "2001:db8::",
+		InterfaceID: "id:00-00-00-00-00-01",
+		FullIP:      "2001:db8::/00-00-00-00-00-01",
+		Err:         nil,
+	}, cells[0])
+	require.ErrorIs(t, cells[1].Err, validators.ErrInvalidHextetChar)
+	require.ErrorIs(t, cells[2].Err, validators.ErrInvalidMACChar)
+	require.ErrorIs(t, cells[3].Err, validators.ErrInvalidMACChar)
+	require.ErrorIs(t, cells[4].Err, errStubFailure)
+	assert.Equal(t, "00-00-00-00-00-02", cells[4].MAC)
+	require.ErrorIs(t, cells[5].Err, validators.ErrInvalidHextetChar)
+
+	assert.Equal(
+		t,
+		[]string{"00-00-00-00-00-01 2001:db8::", "00-00-00-00-00-02 2001:db8::"},
+		calc.calls,
+		"Only valid pairs should reach the calculator",
+	)
+}
+
+// TestCellsStop verifies that cells are computed lazily, so a consumer that
+// stops early leaves the rest of the matrix uncalculated.
+func TestCellsStop(t *testing.T) {
+	t.Parallel()
+
+	calc := &stubCalculator{calls: nil, failMAC: ""}
+
+	for range Cells(calc, []string{"00-00-00-00-00-01", "00-00-00-00-00-02"}, []string{"2001:db8::"}) {
+		break
+	}
+
+	assert.Len(t, calc.calls, 1)
+}
+
+// TestWriteCSV verifies the CSV output of a matrix calculated with the default
+// calculator, with errors explained by the given function and the buffered
+// writer underneath flushed.
+func TestWriteCSV(t *testing.T) {
+	t.Parallel()
+
+	var out strings.Builder
+
+	buffered := bufio.NewWriter(&out)
+	cells := Cells(
+		&eui64.DefaultCalculator{},
+		[]string{"00-14-22-01-23-45", "00-14-22-01-23"},
+		[]string{"2001:db8::", "fd00:1:2:3"},
+	)
+
+	err := WriteCSV(buffered, cells, func(err error) string {
+		if errors.Is(err, validators.ErrMACParseFailed) {
+			return "invalid, too short"
+		}
+
+		return err.Error()
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, strings.Join([]string{
+		"mac,prefix,interface_id,ipv6_address,error",
+		"00-14-22-01-23-45,2001:db8::,0214:22ff:fe01:2345,2001:db8::214:22ff:fe01:2345,",
+		"00-14-22-01-23-45,fd00:1:2:3,0214:22ff:fe01:2345,fd00:1:2:3:214:22ff:fe01:2345,",
+		`00-14-22-01-23,2001:db8::,,,"invalid, too short"`,
+		`00-14-22-01-23,fd00:1:2:3,,,"invalid, too short"`,
+		"",
+	}, "\n"), out.String())
+}
+
+// TestWriteCSVDefaultExplanation verifies that errors are written with their
+// own message when no explanation function is given.
+func TestWriteCSVDefaultExplanation(t *testing.T) {
+	t.Parallel()
+
+	var out strings.Builder
+
+	err := WriteCSV(&out, Cells(&eui64.DefaultCalculator{}, []string{"invalid"}, []string{"2001:db8::"}), nil)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.True(t, strings.HasPrefix(lines[1], "invalid,2001:db8::,,,"), "Unexpected row %q", lines[1])
+	assert.Contains(t, lines[1], validators.ErrInvalidMACChar.Error())
+}
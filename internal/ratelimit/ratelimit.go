@@ -0,0 +1,145 @@
+// Package ratelimit provides Fiber middleware that protects expensive endpoints
+// from abuse. It combines a per-client token bucket, keyed by client IP by default,
+// with a global cap on the number of requests processed concurrently.
+package ratelimit
+
+import (
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// Config defines the behavior of the rate-limiting middleware.
+type Config struct {
+	// Rate is the sustained number of requests per second allowed per client.
+	// A value of zero or less disables per-client limiting.
+	Rate float64
+	// Burst is the number of requests a client may make in quick succession
+	// before Rate applies.
+	Burst int
+	// MaxConcurrent caps the number of requests handled at once across all
+	// clients. A value of zero or less disables the cap.
+	MaxConcurrent int
+	// Store tracks token buckets. Defaults to a MemoryStore built from Rate and Burst.
+	Store Store
+	// KeyGenerator derives the client key from a request. Defaults to c.IP(),
+	// which honors the application's trusted proxy configuration.
+	KeyGenerator func(c fiber.Ctx) string
+	// LimitReached renders the response for rejected requests after the status
+	// and Retry-After header are set. Defaults to sending the status alone.
+	LimitReached fiber.Handler
+}
+
+// slotKey is the key of the request's slot in the Fiber locals.
+type slotKey struct{}
+
+// slot is a request's place under the concurrency cap.
+type slot struct {
+	release func() // release frees the slot, once.
+	held    bool   // held reports whether the slot outlives the handler, see Hold.
+}
+
+// New creates rate-limiting middleware from the given configuration.
+// Requests over the per-client rate or beyond the concurrency cap are answered
+// with 429 Too Many Requests. Requests rejected by the cap do not take a token
+// from their client. Store failures are logged and the request is allowed
+// through so that a backend outage does not take the service down.
+func New(config Config) fiber.Handler {
+	config = withDefaults(config)
+
+	var slots chan struct{}
+	if config.MaxConcurrent > 0 {
+		slots = make(chan struct{}, config.MaxConcurrent)
+	}
+
+	return func(c fiber.Ctx) error {
+		if slots == nil {
+			return limit(c, config)
+		}
+
+		select {
+		case slots <- struct{}{}:
+		default:
+			return reject(c, config, 1)
+		}
+
+		current := &slot{release: sync.OnceFunc(func() { <-slots }), held: false}
+		c.Locals(slotKey{}, current)
+
+		defer func() {
+			if !current.held {
+				current.release()
+			}
+		}()
+
+		return limit(c, config)
+	}
+}
+
+// Hold keeps the request's concurrency slot taken after the handler returns,
+// for responses still being produced then, such as those streamed with
+// SendStreamWriter. It returns the function freeing the slot, to be called
+// once the response is complete. Without a slot, it returns a no-op.
+func Hold(c fiber.Ctx) func() {
+	current, ok := c.Locals(slotKey{}).(*slot)
+	if !ok {
+		return func() {}
+	}
+
+	current.held = true
+
+	return current.release
+}
+
+// limit applies the per-client rate to the request, then calls the next
+// handler if it is allowed.
+func limit(c fiber.Ctx, config Config) error {
+	if config.Rate > 0 {
+		allowed, wait, err := config.Store.Take(c.Context(), config.KeyGenerator(c))
+		if err != nil {
+			slog.ErrorContext(
+				c.Context(),
+				"Rate limit store failed",
+				"error", err,
+			)
+		} else if !allowed {
+			return reject(c, config, wait.Seconds())
+		}
+	}
+
+	return c.Next()
+}
+
+// withDefaults fills unset configuration fields with their default values.
+func withDefaults(config Config) Config {
+	if config.Store == nil && config.Rate > 0 {
+		config.Store = NewMemoryStore(config.Rate, config.Burst)
+	}
+
+	if config.KeyGenerator == nil {
+		config.KeyGenerator = func(c fiber.Ctx) string {
+			return c.IP()
+		}
+	}
+
+	if config.LimitReached == nil {
+		config.LimitReached = func(c fiber.Ctx) error {
+			return c.SendStatus(http.StatusTooManyRequests)
+		}
+	}
+
+	return config
+}
+
+// reject sets the 429 status and a Retry-After header rounded up to whole
+// seconds, then delegates the response body to the LimitReached handler.
+func reject(c fiber.Ctx, config Config, retryAfter float64) error {
+	c.Status(http.StatusTooManyRequests)
+	c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Max(1, math.Ceil(retryAfter)))))
+
+	return config.LimitReached(c)
+}
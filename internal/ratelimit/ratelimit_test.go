@@ -0,0 +1,326 @@
+package ratelimit
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// errStoreUnavailable is returned by failingStore to simulate a backend outage.
+var errStoreUnavailable = errors.New("store unavailable")
+
+// failingStore is a Store whose every call fails.
+type failingStore struct{}
+
+// Take always returns errStoreUnavailable.
+func (failingStore) Take(context.Context, string) (bool, time.Duration, error) {
+	return false, 0, errStoreUnavailable
+}
+
+// TestNew tests the rate-limiting middleware's per-client limit.
+// It verifies that requests beyond the burst receive a 429 with a Retry-After
+// header, that the LimitReached handler renders the body, and that store
+// failures let requests through.
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		config         Config
+		requests       int
+		wantStatus     int
+		wantRetryAfter string
+	}{
+		{
+			name: "Requests within burst pass",
+			config: Config{
+				Rate:          1,
+				Burst:         3,
+				MaxConcurrent: 0,
+				Store:         nil,
+				KeyGenerator:  nil,
+				LimitReached:  nil,
+			},
+			requests:       3,
+			wantStatus:     http.StatusOK,
+			wantRetryAfter: "",
+		},
+		{
+			name: "Request beyond burst is rejected",
+			config: Config{
+				Rate:          0.5,
+				Burst:         2,
+				MaxConcurrent: 0,
+				Store:         nil,
+				KeyGenerator:  nil,
+				LimitReached:  nil,
+			},
+			requests:       3,
+			wantStatus:     http.StatusTooManyRequests,
+			wantRetryAfter: "2",
+		},
+		{
+			name: "Zero rate disables limiting",
+			config: Config{
+				Rate:          0,
+				Burst:         0,
+				MaxConcurrent: 0,
+				Store:         nil,
+				KeyGenerator:  nil,
+				LimitReached:  nil,
+			},
+			requests:       10,
+			wantStatus:     http.StatusOK,
+			wantRetryAfter: "",
+		},
+		{
+			name: "Store failure allows request",
+			config: Config{
+				Rate:          1,
+				Burst:         1,
+				MaxConcurrent: 0,
+				Store:         failingStore{},
+				KeyGenerator:  nil,
+				LimitReached:  nil,
+			},
+			requests:       3,
+			wantStatus:     http.StatusOK,
+			wantRetryAfter: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app := fiber.New()
+			app.Use(New(tt.config))
+			app.Get("/", func(c fiber.Ctx) error {
+				return c.SendString("ok")
+			})
+
+			var resp *http.Response
+
+			for range tt.requests {
+				req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://localhost/", http.NoBody)
+				require.NoError(t, err)
+
+				resp, err = app.Test(req)
+				require.NoError(t, err)
+				require.NoError(t, resp.Body.Close())
+			}
+
+			assert.Equal(t, tt.wantStatus, resp.StatusCode, "Status code")
+			assert.Equal(t, tt.wantRetryAfter, resp.Header.Get(fiber.HeaderRetryAfter), "Retry-After")
+		})
+	}
+}
+
+// TestNewConcurrencyCap verifies that requests arriving while the global
+// concurrency cap is reached are rejected and handed to LimitReached.
+func TestNewConcurrencyCap(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	app := fiber.New()
+	app.Use(New(Config{
+		Rate:          0,
+		Burst:         0,
+		MaxConcurrent: 1,
+		Store:         nil,
+		KeyGenerator:  nil,
+		LimitReached: func(c fiber.Ctx) error {
+			return c.SendString("busy")
+		},
+	}))
+	app.Get("/slow", func(c fiber.Ctx) error {
+		close(started)
+		<-release
+
+		return c.SendString("ok")
+	})
+	app.Get("/fast", func(c fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+
+	done := make(chan int)
+
+	go func() {
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://localhost/slow", http.NoBody)
+
+		resp, err := app.Test(req, fiber.TestConfig{Timeout: 0, FailOnTimeout: false})
+		if err != nil {
+			done <- 0
+
+			return
+		}
+
+		_ = resp.Body.Close()
+		done <- resp.StatusCode
+	}()
+
+	<-started
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://localhost/fast", http.NoBody)
+	require.NoError(t, err)
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode, "Status code while at capacity")
+	assert.Equal(t, "1", resp.Header.Get(fiber.HeaderRetryAfter), "Retry-After")
+
+	close(release)
+	assert.Equal(t, http.StatusOK, <-done, "Status code of in-flight request")
+}
+
+// TestNewConcurrencyCapRejectionKeepsTokens verifies that requests rejected by
+// the concurrency cap do not take a token from their client.
+func TestNewConcurrencyCapRejectionKeepsTokens(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	app := fiber.New()
+	app.Use(New(Config{
+		Rate:          0.001,
+		Burst:         2,
+		MaxConcurrent: 1,
+		Store:         nil,
+		KeyGenerator:  nil,
+		LimitReached:  nil,
+	}))
+	app.Get("/slow", func(c fiber.Ctx) error {
+		close(started)
+		<-release
+
+		return c.SendString("ok")
+	})
+	app.Get("/fast", func(c fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+
+	done := make(chan int)
+
+	go func() {
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://localhost/slow", http.NoBody)
+
+		resp, err := app.Test(req, fiber.TestConfig{Timeout: 0, FailOnTimeout: false})
+		if err != nil {
+			done <- 0
+
+			return
+		}
+
+		_ = resp.Body.Close()
+		done <- resp.StatusCode
+	}()
+
+	<-started
+
+	for _, want := range []int{http.StatusTooManyRequests, http.StatusTooManyRequests} {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://localhost/fast", http.NoBody)
+		require.NoError(t, err)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		assert.Equal(t, want, resp.StatusCode, "Status code while at capacity")
+	}
+
+	close(release)
+	assert.Equal(t, http.StatusOK, <-done, "Status code of in-flight request")
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://localhost/fast", http.NoBody)
+	require.NoError(t, err)
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "Status code with the token left by rejected requests")
+}
+
+// TestHold verifies that a slot held by a streamed response stays taken until
+// the stream releases it, after the handler has returned.
+func TestHold(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	app := fiber.New()
+	app.Use(New(Config{
+		Rate:          0,
+		Burst:         0,
+		MaxConcurrent: 1,
+		Store:         nil,
+		KeyGenerator:  nil,
+		LimitReached:  nil,
+	}))
+	app.Get("/stream", func(c fiber.Ctx) error {
+		done := Hold(c)
+
+		return c.SendStreamWriter(func(w *bufio.Writer) {
+			defer done()
+
+			close(started)
+			<-release
+
+			_, _ = w.WriteString("ok")
+		})
+	})
+	app.Get("/fast", func(c fiber.Ctx) error {
+		assert.NotNil(t, Hold(c), "Hold should return a function")
+
+		return c.SendString("ok")
+	})
+
+	done := make(chan int)
+
+	go func() {
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://localhost/stream", http.NoBody)
+
+		resp, err := app.Test(req, fiber.TestConfig{Timeout: 0, FailOnTimeout: false})
+		if err != nil {
+			done <- 0
+
+			return
+		}
+
+		_ = resp.Body.Close()
+		done <- resp.StatusCode
+	}()
+
+	<-started
+
+	fast := func() int {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://localhost/fast", http.NoBody)
+		require.NoError(t, err)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, http.StatusTooManyRequests, fast(), "Status code while the stream holds the slot")
+
+	close(release)
+	assert.Equal(t, http.StatusOK, <-done, "Status code of the stream")
+	assert.Equal(t, http.StatusOK, fast(), "Status code once the stream released the slot")
+}
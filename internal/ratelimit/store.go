@@ -0,0 +1,96 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Store defines the backend used to track token buckets for rate-limited clients.
+// The in-memory implementation suits a single instance; a shared implementation
+// (e.g., backed by Redis) allows several instances to enforce one limit.
+type Store interface {
+	// Take removes one token from the bucket identified by key. It reports whether
+	// the request is allowed and, if not, how long the caller should wait before
+	// a token becomes available.
+	Take(ctx context.Context, key string) (bool, time.Duration, error)
+}
+
+// MemoryStore is a Store that keeps one token bucket per key in process memory.
+// Buckets that have been idle long enough to refill completely are discarded
+// periodically to bound memory use.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket // buckets maps client keys to their token buckets.
+	rate      float64            // rate is the number of tokens added per second.
+	burst     float64            // burst is the bucket capacity.
+	lastSweep time.Time          // lastSweep is when idle buckets were last discarded.
+	now       func() time.Time   // now returns the current time; replaceable in tests.
+}
+
+// bucket holds the state of a single token bucket.
+type bucket struct {
+	tokens float64   // tokens is the number of tokens available at last.
+	last   time.Time // last is when tokens was last updated.
+}
+
+// NewMemoryStore creates a MemoryStore that refills each bucket at rate tokens
+// per second up to a capacity of burst tokens. A burst below one is raised to one.
+func NewMemoryStore(rate float64, burst int) *MemoryStore {
+	return &MemoryStore{
+		mu:        sync.Mutex{},
+		buckets:   make(map[string]*bucket),
+		rate:      rate,
+		burst:     math.Max(float64(burst), 1),
+		lastSweep: time.Time{},
+		now:       time.Now,
+	}
+}
+
+// Take removes one token from the bucket for key, creating a full bucket for
+// keys not seen before. When the bucket is empty it returns false together with
+// the time until the next token is added.
+func (s *MemoryStore) Take(_ context.Context, key string) (bool, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	bkt, ok := s.buckets[key]
+	if !ok {
+		bkt = &bucket{tokens: s.burst, last: now}
+		s.buckets[key] = bkt
+	}
+
+	bkt.tokens = math.Min(s.burst, bkt.tokens+now.Sub(bkt.last).Seconds()*s.rate)
+	bkt.last = now
+
+	if bkt.tokens >= 1 {
+		bkt.tokens--
+
+		return true, 0, nil
+	}
+
+	wait := time.Duration((1 - bkt.tokens) / s.rate * float64(time.Second))
+
+	return false, wait, nil
+}
+
+// sweep discards buckets that would be full by now, since a missing bucket is
+// recreated full on the next request. It runs at most once per refill period.
+func (s *MemoryStore) sweep(now time.Time) {
+	refill := time.Duration(s.burst / s.rate * float64(time.Second))
+	if now.Sub(s.lastSweep) < refill {
+		return
+	}
+
+	s.lastSweep = now
+
+	for key, bkt := range s.buckets {
+		if now.Sub(bkt.last) >= refill {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMemoryStoreTake tests the MemoryStore token bucket with a controlled clock.
+// It verifies that a client may use its full burst, is then rejected with the time
+// until the next token, regains tokens as time passes, and does not affect other keys.
+func TestMemoryStoreTake(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		rate      float64
+		burst     int
+		takes     int
+		advance   time.Duration
+		wantAllow bool
+		wantWait  time.Duration
+	}{
+		{
+			name:      "Within burst",
+			rate:      1,
+			burst:     3,
+			takes:     3,
+			advance:   0,
+			wantAllow: true,
+			wantWait:  0,
+		},
+		{
+			name:      "Burst exhausted",
+			rate:      2,
+			burst:     3,
+			takes:     4,
+			advance:   0,
+			wantAllow: false,
+			wantWait:  500 * time.Millisecond,
+		},
+		{
+			name:      "Refilled after waiting",
+			rate:      2,
+			burst:     3,
+			takes:     4,
+			advance:   500 * time.Millisecond,
+			wantAllow: true,
+			wantWait:  0,
+		},
+		{
+			name:      "Burst below one is raised to one",
+			rate:      1,
+			burst:     0,
+			takes:     1,
+			advance:   0,
+			wantAllow: true,
+			wantWait:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			store := NewMemoryStore(tt.rate, tt.burst)
+			store.now = func() time.Time { return now }
+
+			for range tt.takes - 1 {
+				allowed, _, err := store.Take(t.Context(), "client")
+				require.NoError(t, err)
+				require.True(t, allowed)
+			}
+
+			now = now.Add(tt.advance)
+
+			allowed, wait, err := store.Take(t.Context(), "client")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAllow, allowed, "Allowed")
+			assert.Equal(t, tt.wantWait, wait, "Wait")
+
+			allowed, _, err = store.Take(t.Context(), "other-client")
+			require.NoError(t, err)
+			assert.True(t, allowed, "Other clients should have their own bucket")
+		})
+	}
+}
+
+// TestMemoryStoreSweep verifies that buckets idle long enough to refill
+// completely are discarded while recently used buckets are kept.
+func TestMemoryStoreSweep(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := NewMemoryStore(1, 2)
+	store.now = func() time.Time { return now }
+
+	_, _, err := store.Take(t.Context(), "idle")
+	require.NoError(t, err)
+
+	now = now.Add(time.Second)
+
+	_, _, err = store.Take(t.Context(), "active")
+	require.NoError(t, err)
+
+	now = now.Add(time.Second)
+
+	_, _, err = store.Take(t.Context(), "active")
+	require.NoError(t, err)
+
+	assert.NotContains(t, store.buckets, "idle", "Idle bucket should be discarded")
+	assert.Contains(t, store.buckets, "active", "Active bucket should be kept")
+}
@@ -0,0 +1,133 @@
+package security
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNew tests the security headers middleware with various configurations.
+// It verifies that configured headers are set, empty values omit their header,
+// and the nonce inserted into the policy matches the one stored on the context.
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		config      Config
+		wantHeaders map[string]string
+	}{
+		{
+			name:   "Default configuration",
+			config: DefaultConfig(),
+			wantHeaders: map[string]string{
+				fiber.HeaderStrictTransportSecurity: "max-age=31536000",
+				fiber.HeaderXContentTypeOptions:     "nosniff",
+				fiber.HeaderReferrerPolicy:          DefaultReferrerPolicy,
+				fiber.HeaderPermissionsPolicy:       DefaultPermissionsPolicy,
+				fiber.HeaderXFrameOptions:           DefaultFrameOptions,
+			},
+		},
+		{
+			name: "HSTS with subdomains",
+			config: Config{
+				ContentSecurityPolicy: "script-src 'nonce-{nonce}'",
+				HSTSMaxAge:            600,
+				HSTSIncludeSubdomains: true,
+				ReferrerPolicy:        "no-referrer",
+				PermissionsPolicy:     "",
+				FrameOptions:          "",
+			},
+			wantHeaders: map[string]string{
+				fiber.HeaderStrictTransportSecurity: "max-age=600; includeSubDomains",
+				fiber.HeaderXContentTypeOptions:     "nosniff",
+				fiber.HeaderReferrerPolicy:          "no-referrer",
+				fiber.HeaderPermissionsPolicy:       "",
+				fiber.HeaderXFrameOptions:           "",
+			},
+		},
+		{
+			name: "Headers disabled",
+			config: Config{
+				ContentSecurityPolicy: "",
+				HSTSMaxAge:            0,
+				HSTSIncludeSubdomains: true,
+				ReferrerPolicy:        "",
+				PermissionsPolicy:     "",
+				FrameOptions:          "",
+			},
+			wantHeaders: map[string]string{
+				fiber.HeaderContentSecurityPolicy:   "",
+				fiber.HeaderStrictTransportSecurity: "",
+				fiber.HeaderXContentTypeOptions:     "nosniff",
+				fiber.HeaderReferrerPolicy:          "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app := fiber.New()
+			app.Use(New(tt.config))
+			app.Get("/", func(c fiber.Ctx) error {
+				return c.SendString(templ.GetNonce(c.Context()))
+			})
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://localhost/", http.NoBody)
+			require.NoError(t, err)
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			for header, want := range tt.wantHeaders {
+				assert.Equal(t, want, resp.Header.Get(header), header)
+			}
+
+			if tt.config.ContentSecurityPolicy != "" {
+				match := regexp.MustCompile(`'nonce-([^']+)'`).
+					FindStringSubmatch(resp.Header.Get(fiber.HeaderContentSecurityPolicy))
+				require.Len(t, match, 2, "Nonce not found in policy")
+
+				body := make([]byte, len(match[1]))
+				_, _ = resp.Body.Read(body)
+				assert.Equal(t, match[1], string(body), "Context nonce should match policy nonce")
+			}
+		})
+	}
+}
+
+// TestNewUniqueNonce verifies that every request receives a different nonce.
+func TestNewUniqueNonce(t *testing.T) {
+	t.Parallel()
+
+	app := fiber.New()
+	app.Use(New(DefaultConfig()))
+	app.Get("/", func(c fiber.Ctx) error {
+		return c.SendStatus(http.StatusNoContent)
+	})
+
+	seen := make(map[string]bool)
+
+	for range 5 {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://localhost/", http.NoBody)
+		require.NoError(t, err)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+
+		policy := resp.Header.Get(fiber.HeaderContentSecurityPolicy)
+		assert.NotContains(t, policy, NoncePlaceholder, "Placeholder should be replaced")
+		assert.False(t, seen[policy], "Nonce should not repeat")
+		seen[policy] = true
+	}
+}
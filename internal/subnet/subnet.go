@@ -0,0 +1,231 @@
+// Package subnet plans EUI-64 addresses across the /64 subnets of a parent
+// prefix, such as the VLANs of a site under one /48 or /56. It derives each
+// subnet's /64 prefix from the parent prefix and a subnet ID, and computes the
+// host's address in it with the eui64 package.
+package subnet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/errcode"
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+)
+
+// Constants defining the sizes of planned subnets and subnet IDs.
+const (
+	subnetBits  = 64     // subnetBits is the prefix length of each planned subnet.
+	hextetBits  = 16     // hextetBits is the number of bits in a hextet.
+	hextetMask  = 0xFFFF // hextetMask masks the low hextet of a value.
+	idBase      = 16     // idBase is the base subnet IDs are written in, as in addresses.
+	idBitSize   = 64     // idBitSize is the maximum size of a subnet ID in bits.
+	rangeMarker = "-"    // rangeMarker separates the first and last IDs of a range.
+
+	// MaxSubnets is the maximum number of subnets in a plan, all those of a /56.
+	MaxSubnets = 256
+
+	// maxExpandedIDs is the maximum number of IDs a list expands to, duplicates
+	// included, so that repeated ranges cannot make parsing walk many times
+	// more IDs than a plan holds.
+	maxExpandedIDs = 4 * MaxSubnets
+)
+
+// Static error variables.
+var (
+	ErrParentRequired = errcode.New("subnet.parent_required", "a non-blank parent prefix is expected")
+	ErrInvalidParent  = errcode.New("subnet.parent_invalid", "invalid IPv6 parent prefix")
+	ErrParentTooLong  = errcode.New("subnet.parent_too_long", fmt.Sprintf("parent prefix must be shorter than /%d", subnetBits))
+	ErrIDsRequired    = errcode.New("subnet.ids_required", "at least one subnet ID is expected")
+	ErrInvalidID      = errcode.New("subnet.id_invalid", "invalid subnet ID")
+	ErrIDOutOfRange   = errcode.New("subnet.id_out_of_range", "subnet ID does not fit in the parent prefix")
+	ErrInvalidRange   = errcode.New("subnet.id_range", "subnet ID range ends before it starts")
+	ErrTooManySubnets = errcode.New("subnet.too_many_subnets", fmt.Sprintf("plan exceeds %d subnets", MaxSubnets))
+)
+
+// Subnet is a /64 subnet of a plan and the host's address in it.
+type Subnet struct {
+	ID      uint64       // ID is the subnet ID, the bits following the parent prefix.
+	Prefix  netip.Prefix // Prefix is the subnet's /64 prefix.
+	Address string       // Address is the host's EUI-64 address in the subnet.
+}
+
+// Plan holds the EUI-64 addresses of a host across the subnets of a parent prefix.
+type Plan struct {
+	InterfaceID string   // InterfaceID is the host's EUI-64 interface ID, the same in every subnet.
+	Subnets     []Subnet // Subnets are the planned subnets, in the order their IDs were given.
+}
+
+// ParseParent parses a parent prefix in CIDR notation, such as 2001:db8:1::/48.
+// The prefix must be IPv6 and shorter than /64, leaving room for subnet IDs;
+// bits set past its length are cleared.
+func ParseParent(parent string) (netip.Prefix, error) {
+	parent = strings.TrimSpace(parent)
+	if parent == "" {
+		return netip.Prefix{}, ErrParentRequired
+	}
+
+	prefix, err := netip.ParsePrefix(parent)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%w: %w", ErrInvalidParent, err)
+	}
+
+	if !prefix.Addr().Is6() {
+		return netip.Prefix{}, fmt.Errorf("%w: %q is not IPv6", ErrInvalidParent, parent)
+	}
+
+	if prefix.Bits() >= subnetBits {
+		return netip.Prefix{}, fmt.Errorf("%w, got /%d", ErrParentTooLong, prefix.Bits())
+	}
+
+	return prefix.Masked(), nil
+}
+
+// ParseIDs parses a list of subnet IDs of the parent prefix, separated by commas
+// or whitespace. Each entry is a hexadecimal ID, written as in the address, or
+// an inclusive range of IDs such as 10-1f. Duplicate IDs are dropped, and the
+// list may not expand to more than MaxSubnets distinct IDs, nor to more than
+// four times as many IDs including duplicates.
+func ParseIDs(ids string, parent netip.Prefix) ([]uint64, error) {
+	entries := strings.FieldsFunc(ids, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+	if len(entries) == 0 {
+		return nil, ErrIDsRequired
+	}
+
+	limit := maxID(parent)
+	seen := make(map[uint64]bool)
+	expanded := 0
+
+	var result []uint64
+
+	for _, entry := range entries {
+		first, last, err := parseEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		if last > limit {
+			return nil, fmt.Errorf("%w: %q exceeds %x", ErrIDOutOfRange, entry, limit)
+		}
+
+		if last-first >= uint64(maxExpandedIDs-expanded) {
+			return nil, fmt.Errorf("%w: list expands to more than %d IDs", ErrTooManySubnets, maxExpandedIDs)
+		}
+
+		expanded += int(last-first) + 1
+
+		for id := first; ; id++ {
+			if !seen[id] {
+				if len(result) == MaxSubnets {
+					return nil, ErrTooManySubnets
+				}
+
+				seen[id] = true
+				result = append(result, id)
+			}
+
+			if id == last {
+				break
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// Compute computes the host's EUI-64 address in each subnet of the parent
+// prefix with the given IDs, using the same calculation as a single address.
+func Compute(mac string, parent netip.Prefix, ids []uint64) (Plan, error) {
+	plan := Plan{InterfaceID: "", Subnets: make([]Subnet, 0, len(ids))}
+	limit := maxID(parent)
+
+	for _, id := range ids {
+		if id > limit {
+			return Plan{}, fmt.Errorf("%w: %x exceeds %x", ErrIDOutOfRange, id, limit)
+		}
+
+		prefix := subnetPrefix(parent, id)
+
+		interfaceID, address, err := eui64.CalculateEUI64(mac, Hextets(prefix))
+		if err != nil {
+			return Plan{}, fmt.Errorf("subnet %x: %w", id, err)
+		}
+
+		plan.InterfaceID = interfaceID
+		plan.Subnets = append(plan.Subnets, Subnet{ID: id, Prefix: prefix, Address: address})
+	}
+
+	return plan, nil
+}
+
+// parseEntry parses a subnet ID or range of IDs, returning the first and last ID.
+func parseEntry(entry string) (uint64, uint64, error) {
+	start, end, isRange := strings.Cut(entry, rangeMarker)
+
+	first, err := parseID(start)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if !isRange {
+		return first, first, nil
+	}
+
+	last, err := parseID(end)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if last < first {
+		return 0, 0, fmt.Errorf("%w: %q", ErrInvalidRange, entry)
+	}
+
+	return first, last, nil
+}
+
+// parseID parses a hexadecimal subnet ID.
+func parseID(id string) (uint64, error) {
+	value, err := strconv.ParseUint(id, idBase, idBitSize)
+	if err != nil {
+		return 0, fmt.Errorf("%w %q: %w", ErrInvalidID, id, err)
+	}
+
+	return value, nil
+}
+
+// maxID returns the largest subnet ID of the parent prefix, all of the bits
+// between its length and /64 set.
+func maxID(parent netip.Prefix) uint64 {
+	return uint64(1)<<(subnetBits-parent.Bits()) - 1
+}
+
+// subnetPrefix returns the /64 prefix of the subnet of the parent prefix with
+// the given ID, which fills the bits following the parent prefix.
+func subnetPrefix(parent netip.Prefix, id uint64) netip.Prefix {
+	addr := parent.Addr().As16()
+	network := binary.BigEndian.Uint64(addr[:8]) | id
+
+	var subnet [16]byte
+	binary.BigEndian.PutUint64(subnet[:8], network)
+
+	return netip.PrefixFrom(netip.AddrFrom16(subnet), subnetBits)
+}
+
+// Hextets returns the four hextets of a /64 prefix in the form accepted by the
+// EUI-64 calculation, such as 2001:db8:1:a.
+func Hextets(prefix netip.Prefix) string {
+	addr := prefix.Addr().As16()
+	network := binary.BigEndian.Uint64(addr[:8])
+
+	parts := make([]string, 0, subnetBits/hextetBits)
+	for shift := subnetBits - hextetBits; shift >= 0; shift -= hextetBits {
+		parts = append(parts, strconv.FormatUint(network>>shift&hextetMask, idBase))
+	}
+
+	return strings.Join(parts, ":")
+}
@@ -0,0 +1,144 @@
+package subnet
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+)
+
+// TestParseParent tests the ParseParent function with various parent prefixes.
+// It verifies that IPv6 prefixes shorter than /64 are accepted with any bits past
+// their length cleared, and that blank, malformed, IPv4, and /64 or longer
+// prefixes are rejected with the matching error.
+func TestParseParent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		parent  string
+		want    string
+		wantErr error
+	}{
+		{"Valid /48", "2001:db8:1::/48", "2001:db8:1::/48", nil},
+		{"Valid /56 with whitespace", " 2001:db8:1:ab00::/56 ", "2001:db8:1:ab00::/56", nil},
+		{"Host bits cleared", "2001:db8:1:ff::/56", "2001:db8:1::/56", nil},
+		{"Blank", "  ", "", ErrParentRequired},
+		{"Missing length", "2001:db8:1::", "", ErrInvalidParent},
+		{"Malformed", "2001:db8:g::/48", "", ErrInvalidParent},
+		{"IPv4", "192.0.2.0/24", "", ErrInvalidParent},
+		{"Length /64", "2001:db8:1:2::/64", "", ErrParentTooLong},
+		{"Length longer than /64", "2001:db8:1:2::/80", "", ErrParentTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseParent(tt.parent)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+// TestParseIDs tests the ParseIDs function with lists and ranges of subnet IDs.
+// It verifies that hexadecimal IDs and inclusive ranges separated by commas or
+// whitespace expand in order without duplicates, and that blank, malformed,
+// reversed, out of range, and oversized lists are rejected.
+func TestParseIDs(t *testing.T) {
+	t.Parallel()
+
+	slash48 := netip.MustParsePrefix("2001:db8:1::/48")
+	slash56 := netip.MustParsePrefix("2001:db8:1:ab00::/56")
+
+	tests := []struct {
+		name    string
+		ids     string
+		parent  netip.Prefix
+		want    []uint64
+		wantErr error
+	}{
+		{"Single ID", "a", slash48, []uint64{0xa}, nil},
+		{"List", "1, 10,ff", slash48, []uint64{0x1, 0x10, 0xff}, nil},
+		{"Whitespace separated", "1 2\t3\n4", slash48, []uint64{1, 2, 3, 4}, nil},
+		{"Range", "e-11", slash48, []uint64{0xe, 0xf, 0x10, 0x11}, nil},
+		{"Duplicates dropped", "3, 1-3, 2", slash48, []uint64{3, 1, 2}, nil},
+		{"Largest ID of a /48", "ffff", slash48, []uint64{0xffff}, nil},
+		{"Largest ID of a /56", "FF", slash56, []uint64{0xff}, nil},
+		{"Every subnet of a /56", "0-ff", slash56, nil, nil},
+		{"Blank", " , ", slash48, nil, ErrIDsRequired},
+		{"Not hexadecimal", "1, vlan10", slash48, nil, ErrInvalidID},
+		{"Open range", "1-", slash48, nil, ErrInvalidID},
+		{"Reversed range", "20-10", slash48, nil, ErrInvalidRange},
+		{"ID out of range of a /56", "100", slash56, nil, ErrIDOutOfRange},
+		{"Range out of range of a /48", "fff0-10000", slash48, nil, ErrIDOutOfRange},
+		{"Too many subnets", "0-100", slash48, nil, ErrTooManySubnets},
+		{"Repeated ranges", "0-ff 0-ff 0-ff 0-ff", slash56, nil, nil},
+		{"Too many repeated ranges", "0-ff 0-ff 0-ff 0-ff 0", slash56, nil, ErrTooManySubnets},
+		{"Range wider than any plan", "0-ffffffffffffffff", netip.MustParsePrefix("::/0"), nil, ErrTooManySubnets},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseIDs(tt.ids, tt.parent)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			if tt.want == nil {
+				assert.Len(t, got, MaxSubnets)
+
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestCompute tests the Compute function, verifying that each subnet's /64 is
+// derived from the parent prefix and subnet ID, that its address matches the
+// single address calculation for that /64, and that invalid input is rejected.
+func TestCompute(t *testing.T) {
+	t.Parallel()
+
+	const mac = "00-14-22-01-23-45"
+
+	plan, err := Compute(mac, netip.MustParsePrefix("2001:db8:1:ab00::/56"), []uint64{0x0, 0xa, 0xff})
+	require.NoError(t, err)
+
+	assert.Equal(t, "0214:22ff:fe01:2345", plan.InterfaceID)
+	assert.Equal(t, []Subnet{
+		{ID: 0x0, Prefix: netip.MustParsePrefix("2001:db8:1:ab00::/64"), Address: "2001:db8:1:ab00:214:22ff:fe01:2345"},
+		{ID: 0xa, Prefix: netip.MustParsePrefix("2001:db8:1:ab0a::/64"), Address: "2001:db8:1:ab0a:214:22ff:fe01:2345"},
+		{ID: 0xff, Prefix: netip.MustParsePrefix("2001:db8:1:abff::/64"), Address: "2001:db8:1:abff:214:22ff:fe01:2345"},
+	}, plan.Subnets)
+
+	for _, subnet := range plan.Subnets {
+		_, want, err := eui64.CalculateEUI64(mac, Hextets(subnet.Prefix))
+		require.NoError(t, err)
+		assert.Equal(t, want, subnet.Address, "Address should match the single address calculation")
+	}
+
+	_, err = Compute("invalid", netip.MustParsePrefix("2001:db8:1::/48"), []uint64{1})
+	require.ErrorIs(t, err, eui64.ErrParseMAC)
+
+	_, err = Compute(mac, netip.MustParsePrefix("2001:db8:1:ab00::/56"), []uint64{0x100})
+	require.ErrorIs(t, err, ErrIDOutOfRange)
+}
@@ -0,0 +1,320 @@
+package ui
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/a-h/templ"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/classify"
+)
+
+// idReferenceAttributes lists the attributes whose values are space-separated
+// ids of other elements in the document.
+var idReferenceAttributes = []string{
+	"for",
+	"aria-controls",
+	"aria-describedby",
+	"aria-errormessage",
+	"aria-labelledby",
+}
+
+// renderPage renders the home page content with the given results swapped into
+// the result container, as the page appears after a calculation.
+func renderPage(t *testing.T, results ...templ.Component) *goquery.Document {
+	t.Helper()
+
+	doc := parseHTML(t, renderToString(t, HomeContent()))
+
+	for _, result := range results {
+		doc.Find(".result-container").AppendHtml(renderToString(t, result))
+	}
+
+	return doc
+}
+
+// TestAccessibilityReferences verifies that every id referenced by a label or
+// ARIA attribute resolves to exactly one element, before and after results are
+// rendered, so assistive technologies never follow a dangling reference.
+func TestAccessibilityReferences(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		result   *ResultData
+		optional []string
+	}{
+		{
+			name:   "Empty form",
+			result: nil,
+			optional: []string{
+				ErrorMessageID, PlanErrorMessageID, MatrixErrorMessageID, ULAErrorMessageID,
+				VerifyErrorMessageID, VerifyCSVErrorMessageID, ImportErrorMessageID, RAErrorMessageID,
+			},
+		},
+		{
+			name: "Successful calculation",
+			result: &ResultData{
+				InterfaceID:    "0214:22ff:fe01:2345",
+				FullIP:         "2001:db8::214:22ff:fe01:2345",
+				Prefix:         classify.Classification{},
+				DUIDLL:         "",
+				DUIDLLT:        "",
+				Error:          "",
+				ErrorField:     "",
+				ErrorHighlight: nil,
+			},
+			optional: []string{
+				ErrorMessageID, PlanErrorMessageID, MatrixErrorMessageID, ULAErrorMessageID,
+				VerifyErrorMessageID, VerifyCSVErrorMessageID, ImportErrorMessageID, RAErrorMessageID,
+			},
+		},
+		{
+			name: "Invalid MAC address",
+			result: &ResultData{
+				InterfaceID:    "",
+				FullIP:         "",
+				Prefix:         classify.Classification{},
+				DUIDLL:         "",
+				DUIDLLT:        "",
+				Error:          "Invalid MAC address",
+				ErrorField:     FieldMAC,
+				ErrorHighlight: nil,
+			},
+			optional: []string{
+				PlanErrorMessageID, MatrixErrorMessageID, ULAErrorMessageID,
+				VerifyErrorMessageID, VerifyCSVErrorMessageID, ImportErrorMessageID, RAErrorMessageID,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var results []templ.Component
+			if tt.result != nil {
+				results = append(results, Result(*tt.result))
+			}
+
+			doc := renderPage(t, results...)
+
+			for _, attr := range idReferenceAttributes {
+				doc.Find("[" + attr + "]").Each(func(_ int, s *goquery.Selection) {
+					for id := range strings.FieldsSeq(s.AttrOr(attr, "")) {
+						count := doc.Find("#" + id).Length()
+						if count == 0 && slices.Contains(tt.optional, id) {
+							// aria-errormessage is ignored until the field is invalid.
+							continue
+						}
+
+						assert.Equal(t, 1, count, "%s=%q should reference exactly one element", attr, id)
+					}
+				})
+			}
+		})
+	}
+}
+
+// TestLiveRegions verifies that results and errors are announced: the result
+// container is a polite, atomic live region that is present (not hidden) from
+// the start, and a status region exists for transient announcements.
+func TestLiveRegions(t *testing.T) {
+	t.Parallel()
+
+	doc := renderPage(t)
+
+	container := doc.Find(".result-container")
+	require.Equal(t, 1, container.Length(), "Result container not found")
+	assert.Equal(t, "polite", container.AttrOr("aria-live", ""), "Incorrect result aria-live")
+	assert.Equal(t, "true", container.AttrOr("aria-atomic", ""), "Incorrect result aria-atomic")
+	assert.Equal(t, 0, doc.Find(".hidden").Length(), "Live regions must not start hidden")
+
+	announcer := doc.Find("#announcer")
+	require.Equal(t, 1, announcer.Length(), "Announcer not found")
+	assert.Equal(t, "status", announcer.AttrOr("role", ""), "Incorrect announcer role")
+	assert.True(t, announcer.HasClass("visually-hidden"), "Announcer should be visually hidden")
+}
+
+// TestResultErrorAccessibility verifies that errors are rendered as alerts with
+// the id referenced by the form fields' aria-errormessage, and name the field
+// they refer to so the client can mark it with aria-invalid.
+func TestResultErrorAccessibility(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		errorField string
+	}{
+		{name: "MAC address error", errorField: FieldMAC},
+		{name: "IPv6 prefix error", errorField: FieldIPv6Prefix},
+		{name: "Error without a field", errorField: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc := renderPage(t, Result(ResultData{
+				InterfaceID:    "",
+				FullIP:         "",
+				Prefix:         classify.Classification{},
+				DUIDLL:         "",
+				DUIDLLT:        "",
+				Error:          "Something went wrong",
+				ErrorField:     tt.errorField,
+				ErrorHighlight: nil,
+			}))
+
+			message := doc.Find(".result-container p.error-message")
+			require.Equal(t, 1, message.Length(), "Error message not found")
+			assert.Equal(t, ErrorMessageID, message.AttrOr("id", ""), "Incorrect error id")
+			assert.Equal(t, "alert", message.AttrOr("role", ""), "Incorrect error role")
+
+			field, ok := message.Attr("data-error-field")
+			if tt.errorField == "" {
+				assert.False(t, ok, "Error without a field should not name one")
+
+				return
+			}
+
+			assert.Equal(t, tt.errorField, field, "Incorrect error field")
+
+			input := doc.Find("form input#" + field)
+			require.Equal(t, 1, input.Length(), "Error field %q not found in form", field)
+			assert.Equal(
+				t,
+				ErrorMessageID,
+				input.AttrOr("aria-errormessage", ""),
+				"Error field should reference the error message",
+			)
+		})
+	}
+}
+
+// TestKeyboardShortcuts verifies that every documented keyboard shortcut is
+// declared through aria-keyshortcuts by exactly one element and listed, key by
+// key, in the shortcuts help.
+func TestKeyboardShortcuts(t *testing.T) {
+	t.Parallel()
+
+	doc := renderPage(t, Result(ResultData{
+		InterfaceID:    "0214:22ff:fe01:2345",
+		FullIP:         "2001:db8::214:22ff:fe01:2345",
+		Prefix:         classify.Classification{},
+		DUIDLL:         "",
+		DUIDLLT:        "",
+		Error:          "",
+		ErrorField:     "",
+		ErrorHighlight: nil,
+	}))
+
+	tests := []struct {
+		shortcut string
+		target   string
+	}{
+		{shortcut: shortcutFocusMAC, target: "input#mac"},
+		{shortcut: shortcutFocusPrefix, target: "input#ip-start"},
+		{shortcut: shortcutCopyResult, target: "button#copy-ip-full"},
+		{shortcut: shortcutClear, target: "button.form-clear[type='reset']"},
+	}
+
+	require.Len(t, keyboardShortcuts, len(tests), "Every shortcut should be tested")
+
+	terms := doc.Find("details.keyboard-shortcuts dt")
+	require.Equal(t, len(tests), terms.Length(), "Incorrect number of documented shortcuts")
+
+	for i, tt := range tests {
+		t.Run(tt.shortcut, func(t *testing.T) {
+			t.Parallel()
+
+			declared := doc.Find(`[aria-keyshortcuts="` + tt.shortcut + `"]`)
+			require.Equal(t, 1, declared.Length(), "Shortcut should be declared once")
+			assert.True(t, declared.Is(tt.target), "Shortcut declared on the wrong element")
+
+			keys := terms.Eq(i).Find("kbd").Map(func(_ int, s *goquery.Selection) string {
+				return s.Text()
+			})
+			assert.Equal(t, strings.Split(tt.shortcut, "+"), keys, "Incorrect documented keys")
+			assert.NotEmpty(
+				t,
+				strings.TrimSpace(terms.Eq(i).Next().Text()),
+				"Shortcut should have a description",
+			)
+		})
+	}
+}
+
+// TestAccessibleNames verifies that every form control and button has an
+// accessible name, and that decorative icons are hidden from assistive
+// technologies.
+func TestAccessibleNames(t *testing.T) {
+	t.Parallel()
+
+	doc := renderPage(t, Result(ResultData{
+		InterfaceID:    "0214:22ff:fe01:2345",
+		FullIP:         "2001:db8::214:22ff:fe01:2345",
+		Prefix:         classify.Classification{},
+		DUIDLL:         "",
+		DUIDLLT:        "",
+		Error:          "",
+		ErrorField:     "",
+		ErrorHighlight: nil,
+	}))
+
+	doc.Find("input:not([type='hidden']), select").Each(func(_ int, s *goquery.Selection) {
+		id := s.AttrOr("id", "")
+		labelled := id != "" && doc.Find(`label[for="`+id+`"]`).Length() == 1
+
+		assert.True(t, labelled || s.AttrOr("aria-label", "") != "", "Control %q has no label", id)
+	})
+
+	doc.Find("button").Each(func(_ int, s *goquery.Selection) {
+		name := s.AttrOr("aria-label", strings.TrimSpace(s.Text()))
+
+		assert.NotEmpty(t, name, "Button %q has no accessible name", s.AttrOr("id", ""))
+		assert.NotEmpty(t, s.AttrOr("type", ""), "Button %q has no type", s.AttrOr("id", ""))
+	})
+
+	doc.Find("svg").Each(func(_ int, s *goquery.Selection) {
+		assert.Equal(t, "true", s.AttrOr("aria-hidden", ""), "Decorative icon should be hidden")
+		assert.Equal(t, "false", s.AttrOr("focusable", ""), "Decorative icon should not be focusable")
+	})
+}
+
+// TestAnalyzerAccessibility verifies that the analyzer page's ARIA references
+// resolve, before and after an error is rendered, and that its controls are named.
+func TestAnalyzerAccessibility(t *testing.T) {
+	t.Parallel()
+
+	noDUID := DUIDData{DUID: "", Facts: nil, Error: ""}
+
+	for _, data := range []AnalysisData{
+		{Address: "", Facts: nil, Error: "", DUID: noDUID},
+		{Address: "192.0.2.1", Facts: nil, Error: "Not IPv6", DUID: noDUID},
+		{Address: "", Facts: nil, Error: "", DUID: DUIDData{DUID: "00:09", Facts: nil, Error: "Unknown type"}},
+	} {
+		doc := parseHTML(t, renderToString(t, AnalyzerContent(data)))
+
+		for _, attr := range idReferenceAttributes {
+			doc.Find("[" + attr + "]").Each(func(_ int, s *goquery.Selection) {
+				for id := range strings.FieldsSeq(s.AttrOr(attr, "")) {
+					if id == AnalyzerErrorMessageID && data.Error == "" || id == DUIDErrorMessageID && data.DUID.Error == "" {
+						continue // aria-errormessage is ignored until the field is invalid.
+					}
+
+					assert.Equal(t, 1, doc.Find("#"+id).Length(), "%s=%q should reference exactly one element", attr, id)
+				}
+			})
+		}
+
+		doc.Find("input").Each(func(_ int, s *goquery.Selection) {
+			id := s.AttrOr("id", "")
+			assert.Equal(t, 1, doc.Find(`label[for="`+id+`"]`).Length(), "Control %q has no label", id)
+		})
+	}
+}
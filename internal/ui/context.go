@@ -0,0 +1,118 @@
+package ui
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/a-h/templ"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/i18n"
+)
+
+// AssetResolver maps a static asset name to the URL it is served from.
+type AssetResolver interface {
+	// Path returns the URL of the named asset (e.g., "styles.css").
+	Path(name string) string
+}
+
+// LocaleURLFunc returns the URL of the current page in the locale with the given tag.
+type LocaleURLFunc func(tag string) string
+
+// contextKey is the type of keys for values the templates read from the render context.
+type contextKey int
+
+// Context keys for values passed to the templates.
+const (
+	csrfTokenKey     contextKey = iota // csrfTokenKey holds the CSRF token for forms.
+	assetResolverKey                   // assetResolverKey holds the AssetResolver for asset URLs.
+	pwaKey                             // pwaKey reports whether the offline-capable client is served.
+	localeURLKey                       // localeURLKey holds the LocaleURLFunc for the language selector.
+)
+
+// staticPrefix is the URL prefix of static assets when no AssetResolver is set.
+const staticPrefix = "/static/"
+
+// CSRFHeader is the request header HTMX uses to send the CSRF token.
+const CSRFHeader = "X-Csrf-Token"
+
+// CSRFField is the form field carrying the CSRF token for non-HTMX submissions.
+const CSRFField = "_csrf"
+
+// WithCSRFToken returns a copy of ctx carrying the CSRF token that forms should submit.
+func WithCSRFToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, csrfTokenKey, token)
+}
+
+// CSRFToken returns the CSRF token carried by ctx, or an empty string if none is set.
+func CSRFToken(ctx context.Context) string {
+	token, _ := ctx.Value(csrfTokenKey).(string)
+
+	return token
+}
+
+// WithAssetResolver returns a copy of ctx carrying the resolver used to build asset URLs.
+func WithAssetResolver(ctx context.Context, resolver AssetResolver) context.Context {
+	return context.WithValue(ctx, assetResolverKey, resolver)
+}
+
+// WithPWA returns a copy of ctx recording whether the offline-capable client,
+// with its service worker, web manifest, and WebAssembly calculator, is served.
+func WithPWA(ctx context.Context, enabled bool) context.Context {
+	return context.WithValue(ctx, pwaKey, enabled)
+}
+
+// PWAEnabled reports whether ctx records that the offline-capable client is served.
+func PWAEnabled(ctx context.Context) bool {
+	enabled, _ := ctx.Value(pwaKey).(bool)
+
+	return enabled
+}
+
+// WithLocaleURL returns a copy of ctx carrying the function building the URLs
+// the language selector links each locale to.
+func WithLocaleURL(ctx context.Context, localeURL LocaleURLFunc) context.Context {
+	return context.WithValue(ctx, localeURLKey, localeURL)
+}
+
+// T returns the message for key in the locale carried by ctx, see i18n.Locale.T.
+func T(ctx context.Context, key i18n.Key, args ...any) string {
+	return i18n.FromContext(ctx).T(key, args...)
+}
+
+// localeURL returns the URL of the current page in the locale with the given
+// tag using the function carried by ctx, or the query selecting the locale on
+// the server if none is set.
+func localeURL(ctx context.Context, tag string) string {
+	if build, ok := ctx.Value(localeURLKey).(LocaleURLFunc); ok && build != nil {
+		return build(tag)
+	}
+
+	return "?" + i18n.QueryParam + "=" + url.QueryEscape(tag)
+}
+
+// assetPath returns the URL of the named static asset using the resolver carried
+// by ctx, or the unversioned path under /static/ if none is set.
+func assetPath(ctx context.Context, name string) string {
+	if resolver, ok := ctx.Value(assetResolverKey).(AssetResolver); ok && resolver != nil {
+		return resolver.Path(name)
+	}
+
+	return staticPrefix + name
+}
+
+// csrfAttributes returns the hx-headers attribute that makes HTMX send the CSRF
+// token with every request from the element, or no attributes if ctx has no token.
+func csrfAttributes(ctx context.Context) templ.Attributes {
+	token := CSRFToken(ctx)
+	if token == "" {
+		return templ.Attributes{}
+	}
+
+	headers, err := json.Marshal(map[string]string{CSRFHeader: token})
+	if err != nil {
+		return templ.Attributes{}
+	}
+
+	return templ.Attributes{"hx-headers": string(headers)}
+}
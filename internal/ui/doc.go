@@ -3,7 +3,7 @@
 // It defines layouts, forms, and result displays using the templ templating language,
 // which are rendered in response to HTTP requests.
 //
-// The package includes components such as Home, HomeContent, Layout, and Result,
+// The package includes components such as Home, HomeContent, Layout, Result, and PlanResult,
 // which are used to generate HTML for the application's user interface.
 //
 // Generated files (e.g., *_templ.go) are created by the templ tool and should not be edited manually.
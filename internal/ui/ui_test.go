@@ -3,12 +3,22 @@ package ui
 import (
 	"bytes"
 	"context"
+	"net/netip"
+	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/a-h/templ"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/classify"
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+	"github.com/nicholas-fedor/eui64-calculator/internal/i18n"
+	"github.com/nicholas-fedor/eui64-calculator/internal/importer"
+	"github.com/nicholas-fedor/eui64-calculator/internal/macrange"
+	"github.com/nicholas-fedor/eui64-calculator/internal/verify"
 )
 
 // renderToString renders a templ.Component to a string for testing.
@@ -76,7 +86,7 @@ func TestHomeContent(t *testing.T) {
 			assert.Equal(
 				t,
 				1,
-				doc.Find("form[hx-swap='innerHTML']").Length(),
+				doc.Find("form[hx-post='/calculate'][hx-swap='innerHTML']").Length(),
 				"Form hx-swap not found",
 			)
 			assert.Equal(
@@ -91,11 +101,10 @@ func TestHomeContent(t *testing.T) {
 				doc.Find("input#mac").AttrOr("placeholder", ""),
 				"Incorrect MAC input placeholder",
 			)
-			assert.Equal(
+			assert.False(
 				t,
-				"[0-9a-fA-F]{2}([-:][0-9a-fA-F]{2}){5}",
-				doc.Find("input#mac").AttrOr("pattern", ""),
-				"Incorrect MAC pattern",
+				doc.Find("input#mac").Is("[pattern]"),
+				"MAC input should leave validation to the validators",
 			)
 			assert.Equal(
 				t,
@@ -105,7 +114,7 @@ func TestHomeContent(t *testing.T) {
 			)
 			assert.Equal(
 				t,
-				"mac-copy",
+				"mac-hint mac-message",
 				doc.Find("input#mac").AttrOr("aria-describedby", ""),
 				"Incorrect MAC aria-describedby",
 			)
@@ -123,33 +132,27 @@ func TestHomeContent(t *testing.T) {
 			)
 			assert.Equal(
 				t,
-				"ip-start-copy",
+				"ip-start-hint ip-start-message",
 				doc.Find("input#ip-start").AttrOr("aria-describedby", ""),
 				"Incorrect IP aria-describedby",
 			)
 			assert.Equal(
 				t,
 				"Calculate",
-				doc.Find("button.form-submit").Text(),
+				doc.Find("form[hx-post='/calculate'] button.form-submit").Text(),
 				"Incorrect submit button text",
 			)
 			assert.Equal(
 				t,
 				"Clear",
-				doc.Find("button.form-clear").Text(),
+				doc.Find("form[hx-post='/calculate'] button.form-clear").Text(),
 				"Incorrect reset button text",
 			)
 			assert.Equal(
 				t,
 				1,
-				doc.Find("div.form-results.hidden").Length(),
-				"Form results div not found or not hidden",
-			)
-			assert.Equal(
-				t,
-				1,
-				doc.Find("div.result-container.hidden").Length(),
-				"Result container div not found or not hidden",
+				doc.Find("div.form-results div.result-container:empty").Length(),
+				"Empty result container not found",
 			)
 
 			// Test copy buttons for input fields
@@ -202,29 +205,20 @@ func TestHomeContent(t *testing.T) {
 				"IPv6 Prefix copy tooltip not found",
 			)
 
-			// Verify copy button event listeners
+			// Verify copy buttons are wired through data attributes rather than inline scripts
 			assert.Equal(
 				t,
-				1,
-				doc.Find("script").FilterFunction(func(_ int, s *goquery.Selection) bool {
-					return strings.Contains(
-						s.Text(),
-						`document.getElementById("copy-mac").addEventListener("click"`,
-					)
-				}).Length(),
-				"MAC copy button event listener not found",
+				"mac",
+				macCopyBtn.AttrOr("data-copy-target", ""),
+				"Incorrect MAC copy button target",
 			)
 			assert.Equal(
 				t,
-				1,
-				doc.Find("script").FilterFunction(func(_ int, s *goquery.Selection) bool {
-					return strings.Contains(
-						s.Text(),
-						`document.getElementById("copy-ip-start").addEventListener("click"`,
-					)
-				}).Length(),
-				"IPv6 Prefix copy button event listener not found",
+				"ip-start",
+				ipStartCopyBtn.AttrOr("data-copy-target", ""),
+				"Incorrect IPv6 Prefix copy button target",
 			)
+			assert.Equal(t, 0, doc.Find("script").Length(), "Inline scripts should not be present")
 
 			// Check that unwanted content is not present
 			assert.Equal(
@@ -237,6 +231,129 @@ func TestHomeContent(t *testing.T) {
 	}
 }
 
+// TestHomeContentCSRF verifies that HomeContent embeds the CSRF token from the
+// render context in a hidden form field and in the hx-headers sent by HTMX, and
+// renders neither when no token is set.
+func TestHomeContentCSRF(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{
+			name:  "Token set on context",
+			token: "csrf-token-value",
+		},
+		{
+			name:  "No token on context",
+			token: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			ctx := WithCSRFToken(context.Background(), tt.token)
+			if err := HomeContent().Render(ctx, &buf); err != nil {
+				t.Fatalf("Failed to render template: %v", err)
+			}
+
+			doc := parseHTML(t, buf.String())
+			hidden := doc.Find("form input[type='hidden'][name='" + CSRFField + "']")
+			headers, hasHeaders := doc.Find("form").Attr("hx-headers")
+
+			if tt.token == "" {
+				assert.Equal(t, 0, hidden.Length(), "Hidden CSRF field should not be present")
+				assert.False(t, hasHeaders, "hx-headers should not be present")
+
+				return
+			}
+
+			assert.Equal(t, tt.token, hidden.AttrOr("value", ""), "Incorrect hidden CSRF field value")
+			assert.JSONEq(t, `{"`+CSRFHeader+`":"`+tt.token+`"}`, headers, "Incorrect hx-headers")
+		})
+	}
+}
+
+// TestHomeContentLinkType verifies that the calculator offers every link type,
+// Ethernet first, and that the MAC address field is validated as an address of
+// the selected link type.
+func TestHomeContentLinkType(t *testing.T) {
+	t.Parallel()
+
+	doc := parseHTML(t, renderToString(t, HomeContent()))
+
+	options := doc.Find("form select#" + FieldLinkType + " option")
+	require.Equal(t, len(eui64.LinkTypes), options.Length(), "Incorrect number of link types")
+	options.Each(func(i int, option *goquery.Selection) {
+		assert.Equal(t, string(eui64.LinkTypes[i]), option.AttrOr("value", ""), "Incorrect link type")
+		assert.NotEmpty(t, strings.TrimSpace(option.Text()), "Link type should be named")
+	})
+	assert.Equal(t, "Ethernet (48-bit MAC address)", options.First().Text())
+
+	input := doc.Find("form input#" + FieldMAC)
+	assert.Equal(t, "#"+FieldLinkType, input.AttrOr("hx-include", ""), "Validation should include the link type")
+	assert.Equal(t, "23", input.AttrOr("maxlength", ""), "Field should fit extended addresses")
+}
+
+// TestHomeContentLiveValidation verifies that each form field requests its own
+// validation as the user types and that the response is swapped into the
+// field's inline message, which describes the field.
+func TestHomeContentLiveValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		field   string
+		path    string
+		trigger string
+	}{
+		{field: FieldMAC, path: "/validate/mac", trigger: macValidationTrigger},
+		{field: FieldIPv6Prefix, path: "/validate/ip-start", trigger: validationTrigger},
+	}
+
+	doc := parseHTML(t, renderToString(t, HomeContent()))
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			t.Parallel()
+
+			input := doc.Find("form input#" + tt.field)
+			assert.Equal(t, tt.path, input.AttrOr("hx-get", ""), "Incorrect hx-get")
+			assert.Equal(t, tt.trigger, input.AttrOr("hx-trigger", ""), "Incorrect hx-trigger")
+			assert.Equal(t, "#"+FieldMessageID(tt.field), input.AttrOr("hx-target", ""), "Incorrect hx-target")
+			assert.Contains(
+				t,
+				strings.Fields(input.AttrOr("aria-describedby", "")),
+				FieldMessageID(tt.field),
+				"Field should be described by its inline message",
+			)
+
+			message := doc.Find("#" + FieldMessageID(tt.field))
+			assert.Equal(t, 1, message.Length(), "Inline message not found")
+			assert.Equal(t, tt.field, message.AttrOr("data-field-message", ""), "Incorrect data-field-message")
+			assert.Equal(t, "polite", message.AttrOr("aria-live", ""), "Inline message should be a live region")
+			assert.Empty(t, message.Text(), "Inline message should be empty initially")
+		})
+	}
+}
+
+// TestFieldMessage verifies that inline validation messages are rendered as
+// escaped text.
+func TestFieldMessage(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, renderToString(t, FieldMessage("")))
+	assert.Equal(
+		t,
+		"The MAC address is &lt;invalid&gt;",
+		renderToString(t, FieldMessage("The MAC address is <invalid>")),
+	)
+}
+
 func TestHome(t *testing.T) {
 	t.Parallel()
 
@@ -301,21 +418,20 @@ func TestHome(t *testing.T) {
 			assert.Equal(
 				t,
 				1,
-				doc.Find("script[src='https://unpkg.com/htmx.org@2.0.4']").Length(),
-				"HTMX script not found",
+				doc.Find("script[src='/static/htmx.min.js']").Length(),
+				"Vendored HTMX script not found",
 			)
 			assert.Equal(
 				t,
-				"sha384-HGfztofotfshcF7+8n44JQL2oJmowVChPTg48S+jvZoztPfvwD79OC/LTtG6dMp+",
-				doc.Find("script[src='https://unpkg.com/htmx.org@2.0.4']").AttrOr("integrity", ""),
-				"Incorrect HTMX integrity",
+				0,
+				doc.Find("script[src*='unpkg.com']").Length(),
+				"CDN scripts should not be present",
 			)
 			assert.Equal(
 				t,
-				"anonymous",
-				doc.Find("script[src='https://unpkg.com/htmx.org@2.0.4']").
-					AttrOr("crossorigin", ""),
-				"Incorrect HTMX crossorigin",
+				1,
+				doc.Find("script[src='/static/app.js']").Length(),
+				"Application script not found",
 			)
 			assert.Equal(
 				t,
@@ -331,25 +447,9 @@ func TestHome(t *testing.T) {
 			)
 			assert.Equal(
 				t,
-				1,
-				doc.Find("script").FilterFunction(func(_ int, s *goquery.Selection) bool {
-					return strings.Contains(
-						s.Text(),
-						"document.body.addEventListener('htmx:afterSwap'",
-					)
-				}).Length(),
-				"HTMX afterSwap script not found",
-			)
-			assert.Equal(
-				t,
-				1,
-				doc.Find("script").FilterFunction(func(_ int, s *goquery.Selection) bool {
-					return strings.Contains(
-						s.Text(),
-						"function copyToClipboard(elementId, buttonId)",
-					)
-				}).Length(),
-				"copyToClipboard script not found",
+				0,
+				doc.Find("script:not([src])").Length(),
+				"Inline scripts should not be present",
 			)
 			assert.Equal(
 				t,
@@ -429,21 +529,20 @@ func TestLayout(t *testing.T) {
 			assert.Equal(
 				t,
 				1,
-				doc.Find("script[src='https://unpkg.com/htmx.org@2.0.4']").Length(),
-				"HTMX script not found",
+				doc.Find("script[src='/static/htmx.min.js']").Length(),
+				"Vendored HTMX script not found",
 			)
 			assert.Equal(
 				t,
-				"sha384-HGfztofotfshcF7+8n44JQL2oJmowVChPTg48S+jvZoztPfvwD79OC/LTtG6dMp+",
-				doc.Find("script[src='https://unpkg.com/htmx.org@2.0.4']").AttrOr("integrity", ""),
-				"Incorrect HTMX integrity",
+				0,
+				doc.Find("script[src*='unpkg.com']").Length(),
+				"CDN scripts should not be present",
 			)
 			assert.Equal(
 				t,
-				"anonymous",
-				doc.Find("script[src='https://unpkg.com/htmx.org@2.0.4']").
-					AttrOr("crossorigin", ""),
-				"Incorrect HTMX crossorigin",
+				1,
+				doc.Find("script[src='/static/app.js']").Length(),
+				"Application script not found",
 			)
 			assert.Equal(
 				t,
@@ -459,25 +558,9 @@ func TestLayout(t *testing.T) {
 			)
 			assert.Equal(
 				t,
-				1,
-				doc.Find("script").FilterFunction(func(_ int, s *goquery.Selection) bool {
-					return strings.Contains(
-						s.Text(),
-						"document.body.addEventListener('htmx:afterSwap'",
-					)
-				}).Length(),
-				"HTMX afterSwap script not found",
-			)
-			assert.Equal(
-				t,
-				1,
-				doc.Find("script").FilterFunction(func(_ int, s *goquery.Selection) bool {
-					return strings.Contains(
-						s.Text(),
-						"function copyToClipboard(elementId, buttonId)",
-					)
-				}).Length(),
-				"copyToClipboard script not found",
+				0,
+				doc.Find("script:not([src])").Length(),
+				"Inline scripts should not be present",
 			)
 			assert.Equal(
 				t,
@@ -489,6 +572,56 @@ func TestLayout(t *testing.T) {
 	}
 }
 
+// TestLayoutNonce verifies that Layout attaches the Content Security Policy nonce
+// from the render context to every script element, and omits the attribute when
+// no nonce is set.
+func TestLayoutNonce(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		nonce     string
+		wantNonce bool
+	}{
+		{
+			name:      "Nonce set on context",
+			nonce:     "dGVzdC1ub25jZQ==",
+			wantNonce: true,
+		},
+		{
+			name:      "No nonce on context",
+			nonce:     "",
+			wantNonce: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			ctx := templ.WithNonce(context.Background(), tt.nonce)
+			if err := Layout("Test Title", HomeContent()).Render(ctx, &buf); err != nil {
+				t.Fatalf("Failed to render template: %v", err)
+			}
+
+			doc := parseHTML(t, buf.String())
+			scripts := doc.Find("script")
+			assert.Equal(t, 3, scripts.Length(), "Unexpected number of scripts")
+
+			scripts.Each(func(_ int, script *goquery.Selection) {
+				nonce, ok := script.Attr("nonce")
+				assert.Equal(t, tt.wantNonce, ok, "Nonce attribute presence")
+
+				if tt.wantNonce {
+					assert.Equal(t, tt.nonce, nonce, "Incorrect nonce")
+				}
+			})
+		})
+	}
+}
+
 func TestResult(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -499,9 +632,14 @@ func TestResult(t *testing.T) {
 		{
 			name: "Result template with success data",
 			data: ResultData{
-				InterfaceID: "0214:22ff:fe01:2345",
-				FullIP:      "2001:0db8:85a3:0000:0214:22ff:fe01:2345",
-				Error:       "",
+				InterfaceID:    "0214:22ff:fe01:2345",
+				FullIP:         "2001:0db8:85a3:0000:0214:22ff:fe01:2345",
+				Prefix:         classify.Classification{},
+				DUIDLL:         "",
+				DUIDLLT:        "",
+				Error:          "",
+				ErrorField:     "",
+				ErrorHighlight: nil,
 			},
 			assertDoc: func(t *testing.T, doc *goquery.Document) {
 				t.Helper()
@@ -517,12 +655,6 @@ func TestResult(t *testing.T) {
 					doc.Find("input#interface-id").AttrOr("value", ""),
 					"Incorrect interface ID value",
 				)
-				assert.Equal(
-					t,
-					"interface-id-copy",
-					doc.Find("input#interface-id").AttrOr("aria-describedby", ""),
-					"Incorrect interface ID aria-describedby",
-				)
 				assert.Equal(
 					t,
 					"IPv6 Address",
@@ -535,12 +667,6 @@ func TestResult(t *testing.T) {
 					doc.Find("input#ip-full").AttrOr("value", ""),
 					"Incorrect full IP value",
 				)
-				assert.Equal(
-					t,
-					"ip-full-copy",
-					doc.Find("input#ip-full").AttrOr("aria-describedby", ""),
-					"Incorrect full IP aria-describedby",
-				)
 				assert.Equal(
 					t,
 					0,
@@ -591,37 +717,33 @@ func TestResult(t *testing.T) {
 					"IPv6 Address copy tooltip not found",
 				)
 
-				// Verify copy button event listeners
+				// Verify copy buttons are wired through data attributes rather than inline scripts
 				assert.Equal(
 					t,
-					1,
-					doc.Find("script").FilterFunction(func(_ int, s *goquery.Selection) bool {
-						return strings.Contains(
-							s.Text(),
-							`document.getElementById("copy-interface").addEventListener("click"`,
-						)
-					}).Length(),
-					"Interface ID copy button event listener not found",
+					"interface-id",
+					interfaceCopyBtn.AttrOr("data-copy-target", ""),
+					"Incorrect Interface ID copy button target",
 				)
 				assert.Equal(
 					t,
-					1,
-					doc.Find("script").FilterFunction(func(_ int, s *goquery.Selection) bool {
-						return strings.Contains(
-							s.Text(),
-							`document.getElementById("copy-ip-full").addEventListener("click"`,
-						)
-					}).Length(),
-					"IPv6 Address copy button event listener not found",
+					"ip-full",
+					fullIPCopyBtn.AttrOr("data-copy-target", ""),
+					"Incorrect IPv6 Address copy button target",
 				)
+				assert.Equal(t, 0, doc.Find("script").Length(), "Inline scripts should not be present")
 			},
 		},
 		{
 			name: "Result template with error data",
 			data: ResultData{
-				InterfaceID: "",
-				FullIP:      "",
-				Error:       "Invalid MAC address",
+				InterfaceID:    "",
+				FullIP:         "",
+				Prefix:         classify.Classification{},
+				DUIDLL:         "",
+				DUIDLLT:        "",
+				Error:          "Invalid MAC address",
+				ErrorField:     FieldMAC,
+				ErrorHighlight: nil,
 			},
 			assertDoc: func(t *testing.T, doc *goquery.Document) {
 				t.Helper()
@@ -668,3 +790,859 @@ func TestResult(t *testing.T) {
 		})
 	}
 }
+
+// TestResultPrefixClassification verifies that a result shows the type of the
+// entered prefix, with a warning only when the classification carries one, and
+// that both are rendered hidden for unclassified prefixes.
+func TestResultPrefixClassification(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		prefix      classify.Classification
+		wantHidden  bool
+		wantName    string
+		wantWarning string
+	}{
+		{
+			name:        "Unclassified",
+			prefix:      classify.Classification{},
+			wantHidden:  true,
+			wantName:    "",
+			wantWarning: "",
+		},
+		{
+			name:        "Global unicast",
+			prefix:      classify.Classification{Type: classify.TypeGUA, Range: netip.Prefix{}, SLAAC: true, Warning: classify.WarningNone},
+			wantHidden:  false,
+			wantName:    "Global unicast (GUA)",
+			wantWarning: "",
+		},
+		{
+			name: "Multicast",
+			prefix: classify.Classification{
+				Type:    classify.TypeMulticast,
+				Range:   netip.Prefix{},
+				SLAAC:   false,
+				Warning: classify.WarningMulticast,
+			},
+			wantHidden:  false,
+			wantName:    "Multicast",
+			wantWarning: i18n.English.PrefixWarning(classify.WarningMulticast),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc := parseHTML(t, renderToString(t, Result(ResultData{
+				InterfaceID:    "0214:22ff:fe01:2345",
+				FullIP:         "ff02::214:22ff:fe01:2345",
+				Prefix:         tt.prefix,
+				DUIDLL:         "",
+				DUIDLLT:        "",
+				Error:          "",
+				ErrorField:     "",
+				ErrorHighlight: nil,
+			})))
+
+			prefixType := doc.Find("p.prefix-type")
+			assert.Equal(t, tt.wantHidden, prefixType.Is("[hidden]"), "Prefix type visibility")
+			assert.Equal(t, string(tt.prefix.Type), prefixType.AttrOr("data-prefix-type", ""))
+			assert.Equal(t, strconv.FormatBool(tt.prefix.SLAAC), prefixType.AttrOr("data-slaac", ""))
+			assert.Equal(t, tt.wantName, prefixType.Find(".prefix-type-name").Text())
+
+			warning := doc.Find("p.prefix-warning")
+			assert.Equal(t, tt.wantWarning == "", warning.Is("[hidden]"), "Warning visibility")
+			assert.Equal(t, tt.wantWarning, warning.Text())
+		})
+	}
+}
+
+// TestResultDUIDs verifies that the DUIDs of the MAC address are listed with
+// a calculation's result, and that the list is hidden without them.
+func TestResultDUIDs(t *testing.T) {
+	t.Parallel()
+
+	for _, ll := range []string{"00:03:00:01:00:14:22:01:23:45", ""} {
+		llt := ""
+		if ll != "" {
+			llt = "00:01:00:01:25:9e:9d:80:00:14:22:01:23:45"
+		}
+
+		doc := parseHTML(t, renderToString(t, Result(ResultData{
+			InterfaceID:    "0214:22ff:fe01:2345",
+			FullIP:         "2001:db8::214:22ff:fe01:2345",
+			Prefix:         classify.Classification{},
+			DUIDLL:         ll,
+			DUIDLLT:        llt,
+			Error:          "",
+			ErrorField:     "",
+			ErrorHighlight: nil,
+		})))
+
+		list := doc.Find("dl.duid-list")
+		require.Equal(t, 1, list.Length(), "DUID list")
+		assert.Equal(t, ll == "", list.Is("[hidden]"), "DUID list visibility")
+		assert.Equal(t, []string{"DUID-LL", "DUID-LLT"}, list.Find("dt").Map(func(_ int, s *goquery.Selection) string {
+			return s.Text()
+		}))
+		assert.Equal(t, ll, list.Find("code.duid-ll").Text())
+		assert.Equal(t, llt, list.Find("code.duid-llt").Text())
+	}
+}
+
+// TestResultErrorHighlight verifies that an error's highlight renders the input
+// with the offending part marked, clamped to the input, and that no input is
+// rendered without one.
+func TestResultErrorHighlight(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		highlight  *Highlight
+		wantInput  string
+		wantMarked string
+	}{
+		{"No highlight", nil, "", ""},
+		{"Marked character", &Highlight{Input: "2001:db8:g", Offset: 9, Length: 1}, "2001:db8:g", "g"},
+		{"Marked multibyte character", &Highlight{Input: "2001:é0", Offset: 5, Length: 2}, "2001:é0", "é"},
+		{"Marked past the end", &Highlight{Input: "2001:db8", Offset: 5, Length: 10}, "2001:db8", "db8"},
+		{"Offset out of range", &Highlight{Input: "2001", Offset: 10, Length: 1}, "2001", ""},
+		{"Negative offset", &Highlight{Input: "<b>", Offset: -1, Length: 1}, "<b>", "<"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc := parseHTML(t, renderToString(t, Result(ResultData{
+				InterfaceID:    "",
+				FullIP:         "",
+				Prefix:         classify.Classification{},
+				DUIDLL:         "",
+				DUIDLLT:        "",
+				Error:          "Invalid input",
+				ErrorField:     FieldIPv6Prefix,
+				ErrorHighlight: tt.highlight,
+			})))
+
+			input := doc.Find("p.error-input code")
+			if tt.highlight == nil {
+				assert.Equal(t, 0, input.Length(), "Input should not be rendered without a highlight")
+
+				return
+			}
+
+			assert.Equal(t, tt.wantInput, input.Text(), "Incorrect input")
+			assert.Equal(t, tt.wantMarked, input.Find("mark").Text(), "Incorrect marked part")
+		})
+	}
+}
+
+// TestRangeFields verifies that the calculator form has optional MAC range
+// fields, collapsed until opened, whose errors are rendered as the result's.
+func TestRangeFields(t *testing.T) {
+	t.Parallel()
+
+	doc := parseHTML(t, renderToString(t, HomeContent()))
+
+	details := doc.Find("form[hx-post='/calculate'] details.mac-range")
+	require.Equal(t, 1, details.Length(), "MAC range fields not found")
+	assert.False(t, details.Is("[open]"), "MAC range fields should be collapsed")
+	assert.Equal(t, "MAC Range", details.Find("summary").Text(), "Incorrect summary")
+
+	for _, field := range []string{FieldMACEnd, FieldMACCount} {
+		input := details.Find("input#" + field)
+		require.Equal(t, 1, input.Length(), "Field %s not found", field)
+		assert.Equal(t, field, input.AttrOr("name", ""), "Incorrect name of %s", field)
+		assert.Equal(t, ErrorMessageID, input.AttrOr("aria-errormessage", ""), "Incorrect aria-errormessage of %s", field)
+		assert.False(t, input.Is("[required]"), "Field %s should be optional", field)
+	}
+}
+
+// TestDUIDFields verifies that the calculator form has optional DUID hardware
+// type and time fields, collapsed until opened, whose errors are rendered as the
+// result's.
+func TestDUIDFields(t *testing.T) {
+	t.Parallel()
+
+	doc := parseHTML(t, renderToString(t, HomeContent()))
+
+	details := doc.Find("form[hx-post='/calculate'] details.duid-options")
+	require.Equal(t, 1, details.Length(), "DUID fields not found")
+	assert.False(t, details.Is("[open]"), "DUID fields should be collapsed")
+	assert.Equal(t, "DUID Options", details.Find("summary").Text(), "Incorrect summary")
+
+	for _, field := range []string{FieldDUIDHardware, FieldDUIDTime} {
+		input := details.Find("input#" + field)
+		require.Equal(t, 1, input.Length(), "Field %s not found", field)
+		assert.Equal(t, field, input.AttrOr("name", ""), "Incorrect name of %s", field)
+		assert.Equal(t, ErrorMessageID, input.AttrOr("aria-errormessage", ""), "Incorrect aria-errormessage of %s", field)
+		assert.False(t, input.Is("[required]"), "Field %s should be optional", field)
+	}
+}
+
+// TestRangeResult verifies that the addresses of a MAC range render as a table
+// row per MAC address, in order, with a caption counting them, followed by the
+// classification of the prefix.
+func TestRangeResult(t *testing.T) {
+	t.Parallel()
+
+	doc := parseHTML(t, renderToString(t, RangeResult(RangeData{
+		Addresses: []macrange.Address{
+			{MAC: "00-14-22-01-23-ff", InterfaceID: "0214:22ff:fe01:23ff", FullIP: "2001:db8::214:22ff:fe01:23ff"},
+			{MAC: "00-14-22-01-24-00", InterfaceID: "0214:22ff:fe01:2400", FullIP: "2001:db8::214:22ff:fe01:2400"},
+		},
+		Prefix: classify.Classification{},
+	})))
+
+	assert.Equal(t, "EUI-64 addresses of 2 MAC addresses", doc.Find("table.range-table caption").Text())
+	assert.Equal(t, []string{"00-14-22-01-23-ff", "00-14-22-01-24-00"}, doc.Find("table.range-table tbody tr td:first-child").Map(
+		func(_ int, s *goquery.Selection) string {
+			return s.Text()
+		},
+	))
+	assert.Equal(t, "2001:db8::214:22ff:fe01:2400", doc.Find("tbody tr").Last().Find("td").Last().Text())
+	assert.True(t, doc.Find(".prefix-type").Is("[hidden]"), "Unclassified prefix should be hidden")
+}
+
+// TestSubnetPlan verifies that the subnet planner posts its MAC address, parent
+// prefix, and subnet IDs fields to /plan, rendering the plan into its own live
+// region, and links each field to its hint and error.
+func TestSubnetPlan(t *testing.T) {
+	t.Parallel()
+
+	doc := parseHTML(t, renderToString(t, HomeContent()))
+
+	form := doc.Find("form[hx-post='/plan']")
+	require.Equal(t, 1, form.Length(), "Subnet plan form not found")
+	assert.Equal(t, "#plan-result", form.AttrOr("hx-target", ""), "Incorrect plan hx-target")
+	assert.Equal(t, "Subnet Plan", doc.Find("#plan-title").Text(), "Incorrect plan title")
+
+	for _, field := range []string{FieldPlanMAC, FieldPlanParent, FieldPlanIDs} {
+		input := form.Find("input#" + field)
+		require.Equal(t, 1, input.Length(), "Field %s not found", field)
+		assert.Equal(t, field, input.AttrOr("name", ""), "Incorrect name of %s", field)
+		assert.Equal(t, field+"-hint", input.AttrOr("aria-describedby", ""), "Incorrect aria-describedby of %s", field)
+		assert.Equal(t, PlanErrorMessageID, input.AttrOr("aria-errormessage", ""), "Incorrect aria-errormessage of %s", field)
+		assert.True(t, input.Is("[required]"), "Field %s should be required", field)
+	}
+
+	container := doc.Find("#plan-result")
+	require.Equal(t, 1, container.Length(), "Plan result container not found")
+	assert.Equal(t, "polite", container.AttrOr("aria-live", ""), "Incorrect plan result aria-live")
+	assert.Equal(t, 0, doc.Find("#offline-plan-result").Length(), "Offline template requires the PWA")
+}
+
+// TestPlanResult verifies that a plan renders a table with a row per subnet,
+// captioned with the interface ID, and that an error renders as an alert naming
+// the field it refers to instead.
+func TestPlanResult(t *testing.T) {
+	t.Parallel()
+
+	doc := parseHTML(t, renderToString(t, PlanResult(PlanData{
+		InterfaceID: "0214:22ff:fe01:2345",
+		Subnets: []PlanRow{
+			{ID: "a", Prefix: "2001:db8:1:a::/64", Address: "2001:db8:1:a:214:22ff:fe01:2345"},
+			{ID: "b", Prefix: "2001:db8:1:b::/64", Address: "2001:db8:1:b:214:22ff:fe01:2345"},
+		},
+		Error:          "",
+		ErrorField:     "",
+		ErrorHighlight: nil,
+	})))
+
+	assert.Equal(t, "EUI-64 addresses of interface ID 0214:22ff:fe01:2345", doc.Find("table caption").Text())
+	assert.Equal(t, 3, doc.Find("thead th[scope='col']").Length(), "Incorrect column headers")
+
+	rows := doc.Find("tbody tr")
+	require.Equal(t, 2, rows.Length(), "Incorrect number of rows")
+	assert.Equal(t, "b", rows.Eq(1).Find("td").Eq(0).Text())
+	assert.Equal(t, "2001:db8:1:b::/64", rows.Eq(1).Find("td").Eq(1).Text())
+	assert.Equal(t, "2001:db8:1:b:214:22ff:fe01:2345", rows.Eq(1).Find("td").Eq(2).Text())
+
+	doc = parseHTML(t, renderToString(t, PlanResult(PlanData{
+		InterfaceID:    "",
+		Subnets:        nil,
+		Error:          "Invalid subnet ID",
+		ErrorField:     FieldPlanIDs,
+		ErrorHighlight: nil,
+	})))
+
+	alert := doc.Find("#" + PlanErrorMessageID)
+	require.Equal(t, 1, alert.Length(), "Plan error not found")
+	assert.Equal(t, "alert", alert.AttrOr("role", ""))
+	assert.Equal(t, FieldPlanIDs, alert.AttrOr("data-error-field", ""))
+	assert.Equal(t, 0, doc.Find("table").Length(), "Table should not be rendered with an error")
+}
+
+// TestAddressMatrix verifies that the matrix mode renders a regular POST form to
+// /matrix, so its CSV is downloaded as a file, with required list fields and the
+// CSRF token as a form field.
+func TestAddressMatrix(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	ctx := WithCSRFToken(context.Background(), "test-token")
+	if err := HomeContent().Render(ctx, &buf); err != nil {
+		t.Fatalf("Failed to render template: %v", err)
+	}
+
+	doc := parseHTML(t, buf.String())
+
+	form := doc.Find("form[data-matrix-form]")
+	require.Equal(t, 1, form.Length(), "Matrix form not found")
+	assert.Equal(t, "/matrix", form.AttrOr("action", ""), "Incorrect matrix action")
+	assert.Equal(t, "post", form.AttrOr("method", ""), "Incorrect matrix method")
+	assert.False(t, form.Is("[hx-post]"), "Matrix form should not be submitted by HTMX")
+	assert.Equal(t, "test-token", form.Find("input[name='"+CSRFField+"']").AttrOr("value", ""), "Incorrect CSRF field")
+	assert.Equal(t, "Address Matrix", doc.Find("#matrix-title").Text(), "Incorrect matrix title")
+
+	for _, field := range []string{FieldMatrixMACs, FieldMatrixPrefixes} {
+		textarea := form.Find("textarea#" + field)
+		require.Equal(t, 1, textarea.Length(), "Field %s not found", field)
+		assert.Equal(t, field, textarea.AttrOr("name", ""), "Incorrect name of %s", field)
+		assert.Equal(t, field+"-hint", textarea.AttrOr("aria-describedby", ""), "Incorrect aria-describedby of %s", field)
+		assert.Equal(t, MatrixErrorMessageID, textarea.AttrOr("aria-errormessage", ""), "Incorrect aria-errormessage of %s", field)
+		assert.True(t, textarea.Is("[required]"), "Field %s should be required", field)
+	}
+
+	assert.Equal(t, "Download CSV", form.Find("button[type='submit']").Text(), "Incorrect submit button")
+	assert.Equal(t, 1, doc.Find("#matrix-result[aria-live='polite']").Length(), "Matrix result container not found")
+}
+
+// TestULAGenerator verifies that the ULA generator posts its method and subnet
+// ID to /ula along with the calculator form's MAC address, defaulting to the
+// derived Global ID.
+func TestULAGenerator(t *testing.T) {
+	t.Parallel()
+
+	doc := parseHTML(t, renderToString(t, HomeContent()))
+
+	form := doc.Find("form[hx-post='/ula']")
+	require.Equal(t, 1, form.Length(), "ULA generator form not found")
+	assert.Equal(t, "#ula-result", form.AttrOr("hx-target", ""), "Incorrect ULA hx-target")
+	assert.Equal(t, "#"+FieldMAC, form.AttrOr("hx-include", ""), "ULA form should include the MAC address")
+	assert.Equal(t, 1, doc.Find("details.ula-generator summary").Length(), "ULA generator should be a disclosure")
+
+	methods := form.Find("input[type='radio'][name='" + FieldULAMethod + "']")
+	require.Equal(t, 2, methods.Length(), "Incorrect number of methods")
+	assert.Equal(t, ULAMethodDerived, form.Find("input[type='radio']:checked").AttrOr("value", ""), "Derived method should be the default")
+
+	subnet := form.Find("input#" + FieldULASubnet)
+	require.Equal(t, 1, subnet.Length(), "Subnet ID field not found")
+	assert.Equal(t, ULAErrorMessageID, subnet.AttrOr("aria-errormessage", ""), "Incorrect aria-errormessage")
+	assert.False(t, subnet.Is("[required]"), "Subnet ID should default to 0")
+	assert.Equal(t, 1, doc.Find("#ula-result[aria-live='polite']").Length(), "ULA result container not found")
+	assert.Equal(t, 0, doc.Find("#offline-ula-result").Length(), "Offline template requires the PWA")
+}
+
+// TestULAResult verifies that a generated prefix renders the /48 and chosen /64
+// with a button entering the subnet into the IPv6 prefix field, and that an
+// error renders as an alert instead.
+func TestULAResult(t *testing.T) {
+	t.Parallel()
+
+	doc := parseHTML(t, renderToString(t, ULAResult(ULAData{
+		Prefix:         "fd12:3456:789a::/48",
+		Subnet:         "fd12:3456:789a:1::/64",
+		Value:          "fd12:3456:789a:1",
+		Error:          "",
+		ErrorField:     "",
+		ErrorHighlight: nil,
+	})))
+
+	assert.Equal(t, "fd12:3456:789a::/48", doc.Find(".ula-prefix").Text())
+	assert.Equal(t, "fd12:3456:789a:1::/64", doc.Find(".ula-subnet").Text())
+
+	use := doc.Find("button[data-use-prefix]")
+	require.Equal(t, 1, use.Length(), "Use button not found")
+	assert.Equal(t, "fd12:3456:789a:1", use.AttrOr("data-use-prefix", ""))
+	assert.Equal(t, FieldIPv6Prefix, use.AttrOr("aria-controls", ""))
+
+	doc = parseHTML(t, renderToString(t, ULAResult(ULAData{
+		Prefix:         "",
+		Subnet:         "",
+		Value:          "",
+		Error:          "Invalid subnet ID",
+		ErrorField:     FieldULASubnet,
+		ErrorHighlight: nil,
+	})))
+
+	alert := doc.Find("#" + ULAErrorMessageID)
+	require.Equal(t, 1, alert.Length(), "ULA error not found")
+	assert.Equal(t, "alert", alert.AttrOr("role", ""))
+	assert.Equal(t, FieldULASubnet, alert.AttrOr("data-error-field", ""))
+	assert.Equal(t, 0, doc.Find("button[data-use-prefix]").Length(), "Use button should not be rendered with an error")
+}
+
+// TestAnalyzerPage verifies that the analyzer page renders its form, submitted
+// both by HTMX and as a regular GET, linking back to the calculator, and that it
+// renders the analysis of the address it was given.
+func TestAnalyzerPage(t *testing.T) {
+	t.Parallel()
+
+	doc := parseHTML(t, renderToString(t, AnalyzerPage(AnalysisData{Address: "", Facts: nil, Error: "", DUID: DUIDData{DUID: "", Facts: nil, Error: ""}})))
+
+	assert.Equal(t, "IPv6 Address Analyzer", doc.Find("title").Text())
+	assert.Equal(t, "/", doc.Find(".page-link a").AttrOr("href", ""), "Analyzer should link to the calculator")
+
+	form := doc.Find("form[data-analyzer-form]")
+	require.Equal(t, 1, form.Length(), "Analyzer form not found")
+	assert.Equal(t, "/analyze", form.AttrOr("action", ""))
+	assert.Equal(t, "get", form.AttrOr("method", ""))
+	assert.Equal(t, "/analyze", form.AttrOr("hx-get", ""))
+	assert.Equal(t, "#analysis-result", form.AttrOr("hx-target", ""))
+
+	address := form.Find("input#" + FieldAnalyzerAddress)
+	require.Equal(t, 1, address.Length(), "Address field not found")
+	assert.Equal(t, AnalyzerErrorMessageID, address.AttrOr("aria-errormessage", ""))
+	assert.Equal(t, 1, doc.Find(`label[for="`+FieldAnalyzerAddress+`"]`).Length(), "Address field has no label")
+	assert.Empty(t, strings.TrimSpace(doc.Find("#analysis-result[aria-live='polite']").Text()), "Result should start empty")
+
+	doc = parseHTML(t, renderToString(t, AnalyzerPage(AnalysisData{
+		Address: "fe80::1",
+		Facts:   []i18n.Fact{{Label: "Address", Value: "fe80::1"}},
+		Error:   "",
+		DUID:    DUIDData{DUID: "", Facts: nil, Error: ""},
+	})))
+
+	assert.Equal(t, "fe80::1", doc.Find("input#"+FieldAnalyzerAddress).AttrOr("value", ""))
+	assert.Equal(t, "fe80::1", doc.Find("#analysis-result dd code").Text())
+}
+
+// TestAnalysisResult verifies that an analysis renders its facts as a
+// description list in order, and that an error renders as an alert naming the
+// address field instead.
+func TestAnalysisResult(t *testing.T) {
+	t.Parallel()
+
+	doc := parseHTML(t, renderToString(t, AnalysisResult(AnalysisData{
+		Address: "2001:db8::214:22ff:fe01:2345",
+		Facts: []i18n.Fact{
+			{Label: "Scope", Value: "Global"},
+			{Label: "MAC Address", Value: "00-14-22-01-23-45"},
+		},
+		Error: "",
+		DUID:  DUIDData{DUID: "", Facts: nil, Error: ""},
+	})))
+
+	assert.Equal(t, []string{"Scope", "MAC Address"}, doc.Find("dl.analysis-facts dt").Map(func(_ int, s *goquery.Selection) string {
+		return s.Text()
+	}))
+	assert.Equal(t, "00-14-22-01-23-45", doc.Find("dl.analysis-facts dd").Last().Text())
+
+	doc = parseHTML(t, renderToString(t, AnalysisResult(AnalysisData{
+		Address: "192.0.2.1",
+		Facts:   nil,
+		Error:   "Not IPv6",
+		DUID:    DUIDData{DUID: "", Facts: nil, Error: ""},
+	})))
+
+	alert := doc.Find("#" + AnalyzerErrorMessageID)
+	require.Equal(t, 1, alert.Length(), "Analyzer error not found")
+	assert.Equal(t, "alert", alert.AttrOr("role", ""))
+	assert.Equal(t, FieldAnalyzerAddress, alert.AttrOr("data-error-field", ""))
+	assert.Equal(t, 0, doc.Find("dl").Length(), "Facts should not be rendered with an error")
+}
+
+// TestDUIDDecoder verifies that the analyzer page's DUID decoder renders its
+// form, submitted both by HTMX and as a regular GET, closed until it is given a
+// DUID, and that it renders the decoded DUID open.
+func TestDUIDDecoder(t *testing.T) {
+	t.Parallel()
+
+	doc := parseHTML(t, renderToString(t, DUIDDecoder(DUIDData{DUID: "", Facts: nil, Error: ""})))
+
+	decoder := doc.Find("details.duid-decoder")
+	require.Equal(t, 1, decoder.Length(), "DUID decoder not found")
+	assert.False(t, decoder.Is("[open]"), "DUID decoder should start closed")
+
+	form := decoder.Find("form[data-duid-form]")
+	require.Equal(t, 1, form.Length(), "DUID form not found")
+	assert.Equal(t, "/duid", form.AttrOr("action", ""))
+	assert.Equal(t, "get", form.AttrOr("method", ""))
+	assert.Equal(t, "/duid", form.AttrOr("hx-get", ""))
+	assert.Equal(t, "#duid-result", form.AttrOr("hx-target", ""))
+
+	field := form.Find("input#" + FieldDUID)
+	require.Equal(t, 1, field.Length(), "DUID field not found")
+	assert.Equal(t, DUIDErrorMessageID, field.AttrOr("aria-errormessage", ""))
+	assert.Equal(t, 1, doc.Find(`label[for="`+FieldDUID+`"]`).Length(), "DUID field has no label")
+
+	doc = parseHTML(t, renderToString(t, DUIDDecoder(DUIDData{
+		DUID:  "00:03:00:01:00:14:22:01:23:45",
+		Facts: []i18n.Fact{{Label: "MAC Address", Value: "00-14-22-01-23-45"}},
+		Error: "",
+	})))
+
+	assert.True(t, doc.Find("details.duid-decoder").Is("[open]"), "DUID decoder should be open with a DUID")
+	assert.Equal(t, "00:03:00:01:00:14:22:01:23:45", doc.Find("input#"+FieldDUID).AttrOr("value", ""))
+	assert.Equal(t, "00-14-22-01-23-45", doc.Find("#duid-result dd code").Text())
+}
+
+// TestDUIDResult verifies that an error decoding a DUID renders as an alert
+// naming the DUID field instead of its facts.
+func TestDUIDResult(t *testing.T) {
+	t.Parallel()
+
+	doc := parseHTML(t, renderToString(t, DUIDResult(DUIDData{DUID: "00:09", Facts: nil, Error: "Unknown type"})))
+
+	alert := doc.Find("#" + DUIDErrorMessageID)
+	require.Equal(t, 1, alert.Length(), "DUID error not found")
+	assert.Equal(t, "alert", alert.AttrOr("role", ""))
+	assert.Equal(t, FieldDUID, alert.AttrOr("data-error-field", ""))
+	assert.Equal(t, 0, doc.Find("dl").Length(), "Facts should not be rendered with an error")
+}
+
+// TestAddressVerifier verifies that the address verifier posts its pair to
+// /verify with HTMX, only the MAC and address being required, and posts pairs
+// as CSV to /verify/csv as a regular form, so its CSV is downloaded as a file.
+func TestAddressVerifier(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	ctx := WithCSRFToken(context.Background(), "test-token")
+	if err := HomeContent().Render(ctx, &buf); err != nil {
+		t.Fatalf("Failed to render template: %v", err)
+	}
+
+	doc := parseHTML(t, buf.String())
+	assert.Equal(t, "Verify Addresses", doc.Find("#verify-title").Text(), "Incorrect verifier title")
+
+	form := doc.Find("form[data-verify-form]")
+	require.Equal(t, 1, form.Length(), "Verify form not found")
+	assert.Equal(t, "/verify", form.AttrOr("hx-post", ""), "Incorrect verify endpoint")
+	assert.Equal(t, "#verify-result", form.AttrOr("hx-target", ""), "Incorrect verify target")
+	assert.Equal(t, "test-token", form.Find("input[name='"+CSRFField+"']").AttrOr("value", ""), "Incorrect CSRF field")
+
+	for field, required := range map[string]bool{FieldVerifyMAC: true, FieldVerifyAddress: true, FieldVerifyPrefix: false} {
+		input := form.Find("input#" + field)
+		require.Equal(t, 1, input.Length(), "Field %s not found", field)
+		assert.Equal(t, field, input.AttrOr("name", ""), "Incorrect name of %s", field)
+		assert.Equal(t, VerifyErrorMessageID, input.AttrOr("aria-errormessage", ""), "Incorrect aria-errormessage of %s", field)
+		assert.Equal(t, required, input.Is("[required]"), "Incorrect required state of %s", field)
+	}
+
+	csvForm := doc.Find("form[data-verify-csv-form]")
+	require.Equal(t, 1, csvForm.Length(), "Verify CSV form not found")
+	assert.Equal(t, "/verify/csv", csvForm.AttrOr("action", ""), "Incorrect verify CSV action")
+	assert.Equal(t, "post", csvForm.AttrOr("method", ""), "Incorrect verify CSV method")
+	assert.False(t, csvForm.Is("[hx-post]"), "Verify CSV form should not be submitted by HTMX")
+	assert.Equal(t, VerifyCSVErrorMessageID, csvForm.Find("textarea#"+FieldVerifyCSV).AttrOr("aria-errormessage", ""))
+	assert.Equal(t, 1, doc.Find("#verify-result[aria-live='polite']").Length(), "Verify result container not found")
+	assert.Equal(t, 1, doc.Find("#verify-csv-result[aria-live='polite']").Length(), "Verify CSV result container not found")
+}
+
+// TestVerifyResult verifies that a verification renders its verdict, tagged with
+// its status, followed by its facts, and that an error renders as an alert
+// naming its field instead.
+func TestVerifyResult(t *testing.T) {
+	t.Parallel()
+
+	doc := parseHTML(t, renderToString(t, VerifyResult(VerifyData{
+		Status:  verify.StatusIIDMismatch,
+		Verdict: "The address has the EUI-64 interface ID of another MAC address.",
+		Facts: []i18n.Fact{
+			{Label: "Expected Address", Value: "fe80::214:22ff:fe01:2345"},
+			{Label: "MAC Address in the Address", Value: "00-14-22-01-23-46"},
+		},
+		Error:          "",
+		ErrorField:     "",
+		ErrorHighlight: nil,
+	})))
+
+	verdict := doc.Find("p.verify-verdict")
+	require.Equal(t, 1, verdict.Length(), "Verdict not found")
+	assert.Equal(t, string(verify.StatusIIDMismatch), verdict.AttrOr("data-verify-status", ""))
+	assert.Equal(t, []string{"Expected Address", "MAC Address in the Address"}, doc.Find("dl.verify-facts dt").Map(func(_ int, s *goquery.Selection) string {
+		return s.Text()
+	}))
+
+	doc = parseHTML(t, renderToString(t, VerifyResult(VerifyData{
+		Status:         "",
+		Verdict:        "",
+		Facts:          nil,
+		Error:          "Invalid prefix",
+		ErrorField:     FieldVerifyPrefix,
+		ErrorHighlight: nil,
+	})))
+
+	alert := doc.Find("#" + VerifyErrorMessageID)
+	require.Equal(t, 1, alert.Length(), "Verify error not found")
+	assert.Equal(t, FieldVerifyPrefix, alert.AttrOr("data-error-field", ""))
+	assert.Equal(t, 0, doc.Find(".verify-verdict").Length(), "Verdict should not be rendered with an error")
+}
+
+// TestMACImporter verifies that the importer posts its file, format, and prefix
+// as multipart form data, offering every format with detection first.
+func TestMACImporter(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	ctx := WithCSRFToken(context.Background(), "test-token")
+	if err := HomeContent().Render(ctx, &buf); err != nil {
+		t.Fatalf("Failed to render template: %v", err)
+	}
+
+	doc := parseHTML(t, buf.String())
+	assert.Equal(t, "Import MAC Addresses", doc.Find("#import-title").Text(), "Incorrect importer title")
+
+	form := doc.Find("form[data-import-form]")
+	require.Equal(t, 1, form.Length(), "Import form not found")
+	assert.Equal(t, "/import", form.AttrOr("hx-post", ""), "Incorrect import endpoint")
+	assert.Equal(t, "multipart/form-data", form.AttrOr("hx-encoding", ""), "Incorrect import encoding")
+	assert.Equal(t, "test-token", form.Find("input[name='"+CSRFField+"']").AttrOr("value", ""), "Incorrect CSRF field")
+	assert.Equal(t, 1, form.Find("input#"+FieldImportFile+"[type='file'][required]").Length(), "File field not found")
+	assert.Equal(t, 1, form.Find("input#"+FieldImportPrefix+"[required]").Length(), "Prefix field not found")
+
+	options := form.Find("select#" + FieldImportFormat + " option").Map(func(_ int, s *goquery.Selection) string {
+		return s.AttrOr("value", "")
+	})
+	require.Len(t, options, len(importer.Formats), "Incorrect number of formats")
+	assert.Equal(t, string(importer.FormatAuto), options[0], "Detection should be the default format")
+	assert.Equal(t, 1, doc.Find("#import-result[aria-live='polite']").Length(), "Import result container not found")
+}
+
+// TestImportResult verifies that an import renders a row per MAC address,
+// explaining those whose address could not be calculated, and that an error
+// renders as an alert naming its field instead.
+func TestImportResult(t *testing.T) {
+	t.Parallel()
+
+	doc := parseHTML(t, renderToString(t, ImportResult(ImportData{
+		Format: "dnsmasq leases",
+		Rows: []ImportRow{
+			{Line: 1, MAC: "00-14-22-01-23-45", Hostname: "printer", InterfaceID: "0214:22ff:fe01:2345", FullIP: "2001:db8::214:22ff:fe01:2345", Error: ""},
+			{Line: 3, MAC: "00:14:22:01:23:45:67:89", Hostname: "", InterfaceID: "", FullIP: "", Error: "Too long"},
+		},
+		Prefix:         classify.Classification{},
+		Error:          "",
+		ErrorField:     "",
+		ErrorHighlight: nil,
+	})))
+
+	assert.Equal(t, "EUI-64 addresses of 2 MAC addresses imported from dnsmasq leases", doc.Find("table.import-table caption").Text())
+
+	rows := doc.Find("table.import-table tbody tr")
+	require.Equal(t, 2, rows.Length(), "Incorrect number of rows")
+	assert.Equal(t, "printer", rows.Eq(0).Find("td").Eq(2).Text())
+	assert.Equal(t, "2001:db8::214:22ff:fe01:2345", rows.Eq(0).Find("td").Eq(4).Text())
+	assert.Equal(t, "Too long", rows.Eq(1).Find("td.import-row-error").Text())
+
+	doc = parseHTML(t, renderToString(t, ImportResult(ImportData{
+		Format:         "",
+		Rows:           nil,
+		Prefix:         classify.Classification{},
+		Error:          "Select a file",
+		ErrorField:     FieldImportFile,
+		ErrorHighlight: nil,
+	})))
+
+	alert := doc.Find("#" + ImportErrorMessageID)
+	require.Equal(t, 1, alert.Length(), "Import error not found")
+	assert.Equal(t, FieldImportFile, alert.AttrOr("data-error-field", ""))
+	assert.Equal(t, 0, doc.Find("table").Length(), "Table should not be rendered with an error")
+}
+
+// TestRASimulator verifies that the Router Advertisement simulator posts its
+// MAC address and Prefix Information options to /simulate, rendering the
+// addresses into its own live region, and links each field to its hint and
+// error.
+func TestRASimulator(t *testing.T) {
+	t.Parallel()
+
+	doc := parseHTML(t, renderToString(t, HomeContent()))
+	assert.Equal(t, "Router Advertisement Simulator", doc.Find("#ra-title").Text(), "Incorrect simulator title")
+
+	form := doc.Find("form[data-ra-form]")
+	require.Equal(t, 1, form.Length(), "Simulator form not found")
+	assert.Equal(t, "/simulate", form.AttrOr("hx-post", ""), "Incorrect simulator endpoint")
+	assert.Equal(t, "#ra-result", form.AttrOr("hx-target", ""), "Incorrect simulator hx-target")
+
+	for _, field := range []string{"input#" + FieldRAMAC, "textarea#" + FieldRAPrefixes} {
+		input := form.Find(field)
+		require.Equal(t, 1, input.Length(), "Field %s not found", field)
+		assert.Equal(t, input.AttrOr("id", ""), input.AttrOr("name", ""), "Incorrect name of %s", field)
+		assert.Equal(t, input.AttrOr("id", "")+"-hint", input.AttrOr("aria-describedby", ""), "Incorrect aria-describedby of %s", field)
+		assert.Equal(t, RAErrorMessageID, input.AttrOr("aria-errormessage", ""), "Incorrect aria-errormessage of %s", field)
+		assert.True(t, input.Is("[required]"), "Field %s should be required", field)
+	}
+
+	assert.Equal(t, 1, doc.Find("#ra-result[aria-live='polite']").Length(), "Simulation result container not found")
+	assert.Equal(t, 0, doc.Find("#offline-ra-result").Length(), "Offline template requires the PWA")
+}
+
+// TestRAResult verifies that a simulation renders a row per address, marking
+// deprecated ones and explaining options forming none, and that an error
+// renders as an alert naming its field instead.
+func TestRAResult(t *testing.T) {
+	t.Parallel()
+
+	doc := parseHTML(t, renderToString(t, RAResult(RAData{
+		InterfaceID: "0214:22ff:fe01:2345",
+		Rows: []RARow{
+			{
+				Prefix: "fe80::/64", Flags: "link-local", Valid: "infinite", Preferred: "infinite",
+				Address: "fe80::214:22ff:fe01:2345", Deprecated: false, Status: "",
+			},
+			{
+				Prefix: "2001:db8:1::/64", Flags: "LA", Valid: "3600 s", Preferred: "0 s",
+				Address: "2001:db8:1:0:214:22ff:fe01:2345", Deprecated: true, Status: "",
+			},
+			{
+				Prefix: "2001:db8:2::/56", Flags: "LA", Valid: "3600 s", Preferred: "1800 s",
+				Address: "", Deprecated: false, Status: "Not a /64",
+			},
+		},
+		Error:          "",
+		ErrorField:     "",
+		ErrorHighlight: nil,
+	})))
+
+	assert.Equal(t, "Addresses formed with interface ID 0214:22ff:fe01:2345", doc.Find("table.ra-table caption").Text())
+	assert.Equal(t, 5, doc.Find("thead th[scope='col']").Length(), "Incorrect column headers")
+
+	rows := doc.Find("table.ra-table tbody tr")
+	require.Equal(t, 3, rows.Length(), "Incorrect number of rows")
+	assert.Equal(t, "fe80::214:22ff:fe01:2345", rows.Eq(0).Find("td code").Last().Text())
+	assert.Equal(t, 0, rows.Eq(0).Find(".ra-deprecated").Length(), "Preferred address marked deprecated")
+	assert.Equal(t, "0 s", rows.Eq(1).Find("td").Eq(3).Text())
+	assert.Equal(t, "deprecated", rows.Eq(1).Find(".ra-deprecated").Text())
+	assert.Equal(t, "Not a /64", rows.Eq(2).Find("td.ra-row-status").Text())
+
+	doc = parseHTML(t, renderToString(t, RAResult(RAData{
+		InterfaceID:    "",
+		Rows:           nil,
+		Error:          "Line 2: invalid flags",
+		ErrorField:     FieldRAPrefixes,
+		ErrorHighlight: nil,
+	})))
+
+	alert := doc.Find("#" + RAErrorMessageID)
+	require.Equal(t, 1, alert.Length(), "Simulation error not found")
+	assert.Equal(t, FieldRAPrefixes, alert.AttrOr("data-error-field", ""))
+	assert.Equal(t, 0, doc.Find("table").Length(), "Table should not be rendered with an error")
+}
+
+// TestHomeAnalyzerLink verifies that the home page links to the analyzer.
+func TestHomeAnalyzerLink(t *testing.T) {
+	t.Parallel()
+
+	doc := parseHTML(t, renderToString(t, HomeContent()))
+	assert.Equal(t, 1, doc.Find(`.page-link a[href="/analyze"]`).Length(), "Analyzer link not found")
+}
+
+// prefixResolver is an AssetResolver that serves every asset under a fixed prefix.
+type prefixResolver string
+
+// Path returns the asset name joined to the prefix.
+func (p prefixResolver) Path(name string) string {
+	return string(p) + name
+}
+
+// TestLayoutAssets verifies that Layout links assets through the AssetResolver
+// carried by the render context.
+func TestLayoutAssets(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	ctx := WithAssetResolver(context.Background(), prefixResolver("/assets/v1/"))
+	if err := Layout("Test Title", HomeContent()).Render(ctx, &buf); err != nil {
+		t.Fatalf("Failed to render template: %v", err)
+	}
+
+	doc := parseHTML(t, buf.String())
+
+	assert.Equal(t, 1, doc.Find("link[rel='icon'][href='/assets/v1/favicon.ico']").Length(), "Favicon link")
+	assert.Equal(t, 1, doc.Find("link[rel='stylesheet'][href='/assets/v1/styles.css']").Length(), "Stylesheet link")
+	assert.Equal(t, 1, doc.Find("script[src='/assets/v1/htmx.min.js']").Length(), "HTMX script")
+	assert.Equal(t, 1, doc.Find("script[src='/assets/v1/app.js']").Length(), "Application script")
+}
+
+// TestHomePWA verifies that the home page links the web manifest and WebAssembly
+// client and includes the offline result template only when the offline-capable
+// client is served.
+func TestHomePWA(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		enabled bool
+	}{
+		{name: "Enabled", enabled: true},
+		{name: "Disabled", enabled: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			ctx := WithPWA(context.Background(), tt.enabled)
+			if err := Home().Render(ctx, &buf); err != nil {
+				t.Fatalf("Failed to render template: %v", err)
+			}
+
+			doc := parseHTML(t, buf.String())
+
+			want := 0
+			if tt.enabled {
+				want = 1
+			}
+
+			assert.Equal(t, want, doc.Find("link[rel='manifest'][href='/static/pwa/manifest.webmanifest']").Length(),
+				"Manifest link")
+			assert.Equal(t, want, doc.Find("meta[name='wasm-runtime'][content='/static/pwa/wasm_exec.js']").Length(),
+				"WebAssembly runtime")
+			assert.Equal(t, want, doc.Find("meta[name='wasm-module'][content='/static/pwa/main.wasm']").Length(),
+				"WebAssembly module")
+			assert.Equal(t, want, doc.Find("template#offline-result").Length(), "Offline result template")
+			assert.Equal(t, want, doc.Find("template#offline-range-result").Length(), "Offline range template")
+			assert.Equal(t, want, doc.Find("template#offline-import-result").Length(), "Offline import template")
+			assert.Equal(t, want, doc.Find("template#offline-ra-result").Length(), "Offline simulation template")
+			assert.Equal(t, want, doc.Find("template#offline-ula-result").Length(), "Offline ULA prefix template")
+		})
+	}
+}
+
+// TestLayoutTheme verifies that Layout loads the theme script before the
+// stylesheet, so the stored theme applies before the first paint, and renders
+// the theme selector with the system, light, and dark options.
+func TestLayoutTheme(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	if err := Layout("Test Title", HomeContent()).Render(context.Background(), &buf); err != nil {
+		t.Fatalf("Failed to render template: %v", err)
+	}
+
+	doc := parseHTML(t, buf.String())
+
+	head := doc.Find("head").Children()
+	themeScript := doc.Find("head script[src='/static/theme.js']")
+	assert.Equal(t, 1, themeScript.Length(), "Theme script should be in the head")
+	assert.Less(t,
+		head.IndexOfSelection(themeScript),
+		head.IndexOfSelection(doc.Find("head link[rel='stylesheet']")),
+		"Theme script should precede the stylesheet",
+	)
+	assert.Equal(t, "light dark", doc.Find("meta[name='color-scheme']").AttrOr("content", ""), "Color scheme")
+
+	selector := doc.Find(".app-container select#theme-select[data-theme-select]")
+	assert.Equal(t, 1, selector.Length(), "Theme selector")
+	assert.Equal(t, "Theme", doc.Find("label[for='theme-select']").Text(), "Theme selector label")
+
+	var values []string
+
+	selector.Find("option").Each(func(_ int, option *goquery.Selection) {
+		values = append(values, option.AttrOr("value", ""))
+	})
+	assert.Equal(t, []string{"system", "light", "dark"}, values, "Theme options")
+}
@@ -0,0 +1,124 @@
+// Package ula generates RFC 4193 Unique Local Address prefixes: /48 prefixes of
+// fd00::/8 with a 40-bit Global ID, either derived from the time and the EUI-64
+// identifier of a MAC address as the RFC suggests, or drawn at random. It also
+// derives the /64 subnets of a generated prefix, ready to be entered as the
+// prefix of an EUI-64 calculation.
+package ula
+
+import (
+	"crypto/sha1" //nolint:gosec // RFC 4193 specifies SHA-1; its output is not relied on for security.
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/errcode"
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+)
+
+// Constants defining the layout of ULA prefixes and their inputs.
+const (
+	PrefixBits = 48 // PrefixBits is the length of a generated ULA prefix.
+	SubnetBits = 64 // SubnetBits is the length of the subnets of a ULA prefix.
+
+	localPrefix    = 0xfd       // localPrefix is the first byte of a locally assigned ULA, fc00::/7 with the L bit set.
+	globalIDBytes  = 5          // globalIDBytes is the size of the Global ID, 40 bits.
+	subnetIDOffset = 6          // subnetIDOffset is the offset of the subnet ID in the address.
+	subnetIDBase   = 16         // subnetIDBase is the base subnet IDs are written in, as in addresses.
+	subnetIDBits   = 16         // subnetIDBits is the size of a subnet ID of a /48.
+	ntpEpochOffset = 2208988800 // ntpEpochOffset is the number of seconds from the NTP epoch, 1900, to the Unix epoch.
+	ntpSecondsBits = 32         // ntpSecondsBits is the size of each half of an NTP timestamp.
+	ntpFraction    = 1 << 32    // ntpFraction is the number of NTP fraction units in a second.
+)
+
+// Static error variables.
+var (
+	ErrRandomGlobalID  = errors.New("generating random Global ID")
+	ErrInvalidSubnetID = errcode.New("ula.subnet_id", fmt.Sprintf("subnet ID must be a hexadecimal number of up to %d bits", subnetIDBits))
+	ErrNotULA          = errors.New("not a /48 ULA prefix")
+)
+
+// Derive generates a ULA prefix with the algorithm suggested by RFC 4193,
+// section 3.2.2: the Global ID is the least significant 40 bits of the SHA-1
+// digest of the time, in 64-bit NTP format, followed by the EUI-64 identifier
+// derived from the MAC address.
+func Derive(mac string, now time.Time) (netip.Prefix, error) {
+	interfaceID, _, err := eui64.CalculateEUI64(mac, "")
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("deriving Global ID: %w", err)
+	}
+
+	identifier, err := hex.DecodeString(strings.ReplaceAll(interfaceID, ":", ""))
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("deriving Global ID from %q: %w", interfaceID, err)
+	}
+
+	key := binary.BigEndian.AppendUint64(nil, ntpTime(now))
+	key = append(key, identifier...)
+	digest := sha1.Sum(key) //nolint:gosec // See the import.
+
+	return prefix(digest[len(digest)-globalIDBytes:]), nil
+}
+
+// Random generates a ULA prefix with a Global ID read from r, which should be a
+// cryptographically secure source such as crypto/rand.Reader.
+func Random(r io.Reader) (netip.Prefix, error) {
+	globalID := make([]byte, globalIDBytes)
+	if _, err := io.ReadFull(r, globalID); err != nil {
+		return netip.Prefix{}, fmt.Errorf("%w: %w", ErrRandomGlobalID, err)
+	}
+
+	return prefix(globalID), nil
+}
+
+// ParseSubnetID parses the hexadecimal ID of a subnet of a ULA prefix, such as
+// 1 or ff00. A blank ID is the first subnet, 0.
+func ParseSubnetID(id string) (uint16, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseUint(id, subnetIDBase, subnetIDBits)
+	if err != nil {
+		return 0, fmt.Errorf("%w, got %q: %w", ErrInvalidSubnetID, id, err)
+	}
+
+	return uint16(value), nil
+}
+
+// Subnet returns the /64 subnet of a /48 ULA prefix with the given ID.
+func Subnet(ula netip.Prefix, id uint16) (netip.Prefix, error) {
+	addr := ula.Addr().As16()
+	if ula.Bits() != PrefixBits || addr[0] != localPrefix {
+		return netip.Prefix{}, fmt.Errorf("%w: %s", ErrNotULA, ula)
+	}
+
+	binary.BigEndian.PutUint16(addr[subnetIDOffset:], id)
+
+	return netip.PrefixFrom(netip.AddrFrom16(addr), SubnetBits), nil
+}
+
+// ntpTime returns t in the 64-bit NTP timestamp format: seconds since 1900 in
+// the high 32 bits and the fraction of a second in the low 32 bits.
+func ntpTime(t time.Time) uint64 {
+	seconds := uint64(t.Unix() + ntpEpochOffset) //nolint:gosec // Times before 1900 are not expected.
+	fraction := uint64(t.Nanosecond()) * ntpFraction / uint64(time.Second)
+
+	return seconds<<ntpSecondsBits | fraction
+}
+
+// prefix returns the /48 ULA prefix with the given Global ID.
+func prefix(globalID []byte) netip.Prefix {
+	var addr [16]byte
+
+	addr[0] = localPrefix
+	copy(addr[1:], globalID)
+
+	return netip.PrefixFrom(netip.AddrFrom16(addr), PrefixBits)
+}
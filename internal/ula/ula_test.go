@@ -0,0 +1,112 @@
+package ula
+
+import (
+	"bytes"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+	"github.com/nicholas-fedor/eui64-calculator/internal/subnet"
+)
+
+// TestDerive tests the Derive function, verifying that the Global ID is the low
+// 40 bits of the SHA-1 digest of the NTP time and EUI-64 identifier, that it
+// changes with the time and the MAC address, and that invalid MAC addresses are
+// rejected.
+func TestDerive(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.January, 2, 3, 4, 5, 500000000, time.UTC)
+
+	got, err := Derive("00-14-22-01-23-45", now)
+	require.NoError(t, err)
+	assert.Equal(t, "fd0f:358:622a::/48", got.String())
+
+	later, err := Derive("00-14-22-01-23-45", now.Add(time.Millisecond))
+	require.NoError(t, err)
+	assert.NotEqual(t, got, later, "Global ID should change with the time")
+
+	other, err := Derive("00-14-22-01-23-46", now)
+	require.NoError(t, err)
+	assert.NotEqual(t, got, other, "Global ID should change with the MAC address")
+
+	_, err = Derive("invalid", now)
+	require.ErrorIs(t, err, eui64.ErrParseMAC)
+}
+
+// TestRandom tests the Random function, verifying that the Global ID is read
+// from the given source and that a failing source is reported.
+func TestRandom(t *testing.T) {
+	t.Parallel()
+
+	got, err := Random(bytes.NewReader([]byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xff}))
+	require.NoError(t, err)
+	assert.Equal(t, "fd12:3456:789a::/48", got.String())
+
+	_, err = Random(bytes.NewReader([]byte{0x12, 0x34}))
+	require.ErrorIs(t, err, ErrRandomGlobalID)
+}
+
+// TestParseSubnetID tests the ParseSubnetID function with valid, blank, and
+// invalid subnet IDs.
+func TestParseSubnetID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		id      string
+		want    uint16
+		wantErr error
+	}{
+		{"Blank", "  ", 0, nil},
+		{"Single digit", "1", 1, nil},
+		{"Largest", " FFFF ", 0xffff, nil},
+		{"Not hexadecimal", "g", 0, ErrInvalidSubnetID},
+		{"Too large", "10000", 0, ErrInvalidSubnetID},
+		{"Negative", "-1", 0, ErrInvalidSubnetID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseSubnetID(tt.id)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestSubnet tests the Subnet function, verifying that the subnet ID fills the
+// bits following the /48, that the subnet can be entered as the prefix of an
+// EUI-64 calculation, and that prefixes other than /48 ULAs are rejected.
+func TestSubnet(t *testing.T) {
+	t.Parallel()
+
+	ula := netip.MustParsePrefix("fd12:3456:789a::/48")
+
+	got, err := Subnet(ula, 0xab)
+	require.NoError(t, err)
+	assert.Equal(t, "fd12:3456:789a:ab::/64", got.String())
+	assert.Equal(t, "fd12:3456:789a:ab", subnet.Hextets(got))
+
+	_, address, err := eui64.CalculateEUI64("00-14-22-01-23-45", subnet.Hextets(got))
+	require.NoError(t, err)
+	assert.Equal(t, "fd12:3456:789a:ab:214:22ff:fe01:2345", address)
+
+	_, err = Subnet(netip.MustParsePrefix("2001:db8:1::/48"), 1)
+	require.ErrorIs(t, err, ErrNotULA)
+
+	_, err = Subnet(netip.MustParsePrefix("fd12:3456::/32"), 1)
+	require.ErrorIs(t, err, ErrNotULA)
+}
@@ -1,16 +1,25 @@
 // Package validators provides input validation functions for the EUI-64 calculator.
 //
-// It validates MAC addresses and IPv6 prefixes before computing EUI-64 interface
-// identifiers. Each validation function returns a sentinel error on failure that
-// describes the specific validation rule violated.
+// It validates MAC addresses, the link-layer addresses of the other link types
+// interface identifiers are derived from, and IPv6 prefixes before computing
+// EUI-64 interface identifiers. Each validation function returns a sentinel
+// error on failure that describes the specific validation rule violated.
 //
-// The package exports two primary validation functions:
+// The package exports these primary validation functions:
 //   - ValidateMAC: validates a 48-bit MAC address string
+//   - ValidateShortAddress: validates a 16-bit IEEE 802.15.4 short address string
+//   - ValidateExtendedAddress: validates a 64-bit IEEE 802.15.4 extended address string
+//   - ValidateBLEAddress: validates a 48-bit Bluetooth LE device address string
+//   - ValidateToken: validates an interface ID token string, such as ::1:2
+//   - ValidateLinkAddress: validates an address with the validator of its link type
 //   - ValidateIPv6Prefix: validates an IPv6 network prefix string (first 64 bits)
 //
 // All exported error variables follow Go conventions for sentinel errors and can
-// be checked using errors.Is().
+// be checked using errors.Is(). The validators return them wrapped in a
+// *ValidationError, retrievable with errors.As(), which names the field and a
+// machine-readable code for the violated rule and locates the offending part of
+// the input by its byte offset.
 //
-// Tests in *_test.go files provide table-driven coverage for both validators,
+// Tests in *_test.go files provide table-driven coverage for the validators,
 // including edge cases such as empty input, overflow, and invalid characters.
 package validators
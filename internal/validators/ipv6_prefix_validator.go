@@ -1,9 +1,10 @@
 package validators
 
 import (
-	"errors"
 	"fmt"
 	"strings"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/errcode"
 )
 
 // Constants defining constraints for IPv6 prefix validation.
@@ -15,64 +16,113 @@ const (
 
 // Static error variables.
 var (
-	ErrEmptyPrefix         = errors.New("a non-blank IPv6 prefix is expected")
-	ErrPrefixLengthExceeds = fmt.Errorf(
-		"IPv6 prefix exceeds maximum length of %d characters",
-		maxPrefixStrLength,
+	ErrEmptyPrefix         = errcode.New(CodePrefixRequired, "a non-blank IPv6 prefix is expected")
+	ErrPrefixLengthExceeds = errcode.New(
+		CodePrefixTooLong,
+		fmt.Sprintf(
+			"IPv6 prefix exceeds maximum length of %d characters",
+			maxPrefixStrLength,
+		),
 	)
-	ErrPrefixHextetsExceeds = fmt.Errorf("IPv6 prefix must be %d or fewer hextets", maxHextets)
-	ErrEmptyHextet          = errors.New("empty hextet in IPv6 prefix")
-	ErrInvalidHextetChar    = errors.New("invalid character in hextet")
-	ErrInvalidHextetLength  = errors.New("invalid hextet length in IPv6 prefix")
+	ErrPrefixHextetsExceeds = errcode.New(CodePrefixTooManyHextets, fmt.Sprintf("IPv6 prefix must be %d or fewer hextets", maxHextets))
+	ErrEmptyHextet          = errcode.New(CodePrefixEmptyHextet, "empty hextet in IPv6 prefix")
+	ErrInvalidHextetChar    = errcode.New(CodePrefixInvalidChar, "invalid character in hextet")
+	ErrInvalidHextetLength  = errcode.New(CodePrefixHextetLength, "invalid hextet length in IPv6 prefix")
 )
 
 // ValidateIPv6Prefix validates an IPv6 prefix string for correctness.
 // It trims whitespace, checks the length, ensures the prefix is non-empty, and checks that it contains 4 or fewer hextets,
 // with each hextet being valid hexadecimal (up to 4 characters) and no internal empty hextets.
-// Allows trailing "::" for zero compression. Returns an error if the prefix is invalid.
+// Allows trailing "::" for zero compression. Returns a *ValidationError if the prefix is invalid,
+// locating the offending characters or hextet when the error refers to a specific part of the prefix.
 func ValidateIPv6Prefix(prefix string) error {
+	input := prefix
+	start := leadingSpace(input)
+
 	prefix = strings.TrimSpace(prefix)
 	if prefix == "" {
-		return ErrEmptyPrefix
+		return prefixError(input, CodePrefixRequired, ErrEmptyPrefix, 0, "", 0)
 	}
 
 	if len(prefix) > maxPrefixStrLength {
-		return ErrPrefixLengthExceeds
+		excess := runeBoundary(prefix, maxPrefixStrLength)
+
+		return prefixError(input, CodePrefixTooLong, ErrPrefixLengthExceeds, start+excess, prefix[excess:], 0)
 	}
 
-	prefix = strings.TrimSuffix(prefix, "::")
-	if prefix == "" {
+	trimmed := strings.TrimSuffix(prefix, "::")
+	if trimmed == "" {
 		return nil // "::" alone is valid, implying all zeros.
 	}
 
-	hextets := strings.Split(prefix, ":")
+	hextets := strings.Split(trimmed, ":")
 	if len(hextets) > maxHextets {
-		return ErrPrefixHextetsExceeds
+		excess := len(strings.Join(hextets[:maxHextets], ":")) + 1
+
+		return prefixError(
+			input,
+			CodePrefixTooManyHextets,
+			ErrPrefixHextetsExceeds,
+			start+excess,
+			trimmed[excess:],
+			maxHextets+1,
+		)
 	}
 
-	for i, hextet := range hextets {
-		if hextet == "" {
-			if i != 0 && i != len(hextets)-1 {
-				return ErrEmptyHextet
-			}
+	offset := start // offset is the byte offset of the current hextet in input.
 
-			continue
+	for i, hextet := range hextets {
+		if err := validateHextet(input, hextets, i, offset); err != nil {
+			return err
 		}
 
-		for _, char := range hextet {
-			if !isHexDigit(char) {
-				return ErrInvalidHextetChar
-			}
+		offset += len(hextet) + 1
+	}
+
+	return nil
+}
+
+// validateHextet validates the hextet at index i of the hextets of input,
+// starting at the given byte offset, returning a *ValidationError locating the
+// problem if it is invalid. Only the first and last hextets may be empty.
+func validateHextet(input string, hextets []string, i, offset int) error {
+	hextet := hextets[i]
+
+	if hextet == "" {
+		if i != 0 && i != len(hextets)-1 {
+			return prefixError(input, CodePrefixEmptyHextet, ErrEmptyHextet, offset-1, "::", i+1)
 		}
 
-		if len(hextet) > maxHextetLength {
-			return ErrInvalidHextetLength
+		return nil
+	}
+
+	for j, char := range hextet {
+		if !isHexDigit(char) {
+			return prefixError(input, CodePrefixInvalidChar, ErrInvalidHextetChar, offset+j, string(char), i+1)
 		}
 	}
 
+	if len(hextet) > maxHextetLength {
+		return prefixError(input, CodePrefixHextetLength, ErrInvalidHextetLength, offset, hextet, i+1)
+	}
+
 	return nil
 }
 
+// prefixError returns a *ValidationError about the IPv6 prefix input, locating
+// value, in the given 1-based hextet, at the given byte offset.
+func prefixError(input string, code Code, err error, offset int, value string, hextet int) error {
+	return &ValidationError{
+		Field:  FieldIPv6Prefix,
+		Code:   code,
+		Input:  input,
+		Value:  value,
+		Offset: offset,
+		Hextet: hextet,
+		Err:    err,
+	}
+}
+
 // isHexDigit reports whether a rune is a valid hexadecimal digit.
 // It checks if the character is 0-9, a-f, or A-F, returning true if valid, false otherwise.
 func isHexDigit(char rune) bool {
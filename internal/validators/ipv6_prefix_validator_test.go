@@ -33,26 +33,38 @@ func TestValidateIPv6Prefix(t *testing.T) {
 		{
 			"Prefix just over max length",
 			"2001:0db8:85a3:abcd5",
-			fmt.Sprintf("IPv6 prefix exceeds maximum length of %d characters", maxPrefixStrLength),
+			fmt.Sprintf(`IPv6 prefix exceeds maximum length of %d characters: "5" at position 20`, maxPrefixStrLength),
 		},
 		{
 			"Prefix exceeds max length",
 			"2001:db8:85a3:abcd:1234",
-			fmt.Sprintf("IPv6 prefix exceeds maximum length of %d characters", maxPrefixStrLength),
+			fmt.Sprintf(`IPv6 prefix exceeds maximum length of %d characters: "1234" at position 20`, maxPrefixStrLength),
 		},
 
 		// Hextet count check
 		{
 			"Too many hextets",
 			"2001:db8:85a3:0:0",
-			fmt.Sprintf("IPv6 prefix must be %d or fewer hextets", maxHextets),
+			fmt.Sprintf(`IPv6 prefix must be %d or fewer hextets: "0" at position 17 (hextet 5)`, maxHextets),
 		},
 
 		// Hextet content checks
-		{"Invalid character in hextet", "2001:db8:85a3:g000", "invalid character in hextet"},
-		{"IPv4-like address", "192.168.1.1", "invalid character in hextet"},
-		{"Invalid internal empty hextet", "2001::85a3:0", "empty hextet in IPv6 prefix"},
-		{"Invalid hextet length", "2001:db8:85a3:12345", "invalid hextet length in IPv6 prefix"},
+		{
+			"Invalid character in hextet",
+			"2001:db8:85a3:g000",
+			`invalid character in hextet: "g" at position 15 (hextet 4)`,
+		},
+		{"IPv4-like address", "192.168.1.1", `invalid character in hextet: "." at position 4 (hextet 1)`},
+		{
+			"Invalid internal empty hextet",
+			"2001::85a3:0",
+			`empty hextet in IPv6 prefix: "::" at position 5 (hextet 2)`,
+		},
+		{
+			"Invalid hextet length",
+			"2001:db8:85a3:12345",
+			`invalid hextet length in IPv6 prefix: "12345" at position 15 (hextet 4)`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -69,3 +81,76 @@ func TestValidateIPv6Prefix(t *testing.T) {
 		})
 	}
 }
+
+// TestValidateIPv6PrefixValidationError verifies that every error is a
+// *ValidationError naming the field and rule, locating the offending part of the
+// input as given, and still matching its sentinel error.
+func TestValidateIPv6PrefixValidationError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		prefix string
+		want   ValidationError
+	}{
+		{
+			name:   "Blank prefix",
+			prefix: " ",
+			want:   ValidationError{Code: CodePrefixRequired, Err: ErrEmptyPrefix, Value: "", Offset: 0, Hextet: 0},
+		},
+		{
+			name:   "Prefix too long",
+			prefix: " 2001:0db8:85a3:abcd:1",
+			want:   ValidationError{Code: CodePrefixTooLong, Err: ErrPrefixLengthExceeds, Value: ":1", Offset: 20, Hextet: 0},
+		},
+		{
+			name:   "Too many hextets",
+			prefix: "1:2:3:4:5:6",
+			want: ValidationError{
+				Code: CodePrefixTooManyHextets, Err: ErrPrefixHextetsExceeds, Value: "5:6", Offset: 8, Hextet: 5,
+			},
+		},
+		{
+			name:   "Invalid character",
+			prefix: "2001:db8:85a3:g000",
+			want:   ValidationError{Code: CodePrefixInvalidChar, Err: ErrInvalidHextetChar, Value: "g", Offset: 14, Hextet: 4},
+		},
+		{
+			name:   "Invalid character after leading whitespace",
+			prefix: "  2001:dbx8 ",
+			want:   ValidationError{Code: CodePrefixInvalidChar, Err: ErrInvalidHextetChar, Value: "x", Offset: 9, Hextet: 2},
+		},
+		{
+			name:   "Multibyte character",
+			prefix: "2001:é",
+			want:   ValidationError{Code: CodePrefixInvalidChar, Err: ErrInvalidHextetChar, Value: "é", Offset: 5, Hextet: 2},
+		},
+		{
+			name:   "Internal empty hextet",
+			prefix: "2001:db8::1",
+			want:   ValidationError{Code: CodePrefixEmptyHextet, Err: ErrEmptyHextet, Value: "::", Offset: 8, Hextet: 3},
+		},
+		{
+			name:   "Hextet too long",
+			prefix: "2001:0db80",
+			want:   ValidationError{Code: CodePrefixHextetLength, Err: ErrInvalidHextetLength, Value: "0db80", Offset: 5, Hextet: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateIPv6Prefix(tt.prefix)
+			require.ErrorIs(t, err, tt.want.Err)
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+
+			tt.want.Field = FieldIPv6Prefix
+			tt.want.Input = tt.prefix
+			assert.Equal(t, tt.want, *validationErr)
+			assert.Equal(t, tt.want.Value, tt.prefix[validationErr.Offset:validationErr.Offset+len(validationErr.Value)])
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package validators
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/errcode"
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+)
+
+// Constants defining the maximum lengths of the link-layer addresses of the
+// link types other than Ethernet.
+const (
+	shortAddressDigits    = 4  // shortAddressDigits is the maximum number of digits of a short address, as in 0x1a2b.
+	extendedAddressStrLen = 23 // extendedAddressStrLen is the maximum string length for "xx:xx:xx:xx:xx:xx:xx:xx".
+	bleAddressStrLen      = 17 // bleAddressStrLen is the maximum string length for "xx:xx:xx:xx:xx:xx".
+)
+
+// shortAddressPrefix optionally starts an IEEE 802.15.4 short address.
+const shortAddressPrefix = "0x"
+
+// Static error variables.
+var (
+	ErrShortAddressRequired      = errcode.New(CodeShortAddressRequired, "IEEE 802.15.4 short address is required")
+	ErrShortAddressLengthExceeds = errcode.New(
+		CodeShortAddressTooLong,
+		fmt.Sprintf(
+			"IEEE 802.15.4 short address exceeds %d hexadecimal digits",
+			shortAddressDigits,
+		),
+	)
+	ErrInvalidShortAddressChar = errcode.New(CodeShortAddressInvalidChar, "invalid character in IEEE 802.15.4 short address")
+	ErrShortAddressParseFailed = errcode.New(CodeShortAddressMalformed, "parsing IEEE 802.15.4 short address")
+
+	ErrExtendedAddressRequired      = errcode.New(CodeExtendedAddressRequired, "IEEE 802.15.4 extended address is required")
+	ErrExtendedAddressLengthExceeds = errcode.New(
+		CodeExtendedAddressTooLong,
+		fmt.Sprintf(
+			"IEEE 802.15.4 extended address string exceeds maximum length of %d characters",
+			extendedAddressStrLen,
+		),
+	)
+	ErrInvalidExtendedAddressChar = errcode.New(CodeExtendedAddressInvalidChar, "invalid character in IEEE 802.15.4 extended address")
+	ErrExtendedAddressParseFailed = errcode.New(CodeExtendedAddressMalformed, "parsing IEEE 802.15.4 extended address")
+
+	ErrBLEAddressRequired      = errcode.New(CodeBLEAddressRequired, "Bluetooth device address is required")
+	ErrBLEAddressLengthExceeds = errcode.New(
+		CodeBLEAddressTooLong,
+		fmt.Sprintf(
+			"Bluetooth device address string exceeds maximum length of %d characters",
+			bleAddressStrLen,
+		),
+	)
+	ErrInvalidBLEAddressChar = errcode.New(CodeBLEAddressInvalidChar, "invalid character in Bluetooth device address")
+	ErrBLEAddressParseFailed = errcode.New(CodeBLEAddressMalformed, "parsing Bluetooth device address")
+)
+
+// addressRules are the field and sentinel errors of the rules a link-layer
+// address written like a MAC address is validated against.
+type addressRules struct {
+	link        eui64.LinkType // link is the link type the address is parsed as.
+	field       Field          // field is the field the address is entered in.
+	maxLen      int            // maxLen is the maximum string length of the address.
+	errRequired error
+	errTooLong  error
+	errInvalid  error
+	errParse    error
+}
+
+// extendedAddressRules validate IEEE 802.15.4 extended addresses.
+var extendedAddressRules = addressRules{
+	link:        eui64.LinkIEEE802154Extended,
+	field:       FieldExtendedAddress,
+	maxLen:      extendedAddressStrLen,
+	errRequired: ErrExtendedAddressRequired,
+	errTooLong:  ErrExtendedAddressLengthExceeds,
+	errInvalid:  ErrInvalidExtendedAddressChar,
+	errParse:    ErrExtendedAddressParseFailed,
+}
+
+// bleAddressRules validate Bluetooth device addresses.
+var bleAddressRules = addressRules{
+	link:        eui64.LinkBLE,
+	field:       FieldBLEAddress,
+	maxLen:      bleAddressStrLen,
+	errRequired: ErrBLEAddressRequired,
+	errTooLong:  ErrBLEAddressLengthExceeds,
+	errInvalid:  ErrInvalidBLEAddressChar,
+	errParse:    ErrBLEAddressParseFailed,
+}
+
+// ValidateLinkAddress validates a link-layer address of the given link type
+// with the type's validator: ValidateMAC, ValidateShortAddress,
+// ValidateExtendedAddress, ValidateBLEAddress, or ValidateToken. Unknown link
+// types are reported as a *ValidationError of FieldLinkType wrapping
+// eui64.ErrUnknownLinkType.
+func ValidateLinkAddress(link eui64.LinkType, address string) error {
+	switch link {
+	case eui64.LinkEthernet:
+		return ValidateMAC(address)
+	case eui64.LinkIEEE802154Short:
+		return ValidateShortAddress(address)
+	case eui64.LinkIEEE802154Extended:
+		return ValidateExtendedAddress(address)
+	case eui64.LinkBLE:
+		return ValidateBLEAddress(address)
+	case eui64.LinkToken:
+		return ValidateToken(address)
+	default:
+		return &ValidationError{
+			Field:  FieldLinkType,
+			Code:   CodeLinkTypeUnknown,
+			Input:  string(link),
+			Value:  "",
+			Offset: 0,
+			Hextet: 0,
+			Err:    fmt.Errorf("%w: %q", eui64.ErrUnknownLinkType, link),
+		}
+	}
+}
+
+// ValidateShortAddress validates an IEEE 802.15.4 short address: one to four
+// hexadecimal digits, optionally prefixed with 0x. Returns a *ValidationError
+// locating the first character that is not a hexadecimal digit or the digits
+// beyond the fourth.
+func ValidateShortAddress(address string) error {
+	input := address
+	start := leadingSpace(input)
+
+	address = strings.TrimSpace(address)
+	if address == "" {
+		return fieldError(FieldShortAddress, input, ErrShortAddressRequired, 0, "")
+	}
+
+	if strings.HasPrefix(strings.ToLower(address), shortAddressPrefix) {
+		start += len(shortAddressPrefix)
+		address = address[len(shortAddressPrefix):]
+	}
+
+	for offset, char := range address {
+		if !isHexDigit(char) {
+			return fieldError(FieldShortAddress, input, ErrInvalidShortAddressChar, start+offset, string(char))
+		}
+	}
+
+	if address == "" {
+		return fieldError(FieldShortAddress, input, ErrShortAddressParseFailed, 0, "")
+	}
+
+	if len(address) > shortAddressDigits {
+		return fieldError(
+			FieldShortAddress,
+			input,
+			ErrShortAddressLengthExceeds,
+			start+shortAddressDigits,
+			address[shortAddressDigits:],
+		)
+	}
+
+	return nil
+}
+
+// ValidateExtendedAddress validates an IEEE 802.15.4 extended address: eight
+// pairs of hexadecimal digits, separated like a MAC address or not at all.
+// Returns a *ValidationError like ValidateMAC's.
+func ValidateExtendedAddress(address string) error {
+	return validateAddress(address, extendedAddressRules)
+}
+
+// ValidateBLEAddress validates a Bluetooth Low Energy device address, written
+// like a MAC address. Returns a *ValidationError like ValidateMAC's.
+func ValidateBLEAddress(address string) error {
+	return validateAddress(address, bleAddressRules)
+}
+
+// validateAddress validates a link-layer address written like a MAC address
+// against the given rules, as ValidateMAC validates MAC addresses.
+func validateAddress(address string, rules addressRules) error {
+	input := address
+	start := leadingSpace(input)
+
+	address = strings.TrimSpace(address)
+	if address == "" {
+		return fieldError(rules.field, input, rules.errRequired, 0, "")
+	}
+
+	if len(address) > rules.maxLen {
+		excess := runeBoundary(address, rules.maxLen)
+
+		return fieldError(rules.field, input, rules.errTooLong, start+excess, address[excess:])
+	}
+
+	_, err := eui64.InterfaceID(rules.link, address)
+	if err != nil {
+		err = fmt.Errorf("%w: %w", rules.errParse, err)
+
+		for offset, char := range address {
+			if !isHexDigit(char) && !strings.ContainsRune(macSeparators, char) {
+				return fieldError(
+					rules.field,
+					input,
+					fmt.Errorf("%w: %w", rules.errInvalid, err),
+					start+offset,
+					string(char),
+				)
+			}
+		}
+
+		return fieldError(rules.field, input, err, 0, "")
+	}
+
+	return nil
+}
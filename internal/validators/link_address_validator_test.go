@@ -0,0 +1,180 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+)
+
+// TestValidateLinkAddress tests that ValidateLinkAddress accepts the addresses
+// of each link type in the notations they are written in.
+func TestValidateLinkAddress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		link    eui64.LinkType
+		address string
+	}{
+		{"Ethernet MAC address", eui64.LinkEthernet, "00-14-22-01-23-45"},
+		{"Short address", eui64.LinkIEEE802154Short, "0x1A2B"},
+		{"Short address without 0x", eui64.LinkIEEE802154Short, " 1 "},
+		{"Extended address with colons", eui64.LinkIEEE802154Extended, "00:12:4b:00:01:02:03:04"},
+		{"Extended address as digits", eui64.LinkIEEE802154Extended, "00124b0001020304"},
+		{"BLE address", eui64.LinkBLE, "c2-14-22-01-23-45"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.NoError(t, ValidateLinkAddress(tt.link, tt.address))
+		})
+	}
+}
+
+// TestValidateLinkAddressValidationError verifies that every error is a
+// *ValidationError naming the field and rule, locating the offending
+// characters, and still matching its sentinel error.
+func TestValidateLinkAddressValidationError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		link       eui64.LinkType
+		address    string
+		wantErr    error
+		wantField  Field
+		wantCode   Code
+		wantValue  string
+		wantOffset int
+	}{
+		{
+			name:      "Empty short address",
+			link:      eui64.LinkIEEE802154Short,
+			address:   " ",
+			wantErr:   ErrShortAddressRequired,
+			wantField: FieldShortAddress,
+			wantCode:  CodeShortAddressRequired,
+		},
+		{
+			name:       "Short address too long",
+			link:       eui64.LinkIEEE802154Short,
+			address:    "0x1a2b3",
+			wantErr:    ErrShortAddressLengthExceeds,
+			wantField:  FieldShortAddress,
+			wantCode:   CodeShortAddressTooLong,
+			wantValue:  "3",
+			wantOffset: 6,
+		},
+		{
+			name:       "Invalid character in short address",
+			link:       eui64.LinkIEEE802154Short,
+			address:    " 0x1-2",
+			wantErr:    ErrInvalidShortAddressChar,
+			wantField:  FieldShortAddress,
+			wantCode:   CodeShortAddressInvalidChar,
+			wantValue:  "-",
+			wantOffset: 4,
+		},
+		{
+			name:      "Short address without digits",
+			link:      eui64.LinkIEEE802154Short,
+			address:   "0x",
+			wantErr:   ErrShortAddressParseFailed,
+			wantField: FieldShortAddress,
+			wantCode:  CodeShortAddressMalformed,
+		},
+		{
+			name:      "Empty extended address",
+			link:      eui64.LinkIEEE802154Extended,
+			address:   "",
+			wantErr:   ErrExtendedAddressRequired,
+			wantField: FieldExtendedAddress,
+			wantCode:  CodeExtendedAddressRequired,
+		},
+		{
+			name:       "Extended address too long",
+			link:       eui64.LinkIEEE802154Extended,
+			address:    "00:12:4b:00:01:02:03:04:05",
+			wantErr:    ErrExtendedAddressLengthExceeds,
+			wantField:  FieldExtendedAddress,
+			wantCode:   CodeExtendedAddressTooLong,
+			wantValue:  ":05",
+			wantOffset: 23,
+		},
+		{
+			name:       "Invalid character in extended address",
+			link:       eui64.LinkIEEE802154Extended,
+			address:    "00:12:4b:00:01:02:03:0z",
+			wantErr:    ErrInvalidExtendedAddressChar,
+			wantField:  FieldExtendedAddress,
+			wantCode:   CodeExtendedAddressInvalidChar,
+			wantValue:  "z",
+			wantOffset: 22,
+		},
+		{
+			name:      "MAC address as extended address",
+			link:      eui64.LinkIEEE802154Extended,
+			address:   "00-14-22-01-23-45",
+			wantErr:   ErrExtendedAddressParseFailed,
+			wantField: FieldExtendedAddress,
+			wantCode:  CodeExtendedAddressMalformed,
+		},
+		{
+			name:       "BLE address too long",
+			link:       eui64.LinkBLE,
+			address:    "00:12:4b:00:01:02:03:04",
+			wantErr:    ErrBLEAddressLengthExceeds,
+			wantField:  FieldBLEAddress,
+			wantCode:   CodeBLEAddressTooLong,
+			wantValue:  ":03:04",
+			wantOffset: 17,
+		},
+		{
+			name:       "Invalid character in BLE address",
+			link:       eui64.LinkBLE,
+			address:    "00_14_22_01_23_45",
+			wantErr:    ErrInvalidBLEAddressChar,
+			wantField:  FieldBLEAddress,
+			wantCode:   CodeBLEAddressInvalidChar,
+			wantValue:  "_",
+			wantOffset: 2,
+		},
+		{
+			name:      "Short BLE address",
+			link:      eui64.LinkBLE,
+			address:   "00-14-22-01-23",
+			wantErr:   ErrBLEAddressParseFailed,
+			wantField: FieldBLEAddress,
+			wantCode:  CodeBLEAddressMalformed,
+		},
+		{
+			name:      "Unknown link type",
+			link:      "token-ring",
+			address:   "00-14-22-01-23-45",
+			wantErr:   eui64.ErrUnknownLinkType,
+			wantField: FieldLinkType,
+			wantCode:  CodeLinkTypeUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateLinkAddress(tt.link, tt.address)
+			require.ErrorIs(t, err, tt.wantErr)
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.wantField, validationErr.Field)
+			assert.Equal(t, tt.wantCode, validationErr.Code)
+			assert.Equal(t, tt.wantValue, validationErr.Value)
+			assert.Equal(t, tt.wantOffset, validationErr.Offset)
+		})
+	}
+}
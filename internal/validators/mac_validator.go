@@ -1,10 +1,11 @@
 package validators
 
 import (
-	"errors"
 	"fmt"
 	"net"
 	"strings"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/errcode"
 )
 
 // Constant defining the maximum string length for a MAC address.
@@ -12,33 +13,62 @@ const (
 	macStrLen = 17 // macStrLen is the maximum string length for "xx-xx-xx-xx-xx-xx".
 )
 
+// macSeparators lists the characters separating the groups of hexadecimal digits
+// in the MAC address notations accepted by net.ParseMAC.
+const macSeparators = "-:."
+
 // Static error variables.
 var (
-	ErrMACRequired      = errors.New("MAC address is required")
-	ErrMACLengthExceeds = fmt.Errorf(
-		"MAC address string exceeds maximum length of %d characters",
-		macStrLen,
+	ErrMACRequired      = errcode.New(CodeMACRequired, "MAC address is required")
+	ErrMACLengthExceeds = errcode.New(
+		CodeMACTooLong,
+		fmt.Sprintf(
+			"MAC address string exceeds maximum length of %d characters",
+			macStrLen,
+		),
 	)
-	ErrMACParseFailed = errors.New("parsing MAC address")
+	ErrMACParseFailed = errcode.New(CodeMACMalformed, "parsing MAC address")
+	ErrInvalidMACChar = errcode.New(CodeMACInvalidChar, "invalid character in MAC address")
 )
 
 // ValidateMAC validates a MAC address string for correctness.
 // It trims whitespace, ensures the address is non-empty, checks the string length,
 // and parses it into a valid MAC address using net.ParseMAC.
-// Returns an error if the MAC address is invalid or exceeds the maximum length.
+// Returns a *ValidationError if the MAC address is invalid or exceeds the maximum
+// length, locating the characters beyond the maximum length or, when parsing fails
+// because of a character that cannot appear in a MAC address, that character.
 func ValidateMAC(macStr string) error {
+	input := macStr
+	start := leadingSpace(input)
+
 	macStr = strings.TrimSpace(macStr)
 	if macStr == "" {
-		return ErrMACRequired
+		return fieldError(FieldMAC, input, ErrMACRequired, 0, "")
 	}
 
 	if len(macStr) > macStrLen {
-		return ErrMACLengthExceeds
+		excess := runeBoundary(macStr, macStrLen)
+
+		return fieldError(FieldMAC, input, ErrMACLengthExceeds, start+excess, macStr[excess:])
 	}
 
 	_, err := net.ParseMAC(macStr)
 	if err != nil {
-		return fmt.Errorf("%w: %w", ErrMACParseFailed, err)
+		err = fmt.Errorf("%w: %w", ErrMACParseFailed, err)
+
+		for offset, char := range macStr {
+			if !isHexDigit(char) && !strings.ContainsRune(macSeparators, char) {
+				return fieldError(
+					FieldMAC,
+					input,
+					fmt.Errorf("%w: %w", ErrInvalidMACChar, err),
+					start+offset,
+					string(char),
+				)
+			}
+		}
+
+		return fieldError(FieldMAC, input, err, 0, "")
 	}
 
 	return nil
@@ -22,6 +22,7 @@ func TestValidateMAC(t *testing.T) {
 		// Valid cases
 		{"Valid MAC with hyphens", "00-14-22-01-23-45", ""},
 		{"Valid MAC with colons", "00:14:22:01:23:45", ""},
+		{"Valid MAC with dots", "0014.2201.2345", ""},
 
 		// Empty check
 		{"Empty MAC", "", "MAC address is required"},
@@ -45,7 +46,7 @@ func TestValidateMAC(t *testing.T) {
 		},
 
 		// Parsing errors
-		{"Invalid MAC format (non-hex)", "invalid-mac", "parsing MAC address"},
+		{"Invalid MAC format (non-hex)", "invalid-mac", `"i" at position 1`},
 		{"MAC too short", "00-14-22-01-23", "parsing MAC address"},
 	}
 
@@ -63,3 +64,65 @@ func TestValidateMAC(t *testing.T) {
 		})
 	}
 }
+
+// TestValidateMACValidationError verifies that every error is a *ValidationError
+// naming the field and rule, locating the characters beyond the maximum length or
+// the first character that cannot appear in a MAC address, and still matching its
+// sentinel error.
+func TestValidateMACValidationError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		mac        string
+		wantErr    error
+		wantCode   Code
+		wantValue  string
+		wantOffset int
+	}{
+		{name: "Empty", mac: "", wantErr: ErrMACRequired, wantCode: CodeMACRequired},
+		{
+			name:       "Too long",
+			mac:        "00-14-22-01-23-45-67",
+			wantErr:    ErrMACLengthExceeds,
+			wantCode:   CodeMACTooLong,
+			wantValue:  "-67",
+			wantOffset: 17,
+		},
+		{
+			name:       "Invalid letter",
+			mac:        "00-14-22-01-23-4g",
+			wantErr:    ErrInvalidMACChar,
+			wantCode:   CodeMACInvalidChar,
+			wantValue:  "g",
+			wantOffset: 16,
+		},
+		{
+			name:       "Invalid separator after leading whitespace",
+			mac:        " 00_14_22_01_23_45",
+			wantErr:    ErrMACParseFailed,
+			wantCode:   CodeMACInvalidChar,
+			wantValue:  "_",
+			wantOffset: 3,
+		},
+		{name: "Missing group", mac: "00-14-22-01-23", wantErr: ErrMACParseFailed, wantCode: CodeMACMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateMAC(tt.mac)
+			require.ErrorIs(t, err, tt.wantErr)
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, FieldMAC, validationErr.Field)
+			assert.Equal(t, tt.wantCode, validationErr.Code)
+			assert.Equal(t, tt.mac, validationErr.Input)
+			assert.Equal(t, tt.wantValue, validationErr.Value)
+			assert.Equal(t, tt.wantOffset, validationErr.Offset)
+			assert.Zero(t, validationErr.Hextet)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/errcode"
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+)
+
+// Static error variables.
+var (
+	ErrTokenRequired      = errcode.New(CodeTokenRequired, "interface ID token is required")
+	ErrTokenLengthExceeds = errcode.New(
+		CodeTokenTooLong,
+		fmt.Sprintf(
+			"interface ID token exceeds maximum length of %d characters",
+			maxPrefixStrLength,
+		),
+	)
+	ErrInvalidTokenChar   = errcode.New(CodeTokenInvalidChar, "invalid character in interface ID token")
+	ErrInvalidTokenHextet = errcode.New(CodeTokenHextetLength, "invalid hextet length in interface ID token")
+	ErrTokenMalformed     = errcode.New(
+		CodeTokenMalformed,
+		fmt.Sprintf(`interface ID token must be up to %d hextets separated by colons, with at most one "::" standing for at least one hextet`, maxHextets),
+	)
+	ErrTokenZero = errcode.New(CodeTokenZero, "interface ID token must not be zero")
+)
+
+// ValidateToken validates an interface ID token, such as ::1:2, by parsing it
+// with eui64.InterfaceID as eui64.LinkToken: up to four hextets of up to four
+// hexadecimal digits, separated by colons, with at most one "::" standing for
+// the zero hextets it omits, as Linux's ip token and static assignments write
+// the interface ID, and not zero. Returns a *ValidationError locating the
+// characters beyond the maximum length, the first character that is not a
+// hexadecimal digit or colon, or the first hextet that is too long.
+func ValidateToken(token string) error {
+	input := token
+	start := leadingSpace(input)
+
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return fieldError(FieldToken, input, ErrTokenRequired, 0, "")
+	}
+
+	if len(token) > maxPrefixStrLength {
+		excess := runeBoundary(token, maxPrefixStrLength)
+
+		return fieldError(FieldToken, input, ErrTokenLengthExceeds, start+excess, token[excess:])
+	}
+
+	_, err := eui64.InterfaceID(eui64.LinkToken, token)
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, eui64.ErrZeroToken) {
+		return fieldError(FieldToken, input, fmt.Errorf("%w: %w", ErrTokenZero, err), 0, "")
+	}
+
+	for offset, char := range token {
+		if !isHexDigit(char) && char != ':' {
+			return fieldError(FieldToken, input, fmt.Errorf("%w: %w", ErrInvalidTokenChar, err), start+offset, string(char))
+		}
+	}
+
+	offset := start // offset is the byte offset of the current hextet in input.
+
+	for hextet := range strings.SplitSeq(token, ":") {
+		if len(hextet) > maxHextetLength {
+			return fieldError(FieldToken, input, fmt.Errorf("%w: %w", ErrInvalidTokenHextet, err), offset, hextet)
+		}
+
+		offset += len(hextet) + 1
+	}
+
+	return fieldError(FieldToken, input, fmt.Errorf("%w: %w", ErrTokenMalformed, err), 0, "")
+}
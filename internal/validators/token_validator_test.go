@@ -0,0 +1,146 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+)
+
+// TestValidateToken tests that ValidateToken accepts the interface ID tokens
+// eui64.CalculateLinkAddress accepts for eui64.LinkToken.
+func TestValidateToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"Token as set with ip token", "::1:2"},
+		{"Token without ::", "1:2"},
+		{"Token compressed in the middle", " 1::2 "},
+		{"Token compressed at the end", "a::"},
+		{"Token of four hextets", "DEAD:beef:0:1"},
+		{"Token with zero hextets", "0::1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.NoError(t, ValidateToken(tt.token))
+
+			_, err := eui64.InterfaceID(eui64.LinkToken, tt.token)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// TestValidateTokenValidationError verifies that every error is a
+// *ValidationError naming the rule, locating the offending characters, and
+// still matching its sentinel error.
+func TestValidateTokenValidationError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		token      string
+		wantErr    error
+		wantCode   Code
+		wantValue  string
+		wantOffset int
+	}{
+		{
+			name:     "Empty token",
+			token:    " ",
+			wantErr:  ErrTokenRequired,
+			wantCode: CodeTokenRequired,
+		},
+		{
+			name:       "Token too long",
+			token:      "0000:0000:0000:0001:2",
+			wantErr:    ErrTokenLengthExceeds,
+			wantCode:   CodeTokenTooLong,
+			wantValue:  ":2",
+			wantOffset: 19,
+		},
+		{
+			name:       "Invalid character in token",
+			token:      " ::1:g",
+			wantErr:    ErrInvalidTokenChar,
+			wantCode:   CodeTokenInvalidChar,
+			wantValue:  "g",
+			wantOffset: 5,
+		},
+		{
+			name:     "Token of five hextets",
+			token:    "1:2:3:4:5",
+			wantErr:  ErrTokenMalformed,
+			wantCode: CodeTokenMalformed,
+		},
+		{
+			name:     "Compressed token of four hextets",
+			token:    "1:2::3:4",
+			wantErr:  ErrTokenMalformed,
+			wantCode: CodeTokenMalformed,
+		},
+		{
+			name:       "Hextet too long after ::",
+			token:      "1::2:12345",
+			wantErr:    ErrInvalidTokenHextet,
+			wantCode:   CodeTokenHextetLength,
+			wantValue:  "12345",
+			wantOffset: 5,
+		},
+		{
+			name:       "Hextet of 5 digits in a token of four hextets",
+			token:      "1:0:0:00001",
+			wantErr:    ErrInvalidTokenHextet,
+			wantCode:   CodeTokenHextetLength,
+			wantValue:  "00001",
+			wantOffset: 6,
+		},
+		{
+			name:     "Zero token",
+			token:    "::",
+			wantErr:  ErrTokenZero,
+			wantCode: CodeTokenZero,
+		},
+		{
+			name:     "Zero token without ::",
+			token:    " 0:0:0:0 ",
+			wantErr:  ErrTokenZero,
+			wantCode: CodeTokenZero,
+		},
+		{
+			name:     "Token compressed twice",
+			token:    "1::2::3",
+			wantErr:  ErrTokenMalformed,
+			wantCode: CodeTokenMalformed,
+		},
+		{
+			name:     "Token with an empty hextet",
+			token:    ":1",
+			wantErr:  ErrTokenMalformed,
+			wantCode: CodeTokenMalformed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateLinkAddress(eui64.LinkToken, tt.token)
+			require.ErrorIs(t, err, tt.wantErr)
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, FieldToken, validationErr.Field)
+			assert.Equal(t, tt.wantCode, validationErr.Code)
+			assert.Equal(t, tt.wantValue, validationErr.Value)
+			assert.Equal(t, tt.wantOffset, validationErr.Offset)
+		})
+	}
+}
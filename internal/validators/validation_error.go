@@ -0,0 +1,169 @@
+package validators
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/errcode"
+)
+
+// Field identifies the input a ValidationError refers to.
+type Field string
+
+// Fields validated by this package. The link-layer addresses of the link types
+// other than Ethernet are entered in place of the MAC address, but are fields of
+// their own.
+const (
+	FieldMAC             Field = "mac"
+	FieldIPv6Prefix      Field = "prefix"
+	FieldLinkType        Field = "link_type"
+	FieldShortAddress    Field = "short_address"
+	FieldExtendedAddress Field = "extended_address"
+	FieldBLEAddress      Field = "ble_address"
+	FieldToken           Field = "token"
+)
+
+// Code is a machine-readable identifier of the rule a ValidationError violates.
+// Codes are stable and may be relied on by clients.
+type Code = errcode.Code
+
+// Codes of the validation rules, one per sentinel error, which carries it.
+const (
+	CodeMACRequired          Code = "mac.required"
+	CodeMACTooLong           Code = "mac.too_long"
+	CodeMACInvalidChar       Code = "mac.invalid_character"
+	CodeMACMalformed         Code = "mac.malformed"
+	CodePrefixRequired       Code = "prefix.required"
+	CodePrefixTooLong        Code = "prefix.too_long"
+	CodePrefixTooManyHextets Code = "prefix.too_many_hextets"
+	CodePrefixEmptyHextet    Code = "prefix.empty_hextet"
+	CodePrefixInvalidChar    Code = "prefix.invalid_character"
+	CodePrefixHextetLength   Code = "prefix.hextet_length"
+
+	CodeLinkTypeUnknown            Code = "link_type.unknown"
+	CodeShortAddressRequired       Code = "short_address.required"
+	CodeShortAddressTooLong        Code = "short_address.too_long"
+	CodeShortAddressInvalidChar    Code = "short_address.invalid_character"
+	CodeShortAddressMalformed      Code = "short_address.malformed"
+	CodeExtendedAddressRequired    Code = "extended_address.required"
+	CodeExtendedAddressTooLong     Code = "extended_address.too_long"
+	CodeExtendedAddressInvalidChar Code = "extended_address.invalid_character"
+	CodeExtendedAddressMalformed   Code = "extended_address.malformed"
+	CodeBLEAddressRequired         Code = "ble_address.required"
+	CodeBLEAddressTooLong          Code = "ble_address.too_long"
+	CodeBLEAddressInvalidChar      Code = "ble_address.invalid_character"
+	CodeBLEAddressMalformed        Code = "ble_address.malformed"
+
+	CodeTokenRequired     Code = "token.required"
+	CodeTokenTooLong      Code = "token.too_long"
+	CodeTokenInvalidChar  Code = "token.invalid_character"
+	CodeTokenHextetLength Code = "token.hextet_length"
+	CodeTokenMalformed    Code = "token.malformed"
+	CodeTokenZero         Code = "token.zero"
+)
+
+// ValidationError describes why an input failed validation and where. It wraps
+// the sentinel error of the violated rule, so callers can test for it with
+// errors.Is, and errors.As retrieves the details. Every error returned by the
+// validators is a *ValidationError.
+type ValidationError struct {
+	// Field is the input that failed validation.
+	Field Field
+	// Code identifies the violated rule.
+	Code Code
+	// Input is the value that was validated, as given.
+	Input string
+	// Value is the offending part of Input, such as a character or a hextet, or
+	// empty if the error does not refer to a specific part.
+	Value string
+	// Offset is the byte offset of Value in Input.
+	Offset int
+	// Hextet is the 1-based index of the IPv6 prefix hextet containing Value,
+	// or 0 if the error does not refer to a hextet.
+	Hextet int
+	// Err is the error describing the failure, wrapping the rule's sentinel error.
+	Err error
+}
+
+// Error returns the wrapped error's message followed, if the error refers to
+// a specific part of the input, by that part and its position.
+func (e *ValidationError) Error() string {
+	if e.Value == "" {
+		return e.Err.Error()
+	}
+
+	var message strings.Builder
+
+	fmt.Fprintf(&message, "%s: %q at position %d", e.Err, e.Value, e.Position())
+
+	if e.Hextet > 0 {
+		fmt.Fprintf(&message, " (hextet %d)", e.Hextet)
+	}
+
+	return message.String()
+}
+
+// Unwrap returns the wrapped error.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// Position returns the 1-based character position of Value in Input.
+func (e *ValidationError) Position() int {
+	return utf8.RuneCountInString(e.Input[:e.Offset]) + 1
+}
+
+// Location returns Value, its position, and Hextet, implementing
+// errcode.Located.
+func (e *ValidationError) Location() (string, int, int) {
+	return e.Value, e.Position(), e.Hextet
+}
+
+// LogValue logs the error as a group of its details, so log records show where
+// the input was invalid.
+func (e *ValidationError) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("message", e.Error()),
+		slog.String("field", string(e.Field)),
+		slog.String("code", string(e.Code)),
+		slog.String("value", e.Value),
+		slog.Int("offset", e.Offset),
+	)
+}
+
+// fieldError returns a *ValidationError about the input of the given field,
+// identified by the code of the sentinel error err wraps, locating value at the
+// given byte offset.
+func fieldError(field Field, input string, err error, offset int, value string) error {
+	code, _ := errcode.Of(err)
+
+	return &ValidationError{
+		Field:  field,
+		Code:   code,
+		Input:  input,
+		Value:  value,
+		Offset: offset,
+		Hextet: 0,
+		Err:    err,
+	}
+}
+
+// leadingSpace returns the byte length of the whitespace trimmed from the start
+// of input by strings.TrimSpace, to convert offsets in the trimmed input into
+// offsets in input.
+func leadingSpace(input string) int {
+	return len(input) - len(strings.TrimLeftFunc(input, unicode.IsSpace))
+}
+
+// runeBoundary returns the byte offset of the first rune of s starting at or
+// after offset n.
+func runeBoundary(s string, n int) int {
+	for n < len(s) && !utf8.RuneStart(s[n]) {
+		n++
+	}
+
+	return n
+}
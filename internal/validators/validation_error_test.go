@@ -0,0 +1,91 @@
+package validators
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+)
+
+// TestValidationError tests the message and position of ValidationError, with
+// and without an offending part of the input.
+func TestValidationError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		err          ValidationError
+		wantMessage  string
+		wantPosition int
+	}{
+		{
+			name: "Without offending part",
+			err: ValidationError{
+				Field: FieldMAC, Code: CodeMACRequired, Input: "", Value: "", Offset: 0, Hextet: 0, Err: ErrMACRequired,
+			},
+			wantMessage:  "MAC address is required",
+			wantPosition: 1,
+		},
+		{
+			name: "Offending character after a multibyte character",
+			err: ValidationError{
+				Field:  FieldIPv6Prefix,
+				Code:   CodePrefixInvalidChar,
+				Input:  "é:x",
+				Value:  "x",
+				Offset: 3,
+				Hextet: 2,
+				Err:    ErrInvalidHextetChar,
+			},
+			wantMessage:  `invalid character in hextet: "x" at position 3 (hextet 2)`,
+			wantPosition: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.wantMessage, tt.err.Error())
+			assert.Equal(t, tt.wantPosition, tt.err.Position())
+			assert.ErrorIs(t, &tt.err, tt.err.Err)
+		})
+	}
+}
+
+// TestValidationErrorLogValue verifies that validation errors are logged with
+// their details.
+func TestValidationErrorLogValue(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	logger.Info("Validation failed", "error", ValidateIPv6Prefix("2001:db8:g"))
+
+	assert.Contains(t, buf.String(), "error.field=prefix")
+	assert.Contains(t, buf.String(), "error.code=prefix.invalid_character")
+	assert.Contains(t, buf.String(), "error.value=g")
+	assert.Contains(t, buf.String(), "error.offset=9")
+}
+
+// TestFieldError verifies that fieldError names the given field and identifies
+// the error by the code of the sentinel error it wraps.
+func TestFieldError(t *testing.T) {
+	t.Parallel()
+
+	err := fieldError(FieldToken, " ::g", fmt.Errorf("%w: %w", ErrInvalidTokenChar, eui64.ErrParseToken), 3, "g")
+
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, FieldToken, validationErr.Field)
+	assert.Equal(t, CodeTokenInvalidChar, validationErr.Code)
+	assert.Equal(t, "g", validationErr.Value)
+	assert.Equal(t, 4, validationErr.Position())
+	assert.ErrorIs(t, err, ErrInvalidTokenChar)
+}
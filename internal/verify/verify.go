@@ -0,0 +1,285 @@
+// Package verify checks addresses observed on a network, such as those in the
+// neighbor tables of switches and routers, against the MAC addresses of the
+// hosts expected to use them, confirming which hosts form their addresses with
+// EUI-64 SLAAC. A mismatch is explained: the address may be in another prefix
+// than expected, have the EUI-64 interface identifier of another MAC address,
+// or have an interface identifier formed by another scheme, such as a privacy
+// address. Pairs can be verified one at a time or in bulk from CSV.
+package verify
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/netip"
+	"strings"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/analyzer"
+	"github.com/nicholas-fedor/eui64-calculator/internal/csvstream"
+	"github.com/nicholas-fedor/eui64-calculator/internal/errcode"
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+	"github.com/nicholas-fedor/eui64-calculator/internal/subnet"
+	"github.com/nicholas-fedor/eui64-calculator/internal/validators"
+)
+
+// Status is the outcome of verifying an address against a MAC address.
+type Status string
+
+// Outcomes of verifications. Mismatches are listed in the order they are checked.
+const (
+	StatusMatch          Status = "match"           // StatusMatch is the EUI-64 address of the MAC address.
+	StatusNotEUI64       Status = "not_eui64"       // StatusNotEUI64 has an interface ID formed by another scheme.
+	StatusIIDMismatch    Status = "iid_mismatch"    // StatusIIDMismatch has the EUI-64 interface ID of another MAC address.
+	StatusPrefixMismatch Status = "prefix_mismatch" // StatusPrefixMismatch has the expected interface ID in another prefix.
+)
+
+// Input identifies the value of a pair that could not be verified.
+type Input string
+
+// Values of a pair.
+const (
+	InputMAC     Input = "mac"
+	InputAddress Input = "address"
+	InputPrefix  Input = "prefix"
+)
+
+// MaxPairs is the maximum number of pairs verified from CSV at once.
+const MaxPairs = 1 << 16
+
+// Fields of a CSV record: the MAC address, the observed address, and the
+// optional expected prefix.
+const (
+	minFields = 2
+	maxFields = 3
+)
+
+// Layout of addresses: a /64 prefix followed by the interface identifier.
+const (
+	prefixBits = 64
+	iidOffset  = 8
+)
+
+// Static error variables.
+var (
+	ErrNoInterfaceID = errcode.New("verify.no_interface_id", "address has no interface identifier")
+	ErrInvalidCSV    = errcode.New("verify.csv_invalid", "invalid CSV")
+	ErrRecordFields  = errcode.New("verify.csv_fields", fmt.Sprintf("CSV records must have %d or %d fields", minFields, maxFields))
+	ErrNoPairs       = errcode.New("verify.no_pairs", "at least one pair of a MAC address and an IPv6 address is expected")
+	ErrTooManyPairs  = errcode.New("verify.too_many_pairs", fmt.Sprintf("CSV exceeds %d pairs", MaxPairs))
+)
+
+// header is the header row of the CSV output.
+var header = []string{
+	"mac", "address", "prefix", "status", "expected_address", "interface_id", "iid_type", "observed_mac", "error",
+}
+
+// InputError is returned when a value of a pair is invalid, naming the value.
+type InputError struct {
+	Input Input // Input is the invalid value.
+	Err   error // Err explains why the value is invalid.
+}
+
+// Error returns the message of the underlying error.
+func (e *InputError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *InputError) Unwrap() error {
+	return e.Err
+}
+
+// Pair is a MAC address and the address observed for it, with the prefix the
+// address is expected in, if known.
+type Pair struct {
+	MAC     string
+	Address string
+	Prefix  string // Prefix is empty to accept the address in any prefix.
+}
+
+// Result is the outcome of verifying a pair. Err is set, and the outcome empty,
+// if a value of the pair is invalid or the calculation fails.
+type Result struct {
+	Pair
+
+	Status      Status           // Status is the outcome of the verification.
+	Expected    string           // Expected is the EUI-64 address of the MAC address in the expected prefix, or in the address's.
+	InterfaceID string           // InterfaceID is the interface identifier of the address as four hextets.
+	IIDType     analyzer.IIDType // IIDType is how the interface identifier was likely formed.
+	ObservedMAC string           // ObservedMAC is the MAC address an EUI-64 interface identifier was derived from.
+	Err         error
+}
+
+// Verify checks whether the address observed for a MAC address is the MAC
+// address's EUI-64 address, calculated with calc, and in the expected prefix,
+// given as up to four hextets like the calculator's, unless it is empty. A
+// value that cannot be verified is reported as an *InputError naming it.
+func Verify(calc eui64.Calculator, pair Pair) Result {
+	result := Result{
+		Pair:        pair,
+		Status:      "",
+		Expected:    "",
+		InterfaceID: "",
+		IIDType:     analyzer.IIDNone,
+		ObservedMAC: "",
+		Err:         nil,
+	}
+
+	if err := validators.ValidateMAC(pair.MAC); err != nil {
+		result.Err = &InputError{Input: InputMAC, Err: err}
+
+		return result
+	}
+
+	analysis, err := analyzer.Analyze(pair.Address)
+	if err != nil {
+		result.Err = &InputError{Input: InputAddress, Err: err}
+
+		return result
+	}
+
+	if analysis.IIDType == analyzer.IIDNone {
+		result.Err = &InputError{Input: InputAddress, Err: fmt.Errorf("%w: %s", ErrNoInterfaceID, analysis.Address)}
+
+		return result
+	}
+
+	prefix := pair.Prefix
+	if prefix == "" {
+		prefix = subnet.Hextets(netip.PrefixFrom(analysis.Address.WithZone(""), prefixBits).Masked())
+	} else if err := validators.ValidateIPv6Prefix(prefix); err != nil {
+		result.Err = &InputError{Input: InputPrefix, Err: err}
+
+		return result
+	}
+
+	_, expected, err := calc.CalculateEUI64(pair.MAC, prefix)
+	if err != nil {
+		result.Err = err
+
+		return result
+	}
+
+	expectedAddr, err := netip.ParseAddr(expected)
+	if err != nil {
+		result.Err = fmt.Errorf("parsing calculated address %q: %w", expected, err)
+
+		return result
+	}
+
+	result.Expected = expected
+	result.InterfaceID = analysis.InterfaceID
+	result.IIDType = analysis.IIDType
+	result.ObservedMAC = analysis.MAC
+	result.Status = status(analysis, expectedAddr)
+
+	return result
+}
+
+// status compares an analyzed address with the expected one: interface
+// identifiers first, as a host using another scheme or MAC address is not
+// using EUI-64 SLAAC with this one in any prefix, then prefixes.
+func status(analysis analyzer.Analysis, expected netip.Addr) Status {
+	observed := analysis.Address.As16()
+	want := expected.As16()
+
+	switch {
+	case analysis.IIDType != analyzer.IIDEUI64:
+		return StatusNotEUI64
+	case [iidOffset]byte(observed[iidOffset:]) != [iidOffset]byte(want[iidOffset:]):
+		return StatusIIDMismatch
+	case [iidOffset]byte(observed[:iidOffset]) != [iidOffset]byte(want[:iidOffset]):
+		return StatusPrefixMismatch
+	default:
+		return StatusMatch
+	}
+}
+
+// ParseCSV reads pairs from CSV records of a MAC address, an address, and an
+// optional expected prefix, such as those exported from a neighbor table.
+// Fields are trimmed, blank lines are skipped, and a header row starting with
+// "mac" is skipped. At least one and at most MaxPairs pairs are expected.
+func ParseCSV(r io.Reader) ([]Pair, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var pairs []Pair
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidCSV, err)
+		}
+
+		if len(pairs) == 0 && strings.EqualFold(strings.TrimSpace(record[0]), "mac") {
+			continue
+		}
+
+		if len(record) < minFields || len(record) > maxFields {
+			line, _ := reader.FieldPos(0)
+
+			return nil, fmt.Errorf("%w, got %d on line %d", ErrRecordFields, len(record), line)
+		}
+
+		if len(pairs) == MaxPairs {
+			return nil, ErrTooManyPairs
+		}
+
+		pair := Pair{MAC: strings.TrimSpace(record[0]), Address: strings.TrimSpace(record[1]), Prefix: ""}
+		if len(record) == maxFields {
+			pair.Prefix = strings.TrimSpace(record[2])
+		}
+
+		pairs = append(pairs, pair)
+	}
+
+	if len(pairs) == 0 {
+		return nil, ErrNoPairs
+	}
+
+	return pairs, nil
+}
+
+// Results returns the results of verifying the pairs with calc, in order.
+func Results(calc eui64.Calculator, pairs []Pair) iter.Seq[Result] {
+	return func(yield func(Result) bool) {
+		for _, pair := range pairs {
+			if !yield(Verify(calc, pair)) {
+				return
+			}
+		}
+	}
+}
+
+// WriteCSV writes the results as CSV, after the header row, explaining each
+// result's error with explain, or with the error's own message if explain is
+// nil. The output is streamed, see csvstream.Write.
+func WriteCSV(w io.Writer, results iter.Seq[Result], explain func(error) string) error {
+	if explain == nil {
+		explain = error.Error
+	}
+
+	err := csvstream.Write(w, header, results, func(result Result) []string {
+		message := ""
+		if result.Err != nil {
+			message = explain(result.Err)
+		}
+
+		return []string{
+			result.MAC, result.Address, result.Prefix, string(result.Status), result.Expected,
+			result.InterfaceID, string(result.IIDType), result.ObservedMAC, message,
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("writing verification results: %w", err)
+	}
+
+	return nil
+}
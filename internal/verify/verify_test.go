@@ -0,0 +1,184 @@
+package verify
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholas-fedor/eui64-calculator/internal/analyzer"
+	"github.com/nicholas-fedor/eui64-calculator/internal/eui64"
+	"github.com/nicholas-fedor/eui64-calculator/internal/validators"
+)
+
+// TestVerify tests the Verify function with matching addresses and each kind of
+// mismatch, with and without an expected prefix.
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		pair            Pair
+		wantStatus      Status
+		wantExpected    string
+		wantIIDType     analyzer.IIDType
+		wantObservedMAC string
+	}{
+		{
+			name:            "Match in any prefix",
+			pair:            Pair{MAC: "00-14-22-01-23-45", Address: "2001:db8:1:2:214:22ff:fe01:2345", Prefix: ""},
+			wantStatus:      StatusMatch,
+			wantExpected:    "2001:db8:1:2:214:22ff:fe01:2345",
+			wantIIDType:     analyzer.IIDEUI64,
+			wantObservedMAC: "00-14-22-01-23-45",
+		},
+		{
+			name:            "Match in expected prefix with zone",
+			pair:            Pair{MAC: "00:14:22:01:23:45", Address: "fe80::214:22ff:fe01:2345%eth0", Prefix: "fe80::"},
+			wantStatus:      StatusMatch,
+			wantExpected:    "fe80::214:22ff:fe01:2345",
+			wantIIDType:     analyzer.IIDEUI64,
+			wantObservedMAC: "00-14-22-01-23-45",
+		},
+		{
+			name:            "Prefix mismatch",
+			pair:            Pair{MAC: "00-14-22-01-23-45", Address: "2001:db8:1:3:214:22ff:fe01:2345", Prefix: "2001:db8:1:2"},
+			wantStatus:      StatusPrefixMismatch,
+			wantExpected:    "2001:db8:1:2:214:22ff:fe01:2345",
+			wantIIDType:     analyzer.IIDEUI64,
+			wantObservedMAC: "00-14-22-01-23-45",
+		},
+		{
+			name:            "Interface ID of another MAC address",
+			pair:            Pair{MAC: "00-14-22-01-23-45", Address: "2001:db8:1:2:214:22ff:fe01:2346", Prefix: "2001:db8:1:3"},
+			wantStatus:      StatusIIDMismatch,
+			wantExpected:    "2001:db8:1:3:214:22ff:fe01:2345",
+			wantIIDType:     analyzer.IIDEUI64,
+			wantObservedMAC: "00-14-22-01-23-46",
+		},
+		{
+			name:            "Privacy address",
+			pair:            Pair{MAC: "00-14-22-01-23-45", Address: "2001:db8:1:2:a1b2:c3d4:e5f6:789", Prefix: ""},
+			wantStatus:      StatusNotEUI64,
+			wantExpected:    "2001:db8:1:2:214:22ff:fe01:2345",
+			wantIIDType:     analyzer.IIDRandom,
+			wantObservedMAC: "",
+		},
+		{
+			name:            "6LoWPAN short address",
+			pair:            Pair{MAC: "00-14-22-01-23-45", Address: "fe80::ff:fe00:1a2b", Prefix: ""},
+			wantStatus:      StatusNotEUI64,
+			wantExpected:    "fe80::214:22ff:fe01:2345",
+			wantIIDType:     analyzer.IIDShortAddress,
+			wantObservedMAC: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Verify(&eui64.DefaultCalculator{}, tt.pair)
+			require.NoError(t, got.Err)
+
+			assert.Equal(t, tt.pair, got.Pair)
+			assert.Equal(t, tt.wantStatus, got.Status, "Status")
+			assert.Equal(t, tt.wantExpected, got.Expected, "Expected address")
+			assert.Equal(t, tt.wantIIDType, got.IIDType, "Interface ID type")
+			assert.Equal(t, tt.wantObservedMAC, got.ObservedMAC, "Observed MAC address")
+		})
+	}
+}
+
+// TestVerifyInvalid tests that Verify names the value of a pair that cannot be
+// verified.
+func TestVerifyInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		pair      Pair
+		wantInput Input
+		wantErr   error
+	}{
+		{"Invalid MAC", Pair{"00-14-22", "2001:db8::1", ""}, InputMAC, validators.ErrMACParseFailed},
+		{"Invalid address", Pair{"00-14-22-01-23-45", "2001:db8::g", ""}, InputAddress, analyzer.ErrInvalidAddress},
+		{"No interface ID", Pair{"00-14-22-01-23-45", "ff02::1", ""}, InputAddress, ErrNoInterfaceID},
+		{"Invalid prefix", Pair{"00-14-22-01-23-45", "2001:db8::1", "2001:db8:0:g"}, InputPrefix, validators.ErrInvalidHextetChar},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Verify(&eui64.DefaultCalculator{}, tt.pair)
+			require.ErrorIs(t, got.Err, tt.wantErr)
+
+			var inputErr *InputError
+			require.ErrorAs(t, got.Err, &inputErr)
+			assert.Equal(t, tt.wantInput, inputErr.Input)
+			assert.Empty(t, got.Status)
+		})
+	}
+}
+
+// TestParseCSV tests the ParseCSV function with headers, blank lines, optional
+// prefixes, and invalid input.
+func TestParseCSV(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseCSV(strings.NewReader("MAC,Address\n\n00-14-22-01-23-45, fe80::214:22ff:fe01:2345\n" +
+		"00-14-22-01-23-46,2001:db8::214:22ff:fe01:2346, 2001:db8:0:0\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []Pair{
+		{MAC: "00-14-22-01-23-45", Address: "fe80::214:22ff:fe01:2345", Prefix: ""},
+		{MAC: "00-14-22-01-23-46", Address: "2001:db8::214:22ff:fe01:2346", Prefix: "2001:db8:0:0"},
+	}, got)
+
+	tests := []struct {
+		name    string
+		csv     string
+		wantErr error
+	}{
+		{"Empty", "", ErrNoPairs},
+		{"Header only", "mac,address\n", ErrNoPairs},
+		{"Missing address", "00-14-22-01-23-45\n", ErrRecordFields},
+		{"Extra field", "00-14-22-01-23-45,fe80::1,fe80::,x\n", ErrRecordFields},
+		{"Unterminated quote", "\"00-14-22-01-23-45,fe80::1\n", ErrInvalidCSV},
+		{"Too many pairs", strings.Repeat("00-14-22-01-23-45,fe80::1\n", MaxPairs+1), ErrTooManyPairs},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseCSV(strings.NewReader(tt.csv))
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+// TestWriteCSV tests that WriteCSV writes a row per result, with the outcome of
+// verified pairs and the explanation of those that could not be verified.
+func TestWriteCSV(t *testing.T) {
+	t.Parallel()
+
+	pairs := []Pair{
+		{MAC: "00-14-22-01-23-45", Address: "fe80::214:22ff:fe01:2345", Prefix: ""},
+		{MAC: "00-14-22", Address: "fe80::1", Prefix: ""},
+	}
+
+	var buf bytes.Buffer
+
+	err := WriteCSV(&buf, Results(&eui64.DefaultCalculator{}, pairs), func(error) string { return "invalid" })
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		"mac,address,prefix,status,expected_address,interface_id,iid_type,observed_mac,error\n"+
+			"00-14-22-01-23-45,fe80::214:22ff:fe01:2345,,match,fe80::214:22ff:fe01:2345,0214:22ff:fe01:2345,eui64,00-14-22-01-23-45,\n"+
+			"00-14-22,fe80::1,,,,,,,invalid\n",
+		buf.String(),
+	)
+}